@@ -0,0 +1,10 @@
+// Package api embeds the HTTP contract so binaries validate requests against, and serve, the
+// spec they were built with regardless of their working directory.
+package api
+
+import _ "embed"
+
+// OpenAPI is api/openapi.yaml as of the build.
+//
+//go:embed openapi.yaml
+var OpenAPI []byte
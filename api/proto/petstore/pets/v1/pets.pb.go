@@ -0,0 +1,2291 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.28.3
+// source: petstore/pets/v1/pets.proto
+
+package petsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PetStatus int32
+
+const (
+	PetStatus_PET_STATUS_UNSPECIFIED PetStatus = 0
+	PetStatus_PET_STATUS_AVAILABLE   PetStatus = 1
+	PetStatus_PET_STATUS_PENDING     PetStatus = 2
+	PetStatus_PET_STATUS_SOLD        PetStatus = 3
+)
+
+// Enum value maps for PetStatus.
+var (
+	PetStatus_name = map[int32]string{
+		0: "PET_STATUS_UNSPECIFIED",
+		1: "PET_STATUS_AVAILABLE",
+		2: "PET_STATUS_PENDING",
+		3: "PET_STATUS_SOLD",
+	}
+	PetStatus_value = map[string]int32{
+		"PET_STATUS_UNSPECIFIED": 0,
+		"PET_STATUS_AVAILABLE":   1,
+		"PET_STATUS_PENDING":     2,
+		"PET_STATUS_SOLD":        3,
+	}
+)
+
+func (x PetStatus) Enum() *PetStatus {
+	p := new(PetStatus)
+	*p = x
+	return p
+}
+
+func (x PetStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PetStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_petstore_pets_v1_pets_proto_enumTypes[0].Descriptor()
+}
+
+func (PetStatus) Type() protoreflect.EnumType {
+	return &file_petstore_pets_v1_pets_proto_enumTypes[0]
+}
+
+func (x PetStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PetStatus.Descriptor instead.
+func (PetStatus) EnumDescriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportMode int32
+
+const (
+	// IMPORT_MODE_UNSPECIFIED behaves like IMPORT_MODE_CREATE.
+	ImportMode_IMPORT_MODE_UNSPECIFIED ImportMode = 0
+	// IMPORT_MODE_CREATE rejects rows that target an existing pet.
+	ImportMode_IMPORT_MODE_CREATE ImportMode = 1
+	// IMPORT_MODE_UPSERT replaces existing pets and creates the rest.
+	ImportMode_IMPORT_MODE_UPSERT ImportMode = 2
+)
+
+// Enum value maps for ImportMode.
+var (
+	ImportMode_name = map[int32]string{
+		0: "IMPORT_MODE_UNSPECIFIED",
+		1: "IMPORT_MODE_CREATE",
+		2: "IMPORT_MODE_UPSERT",
+	}
+	ImportMode_value = map[string]int32{
+		"IMPORT_MODE_UNSPECIFIED": 0,
+		"IMPORT_MODE_CREATE":      1,
+		"IMPORT_MODE_UPSERT":      2,
+	}
+)
+
+func (x ImportMode) Enum() *ImportMode {
+	p := new(ImportMode)
+	*p = x
+	return p
+}
+
+func (x ImportMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_petstore_pets_v1_pets_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportMode) Type() protoreflect.EnumType {
+	return &file_petstore_pets_v1_pets_proto_enumTypes[1]
+}
+
+func (x ImportMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportMode.Descriptor instead.
+func (ImportMode) EnumDescriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{1}
+}
+
+type ImportRowStatus int32
+
+const (
+	ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED ImportRowStatus = 0
+	ImportRowStatus_IMPORT_ROW_STATUS_CREATED     ImportRowStatus = 1
+	ImportRowStatus_IMPORT_ROW_STATUS_UPDATED     ImportRowStatus = 2
+	// IMPORT_ROW_STATUS_VALID marks a row that passed validation during a dry run.
+	ImportRowStatus_IMPORT_ROW_STATUS_VALID  ImportRowStatus = 3
+	ImportRowStatus_IMPORT_ROW_STATUS_FAILED ImportRowStatus = 4
+)
+
+// Enum value maps for ImportRowStatus.
+var (
+	ImportRowStatus_name = map[int32]string{
+		0: "IMPORT_ROW_STATUS_UNSPECIFIED",
+		1: "IMPORT_ROW_STATUS_CREATED",
+		2: "IMPORT_ROW_STATUS_UPDATED",
+		3: "IMPORT_ROW_STATUS_VALID",
+		4: "IMPORT_ROW_STATUS_FAILED",
+	}
+	ImportRowStatus_value = map[string]int32{
+		"IMPORT_ROW_STATUS_UNSPECIFIED": 0,
+		"IMPORT_ROW_STATUS_CREATED":     1,
+		"IMPORT_ROW_STATUS_UPDATED":     2,
+		"IMPORT_ROW_STATUS_VALID":       3,
+		"IMPORT_ROW_STATUS_FAILED":      4,
+	}
+)
+
+func (x ImportRowStatus) Enum() *ImportRowStatus {
+	p := new(ImportRowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_petstore_pets_v1_pets_proto_enumTypes[2].Descriptor()
+}
+
+func (ImportRowStatus) Type() protoreflect.EnumType {
+	return &file_petstore_pets_v1_pets_proto_enumTypes[2]
+}
+
+func (x ImportRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowStatus.Descriptor instead.
+func (ImportRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{2}
+}
+
+type Pet struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PhotoUrls         []string               `protobuf:"bytes,3,rep,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	Category          *Category              `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tags              []*Tag                 `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Status            PetStatus              `protobuf:"varint,6,opt,name=status,proto3,enum=petstore.pets.v1.PetStatus" json:"status,omitempty"`
+	HairLengthCm      float64                `protobuf:"fixed64,7,opt,name=hair_length_cm,json=hairLengthCm,proto3" json:"hair_length_cm,omitempty"`
+	ExternalReference *ExternalReference     `protobuf:"bytes,8,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
+	CreateTime        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Pet) Reset() {
+	*x = Pet{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pet) ProtoMessage() {}
+
+func (x *Pet) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pet.ProtoReflect.Descriptor instead.
+func (*Pet) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pet) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Pet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pet) GetPhotoUrls() []string {
+	if x != nil {
+		return x.PhotoUrls
+	}
+	return nil
+}
+
+func (x *Pet) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *Pet) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Pet) GetStatus() PetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PetStatus_PET_STATUS_UNSPECIFIED
+}
+
+func (x *Pet) GetHairLengthCm() float64 {
+	if x != nil {
+		return x.HairLengthCm
+	}
+	return 0
+}
+
+func (x *Pet) GetExternalReference() *ExternalReference {
+	if x != nil {
+		return x.ExternalReference
+	}
+	return nil
+}
+
+func (x *Pet) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Pet) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Tag) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ExternalReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalReference) Reset() {
+	*x = ExternalReference{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalReference) ProtoMessage() {}
+
+func (x *ExternalReference) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalReference.ProtoReflect.Descriptor instead.
+func (*ExternalReference) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExternalReference) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ExternalReference) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExternalReference) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// PetMutation carries the state to write. Unset fields are left unchanged on update and take
+// their defaults on create; the clear_* flags remove a field and cannot be combined with a value.
+type PetMutation struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                   *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	PhotoUrls              *PhotoUrls             `protobuf:"bytes,3,opt,name=photo_urls,json=photoUrls,proto3" json:"photo_urls,omitempty"`
+	Category               *Category              `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Tags                   *Tags                  `protobuf:"bytes,5,opt,name=tags,proto3" json:"tags,omitempty"`
+	Status                 PetStatus              `protobuf:"varint,6,opt,name=status,proto3,enum=petstore.pets.v1.PetStatus" json:"status,omitempty"`
+	HairLengthCm           *float64               `protobuf:"fixed64,7,opt,name=hair_length_cm,json=hairLengthCm,proto3,oneof" json:"hair_length_cm,omitempty"`
+	ExternalReference      *ExternalReference     `protobuf:"bytes,8,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
+	ClearCategory          bool                   `protobuf:"varint,9,opt,name=clear_category,json=clearCategory,proto3" json:"clear_category,omitempty"`
+	ClearTags              bool                   `protobuf:"varint,10,opt,name=clear_tags,json=clearTags,proto3" json:"clear_tags,omitempty"`
+	ClearExternalReference bool                   `protobuf:"varint,11,opt,name=clear_external_reference,json=clearExternalReference,proto3" json:"clear_external_reference,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PetMutation) Reset() {
+	*x = PetMutation{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PetMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PetMutation) ProtoMessage() {}
+
+func (x *PetMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PetMutation.ProtoReflect.Descriptor instead.
+func (*PetMutation) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PetMutation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PetMutation) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PetMutation) GetPhotoUrls() *PhotoUrls {
+	if x != nil {
+		return x.PhotoUrls
+	}
+	return nil
+}
+
+func (x *PetMutation) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *PetMutation) GetTags() *Tags {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PetMutation) GetStatus() PetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PetStatus_PET_STATUS_UNSPECIFIED
+}
+
+func (x *PetMutation) GetHairLengthCm() float64 {
+	if x != nil && x.HairLengthCm != nil {
+		return *x.HairLengthCm
+	}
+	return 0
+}
+
+func (x *PetMutation) GetExternalReference() *ExternalReference {
+	if x != nil {
+		return x.ExternalReference
+	}
+	return nil
+}
+
+func (x *PetMutation) GetClearCategory() bool {
+	if x != nil {
+		return x.ClearCategory
+	}
+	return false
+}
+
+func (x *PetMutation) GetClearTags() bool {
+	if x != nil {
+		return x.ClearTags
+	}
+	return false
+}
+
+func (x *PetMutation) GetClearExternalReference() bool {
+	if x != nil {
+		return x.ClearExternalReference
+	}
+	return false
+}
+
+// PhotoUrls wraps the list so an update can tell "unchanged" from "replace".
+type PhotoUrls struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Urls          []string               `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PhotoUrls) Reset() {
+	*x = PhotoUrls{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhotoUrls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhotoUrls) ProtoMessage() {}
+
+func (x *PhotoUrls) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhotoUrls.ProtoReflect.Descriptor instead.
+func (*PhotoUrls) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PhotoUrls) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+// Tags wraps the list so an update can tell "unchanged" from "replace".
+type Tags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tags) Reset() {
+	*x = Tags{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tags) ProtoMessage() {}
+
+func (x *Tags) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tags.ProtoReflect.Descriptor instead.
+func (*Tags) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Tags) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddPetRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Pet            *PetMutation           `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddPetRequest) Reset() {
+	*x = AddPetRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPetRequest) ProtoMessage() {}
+
+func (x *AddPetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPetRequest.ProtoReflect.Descriptor instead.
+func (*AddPetRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AddPetRequest) GetPet() *PetMutation {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+func (x *AddPetRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UpdatePetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pet.id selects the pet to update.
+	Pet           *PetMutation `protobuf:"bytes,1,opt,name=pet,proto3" json:"pet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePetRequest) Reset() {
+	*x = UpdatePetRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePetRequest) ProtoMessage() {}
+
+func (x *UpdatePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePetRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePetRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePetRequest) GetPet() *PetMutation {
+	if x != nil {
+		return x.Pet
+	}
+	return nil
+}
+
+type GetPetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPetRequest) Reset() {
+	*x = GetPetRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPetRequest) ProtoMessage() {}
+
+func (x *GetPetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPetRequest.ProtoReflect.Descriptor instead.
+func (*GetPetRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FindPetsByStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []PetStatus            `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=petstore.pets.v1.PetStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindPetsByStatusRequest) Reset() {
+	*x = FindPetsByStatusRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPetsByStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPetsByStatusRequest) ProtoMessage() {}
+
+func (x *FindPetsByStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPetsByStatusRequest.ProtoReflect.Descriptor instead.
+func (*FindPetsByStatusRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FindPetsByStatusRequest) GetStatuses() []PetStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type FindPetsByTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindPetsByTagsRequest) Reset() {
+	*x = FindPetsByTagsRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPetsByTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPetsByTagsRequest) ProtoMessage() {}
+
+func (x *FindPetsByTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPetsByTagsRequest.ProtoReflect.Descriptor instead.
+func (*FindPetsByTagsRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FindPetsByTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type FindPetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pets          []*Pet                 `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindPetsResponse) Reset() {
+	*x = FindPetsResponse{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindPetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPetsResponse) ProtoMessage() {}
+
+func (x *FindPetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPetsResponse.ProtoReflect.Descriptor instead.
+func (*FindPetsResponse) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FindPetsResponse) GetPets() []*Pet {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+type ListPetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPetsRequest) Reset() {
+	*x = ListPetsRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPetsRequest) ProtoMessage() {}
+
+func (x *ListPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPetsRequest.ProtoReflect.Descriptor instead.
+func (*ListPetsRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{13}
+}
+
+type ListPetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pets          []*Pet                 `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPetsResponse) Reset() {
+	*x = ListPetsResponse{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPetsResponse) ProtoMessage() {}
+
+func (x *ListPetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPetsResponse.ProtoReflect.Descriptor instead.
+func (*ListPetsResponse) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListPetsResponse) GetPets() []*Pet {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+type BatchGetPetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPetsRequest) Reset() {
+	*x = BatchGetPetsRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPetsRequest) ProtoMessage() {}
+
+func (x *BatchGetPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPetsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPetsRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchGetPetsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetPetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pets are returned in request order.
+	Pets          []*Pet  `protobuf:"bytes,1,rep,name=pets,proto3" json:"pets,omitempty"`
+	MissingIds    []int64 `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPetsResponse) Reset() {
+	*x = BatchGetPetsResponse{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPetsResponse) ProtoMessage() {}
+
+func (x *BatchGetPetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPetsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPetsResponse) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchGetPetsResponse) GetPets() []*Pet {
+	if x != nil {
+		return x.Pets
+	}
+	return nil
+}
+
+func (x *BatchGetPetsResponse) GetMissingIds() []int64 {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+type BatchUpdatePetStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Status        PetStatus              `protobuf:"varint,2,opt,name=status,proto3,enum=petstore.pets.v1.PetStatus" json:"status,omitempty"`
+	Atomic        bool                   `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdatePetStatusRequest) Reset() {
+	*x = BatchUpdatePetStatusRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdatePetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePetStatusRequest) ProtoMessage() {}
+
+func (x *BatchUpdatePetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePetStatusRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchUpdatePetStatusRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchUpdatePetStatusRequest) GetStatus() PetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PetStatus_PET_STATUS_UNSPECIFIED
+}
+
+func (x *BatchUpdatePetStatusRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type BatchItemProblem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemProblem) Reset() {
+	*x = BatchItemProblem{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemProblem) ProtoMessage() {}
+
+func (x *BatchItemProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemProblem.ProtoReflect.Descriptor instead.
+func (*BatchItemProblem) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BatchItemProblem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchItemProblem) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BatchUpdatePetStatusResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Updated []*Pet                 `protobuf:"bytes,1,rep,name=updated,proto3" json:"updated,omitempty"`
+	// problems lists items that were not written.
+	Problems []*BatchItemProblem `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems,omitempty"`
+	// warnings lists partner sync failures for items that were written.
+	Warnings      []*BatchItemProblem `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdatePetStatusResponse) Reset() {
+	*x = BatchUpdatePetStatusResponse{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdatePetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdatePetStatusResponse) ProtoMessage() {}
+
+func (x *BatchUpdatePetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdatePetStatusResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdatePetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BatchUpdatePetStatusResponse) GetUpdated() []*Pet {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *BatchUpdatePetStatusResponse) GetProblems() []*BatchItemProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *BatchUpdatePetStatusResponse) GetWarnings() []*BatchItemProblem {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type DeletePetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePetRequest) Reset() {
+	*x = DeletePetRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePetRequest) ProtoMessage() {}
+
+func (x *DeletePetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePetRequest.ProtoReflect.Descriptor instead.
+func (*DeletePetRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeletePetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePetResponse) Reset() {
+	*x = DeletePetResponse{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePetResponse) ProtoMessage() {}
+
+func (x *DeletePetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePetResponse.ProtoReflect.Descriptor instead.
+func (*DeletePetResponse) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{21}
+}
+
+type GroomPetRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InitialHairLengthCm float64                `protobuf:"fixed64,2,opt,name=initial_hair_length_cm,json=initialHairLengthCm,proto3" json:"initial_hair_length_cm,omitempty"`
+	TrimByCm            float64                `protobuf:"fixed64,3,opt,name=trim_by_cm,json=trimByCm,proto3" json:"trim_by_cm,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GroomPetRequest) Reset() {
+	*x = GroomPetRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroomPetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroomPetRequest) ProtoMessage() {}
+
+func (x *GroomPetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroomPetRequest.ProtoReflect.Descriptor instead.
+func (*GroomPetRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GroomPetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GroomPetRequest) GetInitialHairLengthCm() float64 {
+	if x != nil {
+		return x.InitialHairLengthCm
+	}
+	return 0
+}
+
+func (x *GroomPetRequest) GetTrimByCm() float64 {
+	if x != nil {
+		return x.TrimByCm
+	}
+	return 0
+}
+
+type UploadImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Metadata      string                 `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageRequest) Reset() {
+	*x = UploadImageRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageRequest) ProtoMessage() {}
+
+func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UploadImageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UploadImageRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadImageRequest) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+type UploadImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageResponse) Reset() {
+	*x = UploadImageResponse{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageResponse) ProtoMessage() {}
+
+func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadImageResponse) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UploadImageResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UploadImageResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UploadImageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportPetsOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Mode  ImportMode             `protobuf:"varint,1,opt,name=mode,proto3,enum=petstore.pets.v1.ImportMode" json:"mode,omitempty"`
+	// dry_run validates every row without writing.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPetsOptions) Reset() {
+	*x = ImportPetsOptions{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPetsOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPetsOptions) ProtoMessage() {}
+
+func (x *ImportPetsOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPetsOptions.ProtoReflect.Descriptor instead.
+func (*ImportPetsOptions) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportPetsOptions) GetMode() ImportMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ImportMode_IMPORT_MODE_UNSPECIFIED
+}
+
+func (x *ImportPetsOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportPetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportPetsRequest_Options
+	//	*ImportPetsRequest_Pet
+	Payload       isImportPetsRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPetsRequest) Reset() {
+	*x = ImportPetsRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPetsRequest) ProtoMessage() {}
+
+func (x *ImportPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPetsRequest.ProtoReflect.Descriptor instead.
+func (*ImportPetsRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportPetsRequest) GetPayload() isImportPetsRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportPetsRequest) GetOptions() *ImportPetsOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportPetsRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportPetsRequest) GetPet() *PetMutation {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportPetsRequest_Pet); ok {
+			return x.Pet
+		}
+	}
+	return nil
+}
+
+type isImportPetsRequest_Payload interface {
+	isImportPetsRequest_Payload()
+}
+
+type ImportPetsRequest_Options struct {
+	Options *ImportPetsOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportPetsRequest_Pet struct {
+	Pet *PetMutation `protobuf:"bytes,2,opt,name=pet,proto3,oneof"`
+}
+
+func (*ImportPetsRequest_Options) isImportPetsRequest_Payload() {}
+
+func (*ImportPetsRequest_Pet) isImportPetsRequest_Payload() {}
+
+type ImportRowResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// row is the 1-based position of the pet among the request messages carrying one.
+	Row    int32           `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Id     int64           `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status ImportRowStatus `protobuf:"varint,3,opt,name=status,proto3,enum=petstore.pets.v1.ImportRowStatus" json:"status,omitempty"`
+	Error  string          `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// warning reports partner sync failures for rows that were saved.
+	Warning       string `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetStatus() ImportRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetWarning() string {
+	if x != nil {
+		return x.Warning
+	}
+	return ""
+}
+
+type ImportSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Valid         int32                  `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSummary) Reset() {
+	*x = ImportSummary{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSummary) ProtoMessage() {}
+
+func (x *ImportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSummary.ProtoReflect.Descriptor instead.
+func (*ImportSummary) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetValid() int32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportPetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*ImportPetsResponse_Row
+	//	*ImportPetsResponse_Summary
+	Result        isImportPetsResponse_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPetsResponse) Reset() {
+	*x = ImportPetsResponse{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPetsResponse) ProtoMessage() {}
+
+func (x *ImportPetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPetsResponse.ProtoReflect.Descriptor instead.
+func (*ImportPetsResponse) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImportPetsResponse) GetResult() isImportPetsResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ImportPetsResponse) GetRow() *ImportRowResult {
+	if x != nil {
+		if x, ok := x.Result.(*ImportPetsResponse_Row); ok {
+			return x.Row
+		}
+	}
+	return nil
+}
+
+func (x *ImportPetsResponse) GetSummary() *ImportSummary {
+	if x != nil {
+		if x, ok := x.Result.(*ImportPetsResponse_Summary); ok {
+			return x.Summary
+		}
+	}
+	return nil
+}
+
+type isImportPetsResponse_Result interface {
+	isImportPetsResponse_Result()
+}
+
+type ImportPetsResponse_Row struct {
+	Row *ImportRowResult `protobuf:"bytes,1,opt,name=row,proto3,oneof"`
+}
+
+type ImportPetsResponse_Summary struct {
+	Summary *ImportSummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*ImportPetsResponse_Row) isImportPetsResponse_Result() {}
+
+func (*ImportPetsResponse_Summary) isImportPetsResponse_Result() {}
+
+type ExportPetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPetsRequest) Reset() {
+	*x = ExportPetsRequest{}
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPetsRequest) ProtoMessage() {}
+
+func (x *ExportPetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_pets_v1_pets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPetsRequest.ProtoReflect.Descriptor instead.
+func (*ExportPetsRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_pets_v1_pets_proto_rawDescGZIP(), []int{30}
+}
+
+var File_petstore_pets_v1_pets_proto protoreflect.FileDescriptor
+
+const file_petstore_pets_v1_pets_proto_rawDesc = "" +
+	"\n" +
+	"\x1bpetstore/pets/v1/pets.proto\x12\x10petstore.pets.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x03\n" +
+	"\x03Pet\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"photo_urls\x18\x03 \x03(\tR\tphotoUrls\x126\n" +
+	"\bcategory\x18\x04 \x01(\v2\x1a.petstore.pets.v1.CategoryR\bcategory\x12)\n" +
+	"\x04tags\x18\x05 \x03(\v2\x15.petstore.pets.v1.TagR\x04tags\x123\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1b.petstore.pets.v1.PetStatusR\x06status\x12$\n" +
+	"\x0ehair_length_cm\x18\a \x01(\x01R\fhairLengthCm\x12R\n" +
+	"\x12external_reference\x18\b \x01(\v2#.petstore.pets.v1.ExternalReferenceR\x11externalReference\x12;\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\".\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\")\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xd3\x01\n" +
+	"\x11ExternalReference\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12S\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v23.petstore.pets.v1.ExternalReference.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa6\x04\n" +
+	"\vPetMutation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12:\n" +
+	"\n" +
+	"photo_urls\x18\x03 \x01(\v2\x1b.petstore.pets.v1.PhotoUrlsR\tphotoUrls\x126\n" +
+	"\bcategory\x18\x04 \x01(\v2\x1a.petstore.pets.v1.CategoryR\bcategory\x12*\n" +
+	"\x04tags\x18\x05 \x01(\v2\x16.petstore.pets.v1.TagsR\x04tags\x123\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x1b.petstore.pets.v1.PetStatusR\x06status\x12)\n" +
+	"\x0ehair_length_cm\x18\a \x01(\x01H\x01R\fhairLengthCm\x88\x01\x01\x12R\n" +
+	"\x12external_reference\x18\b \x01(\v2#.petstore.pets.v1.ExternalReferenceR\x11externalReference\x12%\n" +
+	"\x0eclear_category\x18\t \x01(\bR\rclearCategory\x12\x1d\n" +
+	"\n" +
+	"clear_tags\x18\n" +
+	" \x01(\bR\tclearTags\x128\n" +
+	"\x18clear_external_reference\x18\v \x01(\bR\x16clearExternalReferenceB\a\n" +
+	"\x05_nameB\x11\n" +
+	"\x0f_hair_length_cm\"\x1f\n" +
+	"\tPhotoUrls\x12\x12\n" +
+	"\x04urls\x18\x01 \x03(\tR\x04urls\"1\n" +
+	"\x04Tags\x12)\n" +
+	"\x04tags\x18\x01 \x03(\v2\x15.petstore.pets.v1.TagR\x04tags\"i\n" +
+	"\rAddPetRequest\x12/\n" +
+	"\x03pet\x18\x01 \x01(\v2\x1d.petstore.pets.v1.PetMutationR\x03pet\x12'\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\"C\n" +
+	"\x10UpdatePetRequest\x12/\n" +
+	"\x03pet\x18\x01 \x01(\v2\x1d.petstore.pets.v1.PetMutationR\x03pet\"\x1f\n" +
+	"\rGetPetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"R\n" +
+	"\x17FindPetsByStatusRequest\x127\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x1b.petstore.pets.v1.PetStatusR\bstatuses\"+\n" +
+	"\x15FindPetsByTagsRequest\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\"=\n" +
+	"\x10FindPetsResponse\x12)\n" +
+	"\x04pets\x18\x01 \x03(\v2\x15.petstore.pets.v1.PetR\x04pets\"\x11\n" +
+	"\x0fListPetsRequest\"=\n" +
+	"\x10ListPetsResponse\x12)\n" +
+	"\x04pets\x18\x01 \x03(\v2\x15.petstore.pets.v1.PetR\x04pets\"'\n" +
+	"\x13BatchGetPetsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"b\n" +
+	"\x14BatchGetPetsResponse\x12)\n" +
+	"\x04pets\x18\x01 \x03(\v2\x15.petstore.pets.v1.PetR\x04pets\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\x03R\n" +
+	"missingIds\"|\n" +
+	"\x1bBatchUpdatePetStatusRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.petstore.pets.v1.PetStatusR\x06status\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\"8\n" +
+	"\x10BatchItemProblem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xcf\x01\n" +
+	"\x1cBatchUpdatePetStatusResponse\x12/\n" +
+	"\aupdated\x18\x01 \x03(\v2\x15.petstore.pets.v1.PetR\aupdated\x12>\n" +
+	"\bproblems\x18\x02 \x03(\v2\".petstore.pets.v1.BatchItemProblemR\bproblems\x12>\n" +
+	"\bwarnings\x18\x03 \x03(\v2\".petstore.pets.v1.BatchItemProblemR\bwarnings\"\"\n" +
+	"\x10DeletePetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x13\n" +
+	"\x11DeletePetResponse\"t\n" +
+	"\x0fGroomPetRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x123\n" +
+	"\x16initial_hair_length_cm\x18\x02 \x01(\x01R\x13initialHairLengthCm\x12\x1c\n" +
+	"\n" +
+	"trim_by_cm\x18\x03 \x01(\x01R\btrimByCm\"\\\n" +
+	"\x12UploadImageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1a\n" +
+	"\bmetadata\x18\x03 \x01(\tR\bmetadata\"W\n" +
+	"\x13UploadImageResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"^\n" +
+	"\x11ImportPetsOptions\x120\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x1c.petstore.pets.v1.ImportModeR\x04mode\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x92\x01\n" +
+	"\x11ImportPetsRequest\x12?\n" +
+	"\aoptions\x18\x01 \x01(\v2#.petstore.pets.v1.ImportPetsOptionsH\x00R\aoptions\x121\n" +
+	"\x03pet\x18\x02 \x01(\v2\x1d.petstore.pets.v1.PetMutationH\x00R\x03petB\t\n" +
+	"\apayload\"\x9e\x01\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x129\n" +
+	"\x06status\x18\x03 \x01(\x0e2!.petstore.pets.v1.ImportRowStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x18\n" +
+	"\awarning\x18\x05 \x01(\tR\awarning\"\xa0\x01\n" +
+	"\rImportSummary\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x14\n" +
+	"\x05valid\x18\x04 \x01(\x05R\x05valid\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"\x92\x01\n" +
+	"\x12ImportPetsResponse\x125\n" +
+	"\x03row\x18\x01 \x01(\v2!.petstore.pets.v1.ImportRowResultH\x00R\x03row\x12;\n" +
+	"\asummary\x18\x02 \x01(\v2\x1f.petstore.pets.v1.ImportSummaryH\x00R\asummaryB\b\n" +
+	"\x06result\"\x13\n" +
+	"\x11ExportPetsRequest*n\n" +
+	"\tPetStatus\x12\x1a\n" +
+	"\x16PET_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PET_STATUS_AVAILABLE\x10\x01\x12\x16\n" +
+	"\x12PET_STATUS_PENDING\x10\x02\x12\x13\n" +
+	"\x0fPET_STATUS_SOLD\x10\x03*Y\n" +
+	"\n" +
+	"ImportMode\x12\x1b\n" +
+	"\x17IMPORT_MODE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12IMPORT_MODE_CREATE\x10\x01\x12\x16\n" +
+	"\x12IMPORT_MODE_UPSERT\x10\x02*\xad\x01\n" +
+	"\x0fImportRowStatus\x12!\n" +
+	"\x1dIMPORT_ROW_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19IMPORT_ROW_STATUS_CREATED\x10\x01\x12\x1d\n" +
+	"\x19IMPORT_ROW_STATUS_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17IMPORT_ROW_STATUS_VALID\x10\x03\x12\x1c\n" +
+	"\x18IMPORT_ROW_STATUS_FAILED\x10\x042\xe4\b\n" +
+	"\n" +
+	"PetService\x12@\n" +
+	"\x06AddPet\x12\x1f.petstore.pets.v1.AddPetRequest\x1a\x15.petstore.pets.v1.Pet\x12F\n" +
+	"\tUpdatePet\x12\".petstore.pets.v1.UpdatePetRequest\x1a\x15.petstore.pets.v1.Pet\x12@\n" +
+	"\x06GetPet\x12\x1f.petstore.pets.v1.GetPetRequest\x1a\x15.petstore.pets.v1.Pet\x12a\n" +
+	"\x10FindPetsByStatus\x12).petstore.pets.v1.FindPetsByStatusRequest\x1a\".petstore.pets.v1.FindPetsResponse\x12]\n" +
+	"\x0eFindPetsByTags\x12'.petstore.pets.v1.FindPetsByTagsRequest\x1a\".petstore.pets.v1.FindPetsResponse\x12Q\n" +
+	"\bListPets\x12!.petstore.pets.v1.ListPetsRequest\x1a\".petstore.pets.v1.ListPetsResponse\x12]\n" +
+	"\fBatchGetPets\x12%.petstore.pets.v1.BatchGetPetsRequest\x1a&.petstore.pets.v1.BatchGetPetsResponse\x12u\n" +
+	"\x14BatchUpdatePetStatus\x12-.petstore.pets.v1.BatchUpdatePetStatusRequest\x1a..petstore.pets.v1.BatchUpdatePetStatusResponse\x12T\n" +
+	"\tDeletePet\x12\".petstore.pets.v1.DeletePetRequest\x1a#.petstore.pets.v1.DeletePetResponse\x12D\n" +
+	"\bGroomPet\x12!.petstore.pets.v1.GroomPetRequest\x1a\x15.petstore.pets.v1.Pet\x12Z\n" +
+	"\vUploadImage\x12$.petstore.pets.v1.UploadImageRequest\x1a%.petstore.pets.v1.UploadImageResponse\x12[\n" +
+	"\n" +
+	"ImportPets\x12#.petstore.pets.v1.ImportPetsRequest\x1a$.petstore.pets.v1.ImportPetsResponse(\x010\x01\x12J\n" +
+	"\n" +
+	"ExportPets\x12#.petstore.pets.v1.ExportPetsRequest\x1a\x15.petstore.pets.v1.Pet0\x01BGZEgithub.com/Apurer/go-gin-api-server/api/proto/petstore/pets/v1;petsv1b\x06proto3"
+
+var (
+	file_petstore_pets_v1_pets_proto_rawDescOnce sync.Once
+	file_petstore_pets_v1_pets_proto_rawDescData []byte
+)
+
+func file_petstore_pets_v1_pets_proto_rawDescGZIP() []byte {
+	file_petstore_pets_v1_pets_proto_rawDescOnce.Do(func() {
+		file_petstore_pets_v1_pets_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_petstore_pets_v1_pets_proto_rawDesc), len(file_petstore_pets_v1_pets_proto_rawDesc)))
+	})
+	return file_petstore_pets_v1_pets_proto_rawDescData
+}
+
+var file_petstore_pets_v1_pets_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_petstore_pets_v1_pets_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_petstore_pets_v1_pets_proto_goTypes = []any{
+	(PetStatus)(0),                       // 0: petstore.pets.v1.PetStatus
+	(ImportMode)(0),                      // 1: petstore.pets.v1.ImportMode
+	(ImportRowStatus)(0),                 // 2: petstore.pets.v1.ImportRowStatus
+	(*Pet)(nil),                          // 3: petstore.pets.v1.Pet
+	(*Category)(nil),                     // 4: petstore.pets.v1.Category
+	(*Tag)(nil),                          // 5: petstore.pets.v1.Tag
+	(*ExternalReference)(nil),            // 6: petstore.pets.v1.ExternalReference
+	(*PetMutation)(nil),                  // 7: petstore.pets.v1.PetMutation
+	(*PhotoUrls)(nil),                    // 8: petstore.pets.v1.PhotoUrls
+	(*Tags)(nil),                         // 9: petstore.pets.v1.Tags
+	(*AddPetRequest)(nil),                // 10: petstore.pets.v1.AddPetRequest
+	(*UpdatePetRequest)(nil),             // 11: petstore.pets.v1.UpdatePetRequest
+	(*GetPetRequest)(nil),                // 12: petstore.pets.v1.GetPetRequest
+	(*FindPetsByStatusRequest)(nil),      // 13: petstore.pets.v1.FindPetsByStatusRequest
+	(*FindPetsByTagsRequest)(nil),        // 14: petstore.pets.v1.FindPetsByTagsRequest
+	(*FindPetsResponse)(nil),             // 15: petstore.pets.v1.FindPetsResponse
+	(*ListPetsRequest)(nil),              // 16: petstore.pets.v1.ListPetsRequest
+	(*ListPetsResponse)(nil),             // 17: petstore.pets.v1.ListPetsResponse
+	(*BatchGetPetsRequest)(nil),          // 18: petstore.pets.v1.BatchGetPetsRequest
+	(*BatchGetPetsResponse)(nil),         // 19: petstore.pets.v1.BatchGetPetsResponse
+	(*BatchUpdatePetStatusRequest)(nil),  // 20: petstore.pets.v1.BatchUpdatePetStatusRequest
+	(*BatchItemProblem)(nil),             // 21: petstore.pets.v1.BatchItemProblem
+	(*BatchUpdatePetStatusResponse)(nil), // 22: petstore.pets.v1.BatchUpdatePetStatusResponse
+	(*DeletePetRequest)(nil),             // 23: petstore.pets.v1.DeletePetRequest
+	(*DeletePetResponse)(nil),            // 24: petstore.pets.v1.DeletePetResponse
+	(*GroomPetRequest)(nil),              // 25: petstore.pets.v1.GroomPetRequest
+	(*UploadImageRequest)(nil),           // 26: petstore.pets.v1.UploadImageRequest
+	(*UploadImageResponse)(nil),          // 27: petstore.pets.v1.UploadImageResponse
+	(*ImportPetsOptions)(nil),            // 28: petstore.pets.v1.ImportPetsOptions
+	(*ImportPetsRequest)(nil),            // 29: petstore.pets.v1.ImportPetsRequest
+	(*ImportRowResult)(nil),              // 30: petstore.pets.v1.ImportRowResult
+	(*ImportSummary)(nil),                // 31: petstore.pets.v1.ImportSummary
+	(*ImportPetsResponse)(nil),           // 32: petstore.pets.v1.ImportPetsResponse
+	(*ExportPetsRequest)(nil),            // 33: petstore.pets.v1.ExportPetsRequest
+	nil,                                  // 34: petstore.pets.v1.ExternalReference.AttributesEntry
+	(*timestamppb.Timestamp)(nil),        // 35: google.protobuf.Timestamp
+}
+var file_petstore_pets_v1_pets_proto_depIdxs = []int32{
+	4,  // 0: petstore.pets.v1.Pet.category:type_name -> petstore.pets.v1.Category
+	5,  // 1: petstore.pets.v1.Pet.tags:type_name -> petstore.pets.v1.Tag
+	0,  // 2: petstore.pets.v1.Pet.status:type_name -> petstore.pets.v1.PetStatus
+	6,  // 3: petstore.pets.v1.Pet.external_reference:type_name -> petstore.pets.v1.ExternalReference
+	35, // 4: petstore.pets.v1.Pet.create_time:type_name -> google.protobuf.Timestamp
+	35, // 5: petstore.pets.v1.Pet.update_time:type_name -> google.protobuf.Timestamp
+	34, // 6: petstore.pets.v1.ExternalReference.attributes:type_name -> petstore.pets.v1.ExternalReference.AttributesEntry
+	8,  // 7: petstore.pets.v1.PetMutation.photo_urls:type_name -> petstore.pets.v1.PhotoUrls
+	4,  // 8: petstore.pets.v1.PetMutation.category:type_name -> petstore.pets.v1.Category
+	9,  // 9: petstore.pets.v1.PetMutation.tags:type_name -> petstore.pets.v1.Tags
+	0,  // 10: petstore.pets.v1.PetMutation.status:type_name -> petstore.pets.v1.PetStatus
+	6,  // 11: petstore.pets.v1.PetMutation.external_reference:type_name -> petstore.pets.v1.ExternalReference
+	5,  // 12: petstore.pets.v1.Tags.tags:type_name -> petstore.pets.v1.Tag
+	7,  // 13: petstore.pets.v1.AddPetRequest.pet:type_name -> petstore.pets.v1.PetMutation
+	7,  // 14: petstore.pets.v1.UpdatePetRequest.pet:type_name -> petstore.pets.v1.PetMutation
+	0,  // 15: petstore.pets.v1.FindPetsByStatusRequest.statuses:type_name -> petstore.pets.v1.PetStatus
+	3,  // 16: petstore.pets.v1.FindPetsResponse.pets:type_name -> petstore.pets.v1.Pet
+	3,  // 17: petstore.pets.v1.ListPetsResponse.pets:type_name -> petstore.pets.v1.Pet
+	3,  // 18: petstore.pets.v1.BatchGetPetsResponse.pets:type_name -> petstore.pets.v1.Pet
+	0,  // 19: petstore.pets.v1.BatchUpdatePetStatusRequest.status:type_name -> petstore.pets.v1.PetStatus
+	3,  // 20: petstore.pets.v1.BatchUpdatePetStatusResponse.updated:type_name -> petstore.pets.v1.Pet
+	21, // 21: petstore.pets.v1.BatchUpdatePetStatusResponse.problems:type_name -> petstore.pets.v1.BatchItemProblem
+	21, // 22: petstore.pets.v1.BatchUpdatePetStatusResponse.warnings:type_name -> petstore.pets.v1.BatchItemProblem
+	1,  // 23: petstore.pets.v1.ImportPetsOptions.mode:type_name -> petstore.pets.v1.ImportMode
+	28, // 24: petstore.pets.v1.ImportPetsRequest.options:type_name -> petstore.pets.v1.ImportPetsOptions
+	7,  // 25: petstore.pets.v1.ImportPetsRequest.pet:type_name -> petstore.pets.v1.PetMutation
+	2,  // 26: petstore.pets.v1.ImportRowResult.status:type_name -> petstore.pets.v1.ImportRowStatus
+	30, // 27: petstore.pets.v1.ImportPetsResponse.row:type_name -> petstore.pets.v1.ImportRowResult
+	31, // 28: petstore.pets.v1.ImportPetsResponse.summary:type_name -> petstore.pets.v1.ImportSummary
+	10, // 29: petstore.pets.v1.PetService.AddPet:input_type -> petstore.pets.v1.AddPetRequest
+	11, // 30: petstore.pets.v1.PetService.UpdatePet:input_type -> petstore.pets.v1.UpdatePetRequest
+	12, // 31: petstore.pets.v1.PetService.GetPet:input_type -> petstore.pets.v1.GetPetRequest
+	13, // 32: petstore.pets.v1.PetService.FindPetsByStatus:input_type -> petstore.pets.v1.FindPetsByStatusRequest
+	14, // 33: petstore.pets.v1.PetService.FindPetsByTags:input_type -> petstore.pets.v1.FindPetsByTagsRequest
+	16, // 34: petstore.pets.v1.PetService.ListPets:input_type -> petstore.pets.v1.ListPetsRequest
+	18, // 35: petstore.pets.v1.PetService.BatchGetPets:input_type -> petstore.pets.v1.BatchGetPetsRequest
+	20, // 36: petstore.pets.v1.PetService.BatchUpdatePetStatus:input_type -> petstore.pets.v1.BatchUpdatePetStatusRequest
+	23, // 37: petstore.pets.v1.PetService.DeletePet:input_type -> petstore.pets.v1.DeletePetRequest
+	25, // 38: petstore.pets.v1.PetService.GroomPet:input_type -> petstore.pets.v1.GroomPetRequest
+	26, // 39: petstore.pets.v1.PetService.UploadImage:input_type -> petstore.pets.v1.UploadImageRequest
+	29, // 40: petstore.pets.v1.PetService.ImportPets:input_type -> petstore.pets.v1.ImportPetsRequest
+	33, // 41: petstore.pets.v1.PetService.ExportPets:input_type -> petstore.pets.v1.ExportPetsRequest
+	3,  // 42: petstore.pets.v1.PetService.AddPet:output_type -> petstore.pets.v1.Pet
+	3,  // 43: petstore.pets.v1.PetService.UpdatePet:output_type -> petstore.pets.v1.Pet
+	3,  // 44: petstore.pets.v1.PetService.GetPet:output_type -> petstore.pets.v1.Pet
+	15, // 45: petstore.pets.v1.PetService.FindPetsByStatus:output_type -> petstore.pets.v1.FindPetsResponse
+	15, // 46: petstore.pets.v1.PetService.FindPetsByTags:output_type -> petstore.pets.v1.FindPetsResponse
+	17, // 47: petstore.pets.v1.PetService.ListPets:output_type -> petstore.pets.v1.ListPetsResponse
+	19, // 48: petstore.pets.v1.PetService.BatchGetPets:output_type -> petstore.pets.v1.BatchGetPetsResponse
+	22, // 49: petstore.pets.v1.PetService.BatchUpdatePetStatus:output_type -> petstore.pets.v1.BatchUpdatePetStatusResponse
+	24, // 50: petstore.pets.v1.PetService.DeletePet:output_type -> petstore.pets.v1.DeletePetResponse
+	3,  // 51: petstore.pets.v1.PetService.GroomPet:output_type -> petstore.pets.v1.Pet
+	27, // 52: petstore.pets.v1.PetService.UploadImage:output_type -> petstore.pets.v1.UploadImageResponse
+	32, // 53: petstore.pets.v1.PetService.ImportPets:output_type -> petstore.pets.v1.ImportPetsResponse
+	3,  // 54: petstore.pets.v1.PetService.ExportPets:output_type -> petstore.pets.v1.Pet
+	42, // [42:55] is the sub-list for method output_type
+	29, // [29:42] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_petstore_pets_v1_pets_proto_init() }
+func file_petstore_pets_v1_pets_proto_init() {
+	if File_petstore_pets_v1_pets_proto != nil {
+		return
+	}
+	file_petstore_pets_v1_pets_proto_msgTypes[4].OneofWrappers = []any{}
+	file_petstore_pets_v1_pets_proto_msgTypes[26].OneofWrappers = []any{
+		(*ImportPetsRequest_Options)(nil),
+		(*ImportPetsRequest_Pet)(nil),
+	}
+	file_petstore_pets_v1_pets_proto_msgTypes[29].OneofWrappers = []any{
+		(*ImportPetsResponse_Row)(nil),
+		(*ImportPetsResponse_Summary)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_petstore_pets_v1_pets_proto_rawDesc), len(file_petstore_pets_v1_pets_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_petstore_pets_v1_pets_proto_goTypes,
+		DependencyIndexes: file_petstore_pets_v1_pets_proto_depIdxs,
+		EnumInfos:         file_petstore_pets_v1_pets_proto_enumTypes,
+		MessageInfos:      file_petstore_pets_v1_pets_proto_msgTypes,
+	}.Build()
+	File_petstore_pets_v1_pets_proto = out.File
+	file_petstore_pets_v1_pets_proto_goTypes = nil
+	file_petstore_pets_v1_pets_proto_depIdxs = nil
+}
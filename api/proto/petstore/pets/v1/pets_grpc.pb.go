@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: petstore/pets/v1/pets.proto
+
+package petsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PetService_AddPet_FullMethodName               = "/petstore.pets.v1.PetService/AddPet"
+	PetService_UpdatePet_FullMethodName            = "/petstore.pets.v1.PetService/UpdatePet"
+	PetService_GetPet_FullMethodName               = "/petstore.pets.v1.PetService/GetPet"
+	PetService_FindPetsByStatus_FullMethodName     = "/petstore.pets.v1.PetService/FindPetsByStatus"
+	PetService_FindPetsByTags_FullMethodName       = "/petstore.pets.v1.PetService/FindPetsByTags"
+	PetService_ListPets_FullMethodName             = "/petstore.pets.v1.PetService/ListPets"
+	PetService_BatchGetPets_FullMethodName         = "/petstore.pets.v1.PetService/BatchGetPets"
+	PetService_BatchUpdatePetStatus_FullMethodName = "/petstore.pets.v1.PetService/BatchUpdatePetStatus"
+	PetService_DeletePet_FullMethodName            = "/petstore.pets.v1.PetService/DeletePet"
+	PetService_GroomPet_FullMethodName             = "/petstore.pets.v1.PetService/GroomPet"
+	PetService_UploadImage_FullMethodName          = "/petstore.pets.v1.PetService/UploadImage"
+	PetService_ImportPets_FullMethodName           = "/petstore.pets.v1.PetService/ImportPets"
+	PetService_ExportPets_FullMethodName           = "/petstore.pets.v1.PetService/ExportPets"
+)
+
+// PetServiceClient is the client API for PetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PetService exposes the pets use cases (internal/domains/pets/ports.Service) to internal callers.
+// Partner imports are not offered here; they arrive through the signed partner webhook.
+type PetServiceClient interface {
+	// AddPet creates a pet. Requests repeating an idempotency key return the pet created first.
+	AddPet(ctx context.Context, in *AddPetRequest, opts ...grpc.CallOption) (*Pet, error)
+	// UpdatePet applies the fields set on the mutation to an existing pet.
+	UpdatePet(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*Pet, error)
+	GetPet(ctx context.Context, in *GetPetRequest, opts ...grpc.CallOption) (*Pet, error)
+	FindPetsByStatus(ctx context.Context, in *FindPetsByStatusRequest, opts ...grpc.CallOption) (*FindPetsResponse, error)
+	FindPetsByTags(ctx context.Context, in *FindPetsByTagsRequest, opts ...grpc.CallOption) (*FindPetsResponse, error)
+	ListPets(ctx context.Context, in *ListPetsRequest, opts ...grpc.CallOption) (*ListPetsResponse, error)
+	// BatchGetPets loads up to 1000 pets; unknown ids are listed instead of failing the call.
+	BatchGetPets(ctx context.Context, in *BatchGetPetsRequest, opts ...grpc.CallOption) (*BatchGetPetsResponse, error)
+	// BatchUpdatePetStatus moves many pets to one status. A rejected atomic batch fails with
+	// FAILED_PRECONDITION and writes nothing.
+	BatchUpdatePetStatus(ctx context.Context, in *BatchUpdatePetStatusRequest, opts ...grpc.CallOption) (*BatchUpdatePetStatusResponse, error)
+	DeletePet(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*DeletePetResponse, error)
+	GroomPet(ctx context.Context, in *GroomPetRequest, opts ...grpc.CallOption) (*Pet, error)
+	UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error)
+	// ImportPets streams pets in and reports each row as it is processed, then a summary. The
+	// optional options message must come first.
+	ImportPets(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportPetsRequest, ImportPetsResponse], error)
+	// ExportPets streams the whole catalog.
+	ExportPets(ctx context.Context, in *ExportPetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pet], error)
+}
+
+type petServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPetServiceClient(cc grpc.ClientConnInterface) PetServiceClient {
+	return &petServiceClient{cc}
+}
+
+func (c *petServiceClient) AddPet(ctx context.Context, in *AddPetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, PetService_AddPet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) UpdatePet(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, PetService_UpdatePet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) GetPet(ctx context.Context, in *GetPetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, PetService_GetPet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) FindPetsByStatus(ctx context.Context, in *FindPetsByStatusRequest, opts ...grpc.CallOption) (*FindPetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPetsResponse)
+	err := c.cc.Invoke(ctx, PetService_FindPetsByStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) FindPetsByTags(ctx context.Context, in *FindPetsByTagsRequest, opts ...grpc.CallOption) (*FindPetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPetsResponse)
+	err := c.cc.Invoke(ctx, PetService_FindPetsByTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ListPets(ctx context.Context, in *ListPetsRequest, opts ...grpc.CallOption) (*ListPetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPetsResponse)
+	err := c.cc.Invoke(ctx, PetService_ListPets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) BatchGetPets(ctx context.Context, in *BatchGetPetsRequest, opts ...grpc.CallOption) (*BatchGetPetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetPetsResponse)
+	err := c.cc.Invoke(ctx, PetService_BatchGetPets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) BatchUpdatePetStatus(ctx context.Context, in *BatchUpdatePetStatusRequest, opts ...grpc.CallOption) (*BatchUpdatePetStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdatePetStatusResponse)
+	err := c.cc.Invoke(ctx, PetService_BatchUpdatePetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) DeletePet(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*DeletePetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePetResponse)
+	err := c.cc.Invoke(ctx, PetService_DeletePet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) GroomPet(ctx context.Context, in *GroomPetRequest, opts ...grpc.CallOption) (*Pet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Pet)
+	err := c.cc.Invoke(ctx, PetService_GroomPet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadImageResponse)
+	err := c.cc.Invoke(ctx, PetService_UploadImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *petServiceClient) ImportPets(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ImportPetsRequest, ImportPetsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PetService_ServiceDesc.Streams[0], PetService_ImportPets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportPetsRequest, ImportPetsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_ImportPetsClient = grpc.BidiStreamingClient[ImportPetsRequest, ImportPetsResponse]
+
+func (c *petServiceClient) ExportPets(ctx context.Context, in *ExportPetsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Pet], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PetService_ServiceDesc.Streams[1], PetService_ExportPets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportPetsRequest, Pet]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_ExportPetsClient = grpc.ServerStreamingClient[Pet]
+
+// PetServiceServer is the server API for PetService service.
+// All implementations must embed UnimplementedPetServiceServer
+// for forward compatibility.
+//
+// PetService exposes the pets use cases (internal/domains/pets/ports.Service) to internal callers.
+// Partner imports are not offered here; they arrive through the signed partner webhook.
+type PetServiceServer interface {
+	// AddPet creates a pet. Requests repeating an idempotency key return the pet created first.
+	AddPet(context.Context, *AddPetRequest) (*Pet, error)
+	// UpdatePet applies the fields set on the mutation to an existing pet.
+	UpdatePet(context.Context, *UpdatePetRequest) (*Pet, error)
+	GetPet(context.Context, *GetPetRequest) (*Pet, error)
+	FindPetsByStatus(context.Context, *FindPetsByStatusRequest) (*FindPetsResponse, error)
+	FindPetsByTags(context.Context, *FindPetsByTagsRequest) (*FindPetsResponse, error)
+	ListPets(context.Context, *ListPetsRequest) (*ListPetsResponse, error)
+	// BatchGetPets loads up to 1000 pets; unknown ids are listed instead of failing the call.
+	BatchGetPets(context.Context, *BatchGetPetsRequest) (*BatchGetPetsResponse, error)
+	// BatchUpdatePetStatus moves many pets to one status. A rejected atomic batch fails with
+	// FAILED_PRECONDITION and writes nothing.
+	BatchUpdatePetStatus(context.Context, *BatchUpdatePetStatusRequest) (*BatchUpdatePetStatusResponse, error)
+	DeletePet(context.Context, *DeletePetRequest) (*DeletePetResponse, error)
+	GroomPet(context.Context, *GroomPetRequest) (*Pet, error)
+	UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error)
+	// ImportPets streams pets in and reports each row as it is processed, then a summary. The
+	// optional options message must come first.
+	ImportPets(grpc.BidiStreamingServer[ImportPetsRequest, ImportPetsResponse]) error
+	// ExportPets streams the whole catalog.
+	ExportPets(*ExportPetsRequest, grpc.ServerStreamingServer[Pet]) error
+	mustEmbedUnimplementedPetServiceServer()
+}
+
+// UnimplementedPetServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPetServiceServer struct{}
+
+func (UnimplementedPetServiceServer) AddPet(context.Context, *AddPetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPet not implemented")
+}
+func (UnimplementedPetServiceServer) UpdatePet(context.Context, *UpdatePetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePet not implemented")
+}
+func (UnimplementedPetServiceServer) GetPet(context.Context, *GetPetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPet not implemented")
+}
+func (UnimplementedPetServiceServer) FindPetsByStatus(context.Context, *FindPetsByStatusRequest) (*FindPetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPetsByStatus not implemented")
+}
+func (UnimplementedPetServiceServer) FindPetsByTags(context.Context, *FindPetsByTagsRequest) (*FindPetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPetsByTags not implemented")
+}
+func (UnimplementedPetServiceServer) ListPets(context.Context, *ListPetsRequest) (*ListPetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPets not implemented")
+}
+func (UnimplementedPetServiceServer) BatchGetPets(context.Context, *BatchGetPetsRequest) (*BatchGetPetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPets not implemented")
+}
+func (UnimplementedPetServiceServer) BatchUpdatePetStatus(context.Context, *BatchUpdatePetStatusRequest) (*BatchUpdatePetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdatePetStatus not implemented")
+}
+func (UnimplementedPetServiceServer) DeletePet(context.Context, *DeletePetRequest) (*DeletePetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePet not implemented")
+}
+func (UnimplementedPetServiceServer) GroomPet(context.Context, *GroomPetRequest) (*Pet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GroomPet not implemented")
+}
+func (UnimplementedPetServiceServer) UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
+}
+func (UnimplementedPetServiceServer) ImportPets(grpc.BidiStreamingServer[ImportPetsRequest, ImportPetsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportPets not implemented")
+}
+func (UnimplementedPetServiceServer) ExportPets(*ExportPetsRequest, grpc.ServerStreamingServer[Pet]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPets not implemented")
+}
+func (UnimplementedPetServiceServer) mustEmbedUnimplementedPetServiceServer() {}
+func (UnimplementedPetServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafePetServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PetServiceServer will
+// result in compilation errors.
+type UnsafePetServiceServer interface {
+	mustEmbedUnimplementedPetServiceServer()
+}
+
+func RegisterPetServiceServer(s grpc.ServiceRegistrar, srv PetServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPetServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PetService_ServiceDesc, srv)
+}
+
+func _PetService_AddPet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).AddPet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_AddPet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).AddPet(ctx, req.(*AddPetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_UpdatePet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).UpdatePet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_UpdatePet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).UpdatePet(ctx, req.(*UpdatePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_GetPet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).GetPet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_GetPet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).GetPet(ctx, req.(*GetPetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_FindPetsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPetsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).FindPetsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_FindPetsByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).FindPetsByStatus(ctx, req.(*FindPetsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_FindPetsByTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPetsByTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).FindPetsByTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_FindPetsByTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).FindPetsByTags(ctx, req.(*FindPetsByTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ListPets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).ListPets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_ListPets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).ListPets(ctx, req.(*ListPetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_BatchGetPets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).BatchGetPets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_BatchGetPets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).BatchGetPets(ctx, req.(*BatchGetPetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_BatchUpdatePetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdatePetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).BatchUpdatePetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_BatchUpdatePetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).BatchUpdatePetStatus(ctx, req.(*BatchUpdatePetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_DeletePet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).DeletePet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_DeletePet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).DeletePet(ctx, req.(*DeletePetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_GroomPet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroomPetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).GroomPet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_GroomPet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).GroomPet(ctx, req.(*GroomPetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_UploadImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetServiceServer).UploadImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetService_UploadImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetServiceServer).UploadImage(ctx, req.(*UploadImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PetService_ImportPets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PetServiceServer).ImportPets(&grpc.GenericServerStream[ImportPetsRequest, ImportPetsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_ImportPetsServer = grpc.BidiStreamingServer[ImportPetsRequest, ImportPetsResponse]
+
+func _PetService_ExportPets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPetsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PetServiceServer).ExportPets(m, &grpc.GenericServerStream[ExportPetsRequest, Pet]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PetService_ExportPetsServer = grpc.ServerStreamingServer[Pet]
+
+// PetService_ServiceDesc is the grpc.ServiceDesc for PetService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PetService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "petstore.pets.v1.PetService",
+	HandlerType: (*PetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddPet",
+			Handler:    _PetService_AddPet_Handler,
+		},
+		{
+			MethodName: "UpdatePet",
+			Handler:    _PetService_UpdatePet_Handler,
+		},
+		{
+			MethodName: "GetPet",
+			Handler:    _PetService_GetPet_Handler,
+		},
+		{
+			MethodName: "FindPetsByStatus",
+			Handler:    _PetService_FindPetsByStatus_Handler,
+		},
+		{
+			MethodName: "FindPetsByTags",
+			Handler:    _PetService_FindPetsByTags_Handler,
+		},
+		{
+			MethodName: "ListPets",
+			Handler:    _PetService_ListPets_Handler,
+		},
+		{
+			MethodName: "BatchGetPets",
+			Handler:    _PetService_BatchGetPets_Handler,
+		},
+		{
+			MethodName: "BatchUpdatePetStatus",
+			Handler:    _PetService_BatchUpdatePetStatus_Handler,
+		},
+		{
+			MethodName: "DeletePet",
+			Handler:    _PetService_DeletePet_Handler,
+		},
+		{
+			MethodName: "GroomPet",
+			Handler:    _PetService_GroomPet_Handler,
+		},
+		{
+			MethodName: "UploadImage",
+			Handler:    _PetService_UploadImage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportPets",
+			Handler:       _PetService_ImportPets_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportPets",
+			Handler:       _PetService_ExportPets_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "petstore/pets/v1/pets.proto",
+}
@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.28.3
+// source: petstore/store/v1/store.proto
+
+package storev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrderStatus int32
+
+const (
+	// ORDER_STATUS_UNSPECIFIED places new orders as ORDER_STATUS_PLACED.
+	OrderStatus_ORDER_STATUS_UNSPECIFIED OrderStatus = 0
+	OrderStatus_ORDER_STATUS_PLACED      OrderStatus = 1
+	OrderStatus_ORDER_STATUS_APPROVED    OrderStatus = 2
+	OrderStatus_ORDER_STATUS_DELIVERED   OrderStatus = 3
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_PLACED",
+		2: "ORDER_STATUS_APPROVED",
+		3: "ORDER_STATUS_DELIVERED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED": 0,
+		"ORDER_STATUS_PLACED":      1,
+		"ORDER_STATUS_APPROVED":    2,
+		"ORDER_STATUS_DELIVERED":   3,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_petstore_store_v1_store_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_petstore_store_v1_store_proto_enumTypes[0]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_petstore_store_v1_store_proto_rawDescGZIP(), []int{0}
+}
+
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PetId         int64                  `protobuf:"varint,2,opt,name=pet_id,json=petId,proto3" json:"pet_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ShipTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ship_time,json=shipTime,proto3" json:"ship_time,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=petstore.store.v1.OrderStatus" json:"status,omitempty"`
+	Complete      bool                   `protobuf:"varint,6,opt,name=complete,proto3" json:"complete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_petstore_store_v1_store_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_store_v1_store_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_petstore_store_v1_store_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Order) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetPetId() int64 {
+	if x != nil {
+		return x.PetId
+	}
+	return 0
+}
+
+func (x *Order) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Order) GetShipTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShipTime
+	}
+	return nil
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type PlaceOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlaceOrderRequest) Reset() {
+	*x = PlaceOrderRequest{}
+	mi := &file_petstore_store_v1_store_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaceOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceOrderRequest) ProtoMessage() {}
+
+func (x *PlaceOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_store_v1_store_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceOrderRequest.ProtoReflect.Descriptor instead.
+func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_store_v1_store_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlaceOrderRequest) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_petstore_store_v1_store_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_store_v1_store_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_store_v1_store_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrderRequest) Reset() {
+	*x = DeleteOrderRequest{}
+	mi := &file_petstore_store_v1_store_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderRequest) ProtoMessage() {}
+
+func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_store_v1_store_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_store_v1_store_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteOrderRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrderResponse) Reset() {
+	*x = DeleteOrderResponse{}
+	mi := &file_petstore_store_v1_store_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderResponse) ProtoMessage() {}
+
+func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_store_v1_store_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_petstore_store_v1_store_proto_rawDescGZIP(), []int{4}
+}
+
+type GetInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInventoryRequest) Reset() {
+	*x = GetInventoryRequest{}
+	mi := &file_petstore_store_v1_store_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryRequest) ProtoMessage() {}
+
+func (x *GetInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_store_v1_store_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryRequest.ProtoReflect.Descriptor instead.
+func (*GetInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_petstore_store_v1_store_proto_rawDescGZIP(), []int{5}
+}
+
+type GetInventoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// counts maps a pet status to the number of pets that have it.
+	Counts        map[string]int32 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInventoryResponse) Reset() {
+	*x = GetInventoryResponse{}
+	mi := &file_petstore_store_v1_store_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryResponse) ProtoMessage() {}
+
+func (x *GetInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_petstore_store_v1_store_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryResponse.ProtoReflect.Descriptor instead.
+func (*GetInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_petstore_store_v1_store_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetInventoryResponse) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+var File_petstore_store_v1_store_proto protoreflect.FileDescriptor
+
+const file_petstore_store_v1_store_proto_rawDesc = "" +
+	"\n" +
+	"\x1dpetstore/store/v1/store.proto\x12\x11petstore.store.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd7\x01\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06pet_id\x18\x02 \x01(\x03R\x05petId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x127\n" +
+	"\tship_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bshipTime\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.petstore.store.v1.OrderStatusR\x06status\x12\x1a\n" +
+	"\bcomplete\x18\x06 \x01(\bR\bcomplete\"C\n" +
+	"\x11PlaceOrderRequest\x12.\n" +
+	"\x05order\x18\x01 \x01(\v2\x18.petstore.store.v1.OrderR\x05order\"!\n" +
+	"\x0fGetOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"$\n" +
+	"\x12DeleteOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x15\n" +
+	"\x13DeleteOrderResponse\"\x15\n" +
+	"\x13GetInventoryRequest\"\x9e\x01\n" +
+	"\x14GetInventoryResponse\x12K\n" +
+	"\x06counts\x18\x01 \x03(\v23.petstore.store.v1.GetInventoryResponse.CountsEntryR\x06counts\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01*{\n" +
+	"\vOrderStatus\x12\x1c\n" +
+	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ORDER_STATUS_PLACED\x10\x01\x12\x19\n" +
+	"\x15ORDER_STATUS_APPROVED\x10\x02\x12\x1a\n" +
+	"\x16ORDER_STATUS_DELIVERED\x10\x032\xe5\x02\n" +
+	"\fStoreService\x12L\n" +
+	"\n" +
+	"PlaceOrder\x12$.petstore.store.v1.PlaceOrderRequest\x1a\x18.petstore.store.v1.Order\x12H\n" +
+	"\bGetOrder\x12\".petstore.store.v1.GetOrderRequest\x1a\x18.petstore.store.v1.Order\x12\\\n" +
+	"\vDeleteOrder\x12%.petstore.store.v1.DeleteOrderRequest\x1a&.petstore.store.v1.DeleteOrderResponse\x12_\n" +
+	"\fGetInventory\x12&.petstore.store.v1.GetInventoryRequest\x1a'.petstore.store.v1.GetInventoryResponseBIZGgithub.com/Apurer/go-gin-api-server/api/proto/petstore/store/v1;storev1b\x06proto3"
+
+var (
+	file_petstore_store_v1_store_proto_rawDescOnce sync.Once
+	file_petstore_store_v1_store_proto_rawDescData []byte
+)
+
+func file_petstore_store_v1_store_proto_rawDescGZIP() []byte {
+	file_petstore_store_v1_store_proto_rawDescOnce.Do(func() {
+		file_petstore_store_v1_store_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_petstore_store_v1_store_proto_rawDesc), len(file_petstore_store_v1_store_proto_rawDesc)))
+	})
+	return file_petstore_store_v1_store_proto_rawDescData
+}
+
+var file_petstore_store_v1_store_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_petstore_store_v1_store_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_petstore_store_v1_store_proto_goTypes = []any{
+	(OrderStatus)(0),              // 0: petstore.store.v1.OrderStatus
+	(*Order)(nil),                 // 1: petstore.store.v1.Order
+	(*PlaceOrderRequest)(nil),     // 2: petstore.store.v1.PlaceOrderRequest
+	(*GetOrderRequest)(nil),       // 3: petstore.store.v1.GetOrderRequest
+	(*DeleteOrderRequest)(nil),    // 4: petstore.store.v1.DeleteOrderRequest
+	(*DeleteOrderResponse)(nil),   // 5: petstore.store.v1.DeleteOrderResponse
+	(*GetInventoryRequest)(nil),   // 6: petstore.store.v1.GetInventoryRequest
+	(*GetInventoryResponse)(nil),  // 7: petstore.store.v1.GetInventoryResponse
+	nil,                           // 8: petstore.store.v1.GetInventoryResponse.CountsEntry
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+}
+var file_petstore_store_v1_store_proto_depIdxs = []int32{
+	9, // 0: petstore.store.v1.Order.ship_time:type_name -> google.protobuf.Timestamp
+	0, // 1: petstore.store.v1.Order.status:type_name -> petstore.store.v1.OrderStatus
+	1, // 2: petstore.store.v1.PlaceOrderRequest.order:type_name -> petstore.store.v1.Order
+	8, // 3: petstore.store.v1.GetInventoryResponse.counts:type_name -> petstore.store.v1.GetInventoryResponse.CountsEntry
+	2, // 4: petstore.store.v1.StoreService.PlaceOrder:input_type -> petstore.store.v1.PlaceOrderRequest
+	3, // 5: petstore.store.v1.StoreService.GetOrder:input_type -> petstore.store.v1.GetOrderRequest
+	4, // 6: petstore.store.v1.StoreService.DeleteOrder:input_type -> petstore.store.v1.DeleteOrderRequest
+	6, // 7: petstore.store.v1.StoreService.GetInventory:input_type -> petstore.store.v1.GetInventoryRequest
+	1, // 8: petstore.store.v1.StoreService.PlaceOrder:output_type -> petstore.store.v1.Order
+	1, // 9: petstore.store.v1.StoreService.GetOrder:output_type -> petstore.store.v1.Order
+	5, // 10: petstore.store.v1.StoreService.DeleteOrder:output_type -> petstore.store.v1.DeleteOrderResponse
+	7, // 11: petstore.store.v1.StoreService.GetInventory:output_type -> petstore.store.v1.GetInventoryResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_petstore_store_v1_store_proto_init() }
+func file_petstore_store_v1_store_proto_init() {
+	if File_petstore_store_v1_store_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_petstore_store_v1_store_proto_rawDesc), len(file_petstore_store_v1_store_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_petstore_store_v1_store_proto_goTypes,
+		DependencyIndexes: file_petstore_store_v1_store_proto_depIdxs,
+		EnumInfos:         file_petstore_store_v1_store_proto_enumTypes,
+		MessageInfos:      file_petstore_store_v1_store_proto_msgTypes,
+	}.Build()
+	File_petstore_store_v1_store_proto = out.File
+	file_petstore_store_v1_store_proto_goTypes = nil
+	file_petstore_store_v1_store_proto_depIdxs = nil
+}
@@ -3,12 +3,17 @@ package main
 import (
 	"context"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
 
 	apiapp "github.com/Apurer/go-gin-api-server/internal/app/api"
 )
 
 func main() {
-	if err := apiapp.Run(context.Background()); err != nil {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := apiapp.Run(ctx); err != nil {
 		log.Fatal(err)
 	}
 }
@@ -0,0 +1,95 @@
+// Command apikeys manages the API keys accepted for the api_key security scheme.
+//
+//	apikeys issue -name ops [-ttl 720h]
+//	apikeys revoke -id <key id>
+//	apikeys list
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	platformmigrations "github.com/Apurer/go-gin-api-server/internal/platform/migrations"
+	platformpostgres "github.com/Apurer/go-gin-api-server/internal/platform/postgres"
+	platformsecurity "github.com/Apurer/go-gin-api-server/internal/platform/security"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	db, cleanup := platformpostgres.ConnectFromEnv(ctx, logger)
+	defer cleanup()
+	if db == nil {
+		log.Fatal("POSTGRES_DSN not set or connection failed; cannot manage api keys")
+	}
+	if err := platformmigrations.Run(db); err != nil {
+		log.Fatalf("run migrations: %v", err)
+	}
+	store := platformsecurity.NewPostgresAPIKeyStore(db)
+
+	switch os.Args[1] {
+	case "issue":
+		fs := flag.NewFlagSet("issue", flag.ExitOnError)
+		name := fs.String("name", "", "who or what the key is for")
+		ttl := fs.Duration("ttl", 0, "lifetime of the key (default: never expires)")
+		_ = fs.Parse(os.Args[2:])
+		if *name == "" {
+			log.Fatal("-name is required")
+		}
+		secret, key, err := store.Issue(ctx, *name, *ttl)
+		if err != nil {
+			log.Fatalf("issue api key: %v", err)
+		}
+		fmt.Printf("id:     %s\nname:   %s\nsecret: %s\n", key.ID, key.Name, secret)
+		fmt.Fprintln(os.Stderr, "store the secret now; it cannot be shown again")
+	case "revoke":
+		fs := flag.NewFlagSet("revoke", flag.ExitOnError)
+		id := fs.String("id", "", "id of the key to revoke")
+		_ = fs.Parse(os.Args[2:])
+		if *id == "" {
+			log.Fatal("-id is required")
+		}
+		if err := store.Revoke(ctx, *id); err != nil {
+			log.Fatalf("revoke api key: %v", err)
+		}
+		log.Printf("api key %s revoked", *id)
+	case "list":
+		keys, err := store.List(ctx)
+		if err != nil {
+			log.Fatalf("list api keys: %v", err)
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tCREATED\tEXPIRES\tSTATUS")
+		now := time.Now()
+		for _, key := range keys {
+			expires := "-"
+			if key.ExpiresAt != nil {
+				expires = key.ExpiresAt.Format(time.RFC3339)
+			}
+			status := "active"
+			if !key.Active(now) {
+				status = "inactive"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", key.ID, key.Name, key.CreatedAt.Format(time.RFC3339), expires, status)
+		}
+		_ = w.Flush()
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: apikeys issue -name NAME [-ttl DURATION] | revoke -id ID | list")
+	os.Exit(2)
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
+	petspostgres "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/persistence/postgres"
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	platformpostgres "github.com/Apurer/go-gin-api-server/internal/platform/postgres"
+	petworkflows "github.com/Apurer/go-gin-api-server/internal/platform/temporal/workflows/pets"
+)
+
+// Exit code returned by -fail-on-drift when unrepaired differences remain.
+const exitDrift = 2
+
+func main() {
+	providers := flag.String("providers", "", "comma-separated provider names to reconcile (default: all configured)")
+	repair := flag.Bool("repair", false, "re-run SyncPetWithPartner for drifted and missing-remote records")
+	pageSize := flag.Int("page-size", petspartner.DefaultReconcilePageSize, "pets loaded per local and remote page")
+	output := flag.String("output", "", "write the JSON report to this file instead of stdout")
+	inline := flag.Bool("inline", false, "reconcile in-process instead of starting the Temporal workflow (report only)")
+	failOnDrift := flag.Bool("fail-on-drift", false, "exit with status 2 when unrepaired differences remain")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	input := petworkflows.PartnerReconciliationWorkflowInput{
+		Providers: splitProviders(*providers),
+		PageSize:  *pageSize,
+		Repair:    *repair,
+	}
+	var (
+		report *petstypes.ReconciliationReport
+		err    error
+	)
+	if *inline {
+		if input.Repair {
+			log.Fatal("-repair needs the Temporal workflow; drop -inline")
+		}
+		report, err = reconcileInline(ctx, logger, input)
+	} else {
+		report, err = reconcileWithWorkflow(ctx, input)
+	}
+	if err != nil {
+		log.Fatalf("partner reconciliation failed: %v", err)
+	}
+	if err := writeReport(report, *output); err != nil {
+		log.Fatalf("failed to write reconciliation report: %v", err)
+	}
+	if *failOnDrift && report.Unrepaired() {
+		os.Exit(exitDrift)
+	}
+}
+
+func reconcileInline(ctx context.Context, logger *slog.Logger, input petworkflows.PartnerReconciliationWorkflowInput) (*petstypes.ReconciliationReport, error) {
+	db, cleanup := platformpostgres.ConnectFromEnv(ctx, logger)
+	defer cleanup()
+	if db == nil {
+		return nil, fmt.Errorf("POSTGRES_DSN not set or connection failed")
+	}
+	configs, err := petspartner.LoadProviderConfigs()
+	if err != nil {
+		return nil, err
+	}
+	resilience, err := partnerclient.LoadResilienceConfig()
+	if err != nil {
+		return nil, err
+	}
+	registry, err := petspartner.BuildRegistry(configs, partnerclient.WithResilience(resilience))
+	if err != nil {
+		return nil, err
+	}
+	if registry == nil {
+		return nil, fmt.Errorf("no partner providers configured (set PARTNER_API_BASE_URL or PARTNER_PROVIDERS)")
+	}
+	reconciler := petspartner.NewReconciler(petspostgres.NewRepository(db), registry)
+	return reconciler.Reconcile(ctx, petspartner.ReconcileInput{Providers: input.Providers, PageSize: input.PageSize})
+}
+
+func reconcileWithWorkflow(ctx context.Context, input petworkflows.PartnerReconciliationWorkflowInput) (*petstypes.ReconciliationReport, error) {
+	temporalClient, err := client.Dial(client.Options{
+		HostPort:  envOrDefault("TEMPORAL_ADDRESS", client.DefaultHostPort),
+		Namespace: envOrDefault("TEMPORAL_NAMESPACE", client.DefaultNamespace),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("connect to Temporal: %w", err)
+	}
+	defer temporalClient.Close()
+	run, err := temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("partner-reconciliation-%d", time.Now().UnixNano()),
+		TaskQueue: petworkflows.PetCreationTaskQueue,
+	}, petworkflows.PartnerReconciliationWorkflowName, input)
+	if err != nil {
+		return nil, fmt.Errorf("start reconciliation workflow: %w", err)
+	}
+	var report petstypes.ReconciliationReport
+	if err := run.Get(ctx, &report); err != nil {
+		return nil, fmt.Errorf("reconciliation workflow %s: %w", run.GetID(), err)
+	}
+	return &report, nil
+}
+
+func writeReport(report *petstypes.ReconciliationReport, path string) error {
+	var w io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+func splitProviders(raw string) []string {
+	var providers []string
+	for _, name := range strings.Split(raw, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			providers = append(providers, name)
+		}
+	}
+	return providers
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Apurer/go-gin-api-server/internal/clients/http/partner/partnerstub"
+)
+
+func main() {
+	addr := flag.String("addr", envOrDefault("PARTNER_STUB_ADDR", ":8090"), "listen address")
+	latency := flag.Duration("latency", 0, "delay added to every partner API response")
+	errorRate := flag.Float64("error-rate", 0, "fraction (0..1) of partner API calls that fail")
+	errorStatus := flag.Int("error-status", http.StatusServiceUnavailable, "HTTP status used for random failures")
+	flag.Parse()
+
+	stub := partnerstub.New(
+		partnerstub.WithLatency(*latency),
+		partnerstub.WithErrorRate(*errorRate, *errorStatus),
+	)
+	server := &http.Server{Addr: *addr, Handler: stub, ReadHeaderTimeout: 5 * time.Second}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("partner stub listening on %s (inspection API under %s)", *addr, partnerstub.InspectionPrefix)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("partner stub failed: %v", err)
+	}
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -2,10 +2,10 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"log/slog"
 	"os"
-	"strings"
 	"time"
 
 	"go.temporal.io/sdk/activity"
@@ -18,6 +18,7 @@ import (
 	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
 	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
 	petsmemory "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	petsnotifications "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/notifications"
 	petsobs "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/observability"
 	petspostgres "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/persistence/postgres"
 	petsapp "github.com/Apurer/go-gin-api-server/internal/domains/pets/application"
@@ -25,6 +26,7 @@ import (
 	platformobservability "github.com/Apurer/go-gin-api-server/internal/platform/observability"
 	platformpostgres "github.com/Apurer/go-gin-api-server/internal/platform/postgres"
 	petactivities "github.com/Apurer/go-gin-api-server/internal/platform/temporal/activities/pets"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/correlation"
 	petworkflows "github.com/Apurer/go-gin-api-server/internal/platform/temporal/workflows/pets"
 	"gorm.io/gorm"
 )
@@ -32,7 +34,11 @@ import (
 func main() {
 	ctx := context.Background()
 	const serviceName = "petstore-worker"
-	instruments, shutdown, err := platformobservability.Init(ctx, serviceName)
+	obsCfg, err := platformobservability.LoadConfig(serviceName)
+	if err != nil {
+		log.Fatalf("invalid observability config: %v", err)
+	}
+	instruments, shutdown, err := platformobservability.Init(ctx, obsCfg)
 	if err != nil {
 		log.Fatalf("failed to initialize observability: %v", err)
 	}
@@ -49,15 +55,36 @@ func main() {
 	defer cleanupRepo()
 	petRepo := buildPetRepository(db, logger)
 	petIdempotencyStore := buildPetIdempotencyStore(db, logger)
-	partnerSync := buildPartnerSyncFromEnv(logger)
+	partnerRegistry, err := buildPartnerRegistryFromEnv(logger, instruments)
+	if err != nil {
+		logger.Error("invalid partner config", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	var partnerSync petsports.PartnerSync
+	if partnerRegistry != nil {
+		partnerSync = partnerRegistry
+	}
+	petMetrics := petsobs.NewBusinessMetrics(instruments.Meter("internal.pets.business"))
 	// Persistence-only service (no partner sync) to avoid duplicate outbound calls inside activities.
 	persistPetService := petsobs.New(
-		petsapp.NewService(petRepo, petsapp.WithIdempotencyStore(petIdempotencyStore)),
+		petsapp.NewService(petRepo,
+			petsapp.WithIdempotencyStore(petIdempotencyStore),
+			petsapp.WithBusinessMetrics(petMetrics),
+			petsapp.WithEventPublisher(buildPetEventPublisher(db, logger)),
+			petsapp.WithCategories(buildCategoryRepository(db)),
+			petsapp.WithTags(buildTagRepository(db)),
+		),
 		petsobs.WithLogger(logger),
 		petsobs.WithTracer(instruments.Tracer("internal.pets.application")),
 		petsobs.WithMeter(instruments.Meter("internal.pets.application")),
 	)
-	petActivities := petactivities.NewActivities(persistPetService, petRepo, partnerSync)
+	petActivities := petactivities.NewActivities(persistPetService, petRepo, partnerSync,
+		petactivities.WithMetrics(petMetrics),
+		petactivities.WithReconciliation(partnerRegistry),
+		petactivities.WithGroomingReminders(
+			petsapp.NewGroomingReminders(buildAppointmentRepository(db), petsnotifications.NewLogNotifier(logger)),
+		),
+	)
 
 	tracerOptions := temporalotel.TracerOptions{Tracer: instruments.Tracer("temporal-worker")}
 	tracingInterceptor, err := temporalotel.NewTracingInterceptor(tracerOptions)
@@ -71,6 +98,7 @@ func main() {
 		Logger:    workerlog.NewStructuredLogger(logger),
 	}
 	clientOptions.Interceptors = append(clientOptions.Interceptors, tracingInterceptor)
+	clientOptions.ContextPropagators = append(clientOptions.ContextPropagators, correlation.NewPropagator())
 	temporalClient, err := client.Dial(clientOptions)
 	if err != nil {
 		logger.Error("failed to create Temporal client", slog.String("error", err.Error()))
@@ -82,6 +110,11 @@ func main() {
 	w.RegisterWorkflowWithOptions(petworkflows.PetCreationWorkflow, workflow.RegisterOptions{Name: petworkflows.PetCreationWorkflowName})
 	w.RegisterActivityWithOptions(petActivities.PersistPet, activity.RegisterOptions{Name: petactivities.PersistPetActivityName})
 	w.RegisterActivityWithOptions(petActivities.SyncPetWithPartner, activity.RegisterOptions{Name: petactivities.SyncPetWithPartnerActivityName})
+	w.RegisterWorkflowWithOptions(petworkflows.PartnerReconciliationWorkflow, workflow.RegisterOptions{Name: petworkflows.PartnerReconciliationWorkflowName})
+	w.RegisterActivityWithOptions(petActivities.ReconcileLocalPage, activity.RegisterOptions{Name: petactivities.ReconcileLocalPageActivityName})
+	w.RegisterActivityWithOptions(petActivities.ReconcileRemotePage, activity.RegisterOptions{Name: petactivities.ReconcileRemotePageActivityName})
+	w.RegisterWorkflowWithOptions(petworkflows.GroomingReminderWorkflow, workflow.RegisterOptions{Name: petworkflows.GroomingReminderWorkflowName})
+	w.RegisterActivityWithOptions(petActivities.SendGroomingReminder, activity.RegisterOptions{Name: petactivities.SendGroomingReminderActivityName})
 
 	logger.Info("worker listening", slog.String("taskQueue", petworkflows.PetCreationTaskQueue), slog.String("namespace", clientOptions.Namespace))
 	if err := w.Run(worker.InterruptCh()); err != nil {
@@ -100,6 +133,42 @@ func buildPetRepository(db *gorm.DB, logger *slog.Logger) petsports.Repository {
 	return petspostgres.NewRepository(db)
 }
 
+// buildCategoryRepository validates pet categories against the API's categories; the in-memory
+// fallback shares no state with the API, so categories stay free-form there.
+func buildCategoryRepository(db *gorm.DB) petsports.CategoryRepository {
+	if db == nil {
+		return nil
+	}
+	return petspostgres.NewCategoryRepository(db)
+}
+
+// buildTagRepository validates tag IDs against the shared tag catalog; like categories, tags
+// stay free-form on the in-memory fallback.
+func buildTagRepository(db *gorm.DB) petsports.TagRepository {
+	if db == nil {
+		return nil
+	}
+	return petspostgres.NewTagRepository(db)
+}
+
+// buildAppointmentRepository reads the grooming calendar the API books into. The in-memory
+// fallback shares no state with the API, so reminders for unknown appointments are skipped.
+func buildAppointmentRepository(db *gorm.DB) petsports.AppointmentRepository {
+	if db == nil {
+		return petsmemory.NewAppointmentRepository()
+	}
+	return petspostgres.NewAppointmentRepository(db)
+}
+
+// buildPetEventPublisher notifies the API instances of pets written by workflows; without
+// Postgres there is no shared channel to reach them.
+func buildPetEventPublisher(db *gorm.DB, logger *slog.Logger) petsports.PetEventPublisher {
+	if db == nil {
+		return petsports.NoopPetEvents
+	}
+	return petspostgres.NewEventNotifier(db, logger)
+}
+
 func buildPetIdempotencyStore(db *gorm.DB, logger *slog.Logger) petsports.IdempotencyStore {
 	if db == nil {
 		logger.Warn("POSTGRES_DSN not set or unavailable, falling back to in-memory idempotency store")
@@ -109,22 +178,32 @@ func buildPetIdempotencyStore(db *gorm.DB, logger *slog.Logger) petsports.Idempo
 	return petspostgres.NewIdempotencyStore(db)
 }
 
-func buildPartnerSyncFromEnv(logger *slog.Logger) petsports.PartnerSync {
-	baseURL := strings.TrimSpace(os.Getenv("PARTNER_API_BASE_URL"))
-	if baseURL == "" {
-		return nil
+// buildPartnerRegistryFromEnv fails on invalid provider, credential, or resilience settings, like
+// the API, instead of running the worker with partner sync silently disabled.
+func buildPartnerRegistryFromEnv(logger *slog.Logger, instruments *platformobservability.Instruments) (*petspartner.Registry, error) {
+	providers, err := petspartner.LoadProviderConfigs()
+	if err != nil {
+		return nil, fmt.Errorf("partner providers: %w", err)
 	}
-	if logger != nil {
-		logger.Info("partner sync enabled", slog.String("base_url", baseURL))
+	if len(providers) == 0 {
+		return nil, nil
 	}
-	client, err := partnerclient.NewPartnerClient(baseURL, nil)
+	resilience, err := partnerclient.LoadResilienceConfig()
 	if err != nil {
-		if logger != nil {
-			logger.Error("failed to init partner client", slog.String("base_url", baseURL), slog.String("error", err.Error()))
-		}
-		return nil
+		return nil, fmt.Errorf("partner resilience: %w", err)
+	}
+	registry, err := petspartner.BuildRegistry(providers,
+		partnerclient.WithResilience(resilience),
+		partnerclient.WithMeter(instruments.Meter("internal.clients.partner")),
+		partnerclient.WithTracerProvider(instruments.TracerProvider),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("partner providers: %w", err)
+	}
+	if logger != nil {
+		logger.Info("partner sync enabled", slog.Any("providers", registry.Providers()))
 	}
-	return petspartner.NewSyncer(client)
+	return registry, nil
 }
 
 func envOrDefault(key, fallback string) string {
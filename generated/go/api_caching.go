@@ -0,0 +1,119 @@
+package petstoreserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+)
+
+// validators are the conditional-request validators of one representation.
+type validators struct {
+	etag string
+	// lastModified is zero when the resource has no trustworthy modification time.
+	lastModified time.Time
+}
+
+// petValidators derive a strong ETag and Last-Modified from the pet's last update.
+func petValidators(c *gin.Context, pet *petstypes.PetProjection) validators {
+	return validators{
+		etag:         entityTag(c, false, petVersion(pet)),
+		lastModified: pet.Metadata.UpdatedAt,
+	}
+}
+
+// petListValidators derive a weak ETag from the members' versions. No Last-Modified is sent:
+// the newest member timestamp does not change when a pet leaves the collection.
+func petListValidators(c *gin.Context, pets []*petstypes.PetProjection) validators {
+	versions := make([]string, 0, len(pets))
+	for _, pet := range pets {
+		versions = append(versions, petVersion(pet))
+	}
+	return validators{etag: entityTag(c, true, versions...)}
+}
+
+// contentValidators hash the representation for resources without modification metadata.
+func contentValidators(c *gin.Context, body any) validators {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return validators{}
+	}
+	return validators{etag: entityTag(c, false, string(raw))}
+}
+
+func petVersion(pet *petstypes.PetProjection) string {
+	if pet == nil || pet.Pet == nil {
+		return ""
+	}
+	return strconv.FormatInt(pet.Pet.ID, 10) + "@" + strconv.FormatInt(pet.Metadata.UpdatedAt.UnixNano(), 10)
+}
+
+// entityTag hashes the version parts together with the negotiated media type, so the JSON and
+// XML representations of a resource never share a validator.
+func entityTag(c *gin.Context, weak bool, parts ...string) string {
+	h := sha256.New()
+	h.Write([]byte(c.GetString(responseFormatKey)))
+	for _, part := range parts {
+		h.Write([]byte{0})
+		h.Write([]byte(part))
+	}
+	tag := `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+	if weak {
+		return "W/" + tag
+	}
+	return tag
+}
+
+// notModified sets the validator headers and answers 304 when the request's preconditions show
+// the client already holds the current representation. If-None-Match takes precedence over
+// If-Modified-Since (RFC 9110 section 13.2.2); handlers return immediately when it reports true.
+func notModified(c *gin.Context, v validators) bool {
+	c.Header("Vary", "Accept")
+	if v.etag != "" {
+		c.Header("ETag", v.etag)
+	}
+	if !v.lastModified.IsZero() {
+		c.Header("Last-Modified", v.lastModified.UTC().Format(http.TimeFormat))
+	}
+	if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" {
+		if !etagMatches(ifNoneMatch, v.etag) {
+			return false
+		}
+	} else {
+		ifModifiedSince := c.GetHeader("If-Modified-Since")
+		if ifModifiedSince == "" || v.lastModified.IsZero() {
+			return false
+		}
+		since, err := http.ParseTime(ifModifiedSince)
+		// HTTP dates have second precision, so compare against the truncated timestamp.
+		if err != nil || v.lastModified.Truncate(time.Second).After(since) {
+			return false
+		}
+	}
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// etagMatches applies the weak comparison If-None-Match requires to a tag list or "*".
+func etagMatches(header, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+	opaque := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == opaque {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package petstoreserver
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	petdomain "github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// CategoryAPI implements the category management OpenAPI operations.
+type CategoryAPI struct {
+	service petsports.CategoryService
+}
+
+// NewCategoryAPI wires the category service.
+func NewCategoryAPI(service petsports.CategoryService) CategoryAPI {
+	return CategoryAPI{service: service}
+}
+
+func toCategoryMutation(id int64, model Category) petstypes.CategoryMutationInput {
+	input := petstypes.CategoryMutationInput{ID: id, Name: model.Name}
+	if model.ParentId != 0 {
+		parentID := model.ParentId
+		input.ParentID = &parentID
+	}
+	return input
+}
+
+func fromDomainCategory(category *petdomain.Category) Category {
+	model := Category{Id: category.ID, Name: category.Name}
+	if category.ParentID != nil {
+		model.ParentId = *category.ParentID
+	}
+	return model
+}
+
+// Get /v2/category
+// Lists pet categories
+func (api *CategoryAPI) ListCategories(c *gin.Context) {
+	categories, err := api.service.ListCategories(c.Request.Context())
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	body := make([]Category, 0, len(categories))
+	for _, category := range categories {
+		body = append(body, fromDomainCategory(category))
+	}
+	respondList(c, http.StatusOK, "categories", body)
+}
+
+// Post /v2/category
+// Create a pet category
+func (api *CategoryAPI) CreateCategory(c *gin.Context) {
+	var payload Category
+	if !bindBody(c, &payload) {
+		return
+	}
+	created, err := api.service.CreateCategory(c.Request.Context(), toCategoryMutation(payload.Id, payload))
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusCreated, fromDomainCategory(created))
+}
+
+// Get /v2/category/:categoryId
+// Find category by ID
+func (api *CategoryAPI) GetCategoryById(c *gin.Context) {
+	id, ok := parseIDParam(c, "categoryId")
+	if !ok {
+		return
+	}
+	category, err := api.service.GetCategory(c.Request.Context(), id)
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, fromDomainCategory(category))
+}
+
+// Put /v2/category/:categoryId
+// Update a pet category
+func (api *CategoryAPI) UpdateCategory(c *gin.Context) {
+	id, ok := parseIDParam(c, "categoryId")
+	if !ok {
+		return
+	}
+	var payload Category
+	if !bindBody(c, &payload) {
+		return
+	}
+	updated, err := api.service.UpdateCategory(c.Request.Context(), toCategoryMutation(id, payload))
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, fromDomainCategory(updated))
+}
+
+// Delete /v2/category/:categoryId
+// Deletes a pet category
+func (api *CategoryAPI) DeleteCategory(c *gin.Context) {
+	id, ok := parseIDParam(c, "categoryId")
+	if !ok {
+		return
+	}
+	if err := api.service.DeleteCategory(c.Request.Context(), id); err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
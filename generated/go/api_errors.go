@@ -8,6 +8,18 @@ import (
 	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
 )
 
+// serviceErrors answers catalogued domain errors with their coded problems and anything else
+// with a 500.
+var serviceErrors = apierrors.NewChainedResponder("", apierrors.DefaultCatalog.Mapper())
+
+// respondServiceError maps an application or adapter error through the error catalog.
+func respondServiceError(c *gin.Context, err error) {
+	if err == nil {
+		return
+	}
+	serviceErrors.RespondError(c, err)
+}
+
 // respondProblem maps a ProblemDetail through the shared responder.
 func respondProblem(c *gin.Context, problem apierrors.ProblemDetail) {
 	apierrors.Respond(c, problem)
@@ -0,0 +1,210 @@
+package petstoreserver
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	petdomain "github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// GroomingAPI implements the grooming appointment OpenAPI operations.
+type GroomingAPI struct {
+	service petsports.AppointmentService
+}
+
+// NewGroomingAPI wires the appointment service.
+func NewGroomingAPI(service petsports.AppointmentService) GroomingAPI {
+	return GroomingAPI{service: service}
+}
+
+func fromDomainAppointment(appointment *petdomain.Appointment) GroomingAppointment {
+	return GroomingAppointment{
+		Id:              appointment.ID,
+		PetId:           appointment.PetID,
+		Groomer:         appointment.Groomer,
+		StartsAt:        appointment.Slot.Start,
+		EndsAt:          appointment.Slot.End,
+		RequestedTrimCm: appointment.RequestedTrimCm,
+		Status:          string(appointment.Status),
+		RemindedAt:      appointment.RemindedAt,
+		CompletedAt:     appointment.CompletedAt,
+	}
+}
+
+func fromDomainGroomingRecord(record petdomain.GroomingRecord) GroomingRecord {
+	return GroomingRecord{
+		AppointmentId:       record.AppointmentID,
+		PetId:               record.PetID,
+		Groomer:             record.Groomer,
+		InitialHairLengthCm: record.InitialLengthCm,
+		TrimByCm:            record.TrimByCm,
+		ResultHairLengthCm:  record.ResultLengthCm,
+		GroomedAt:           record.GroomedAt,
+	}
+}
+
+// Get /v2/grooming/appointment
+// Lists grooming appointments
+func (api *GroomingAPI) ListGroomingAppointments(c *gin.Context) {
+	filter := petstypes.AppointmentFilter{Groomer: c.Query("groomer")}
+	if value := c.Query("petId"); value != "" {
+		petID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+			return
+		}
+		filter.PetID = petID
+	}
+	var ok bool
+	if filter.From, ok = parseTimeQuery(c, "from"); !ok {
+		return
+	}
+	if filter.To, ok = parseTimeQuery(c, "to"); !ok {
+		return
+	}
+	appointments, err := api.service.ListAppointments(c.Request.Context(), filter)
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	body := make([]GroomingAppointment, 0, len(appointments))
+	for _, appointment := range appointments {
+		body = append(body, fromDomainAppointment(appointment))
+	}
+	respondList(c, http.StatusOK, "appointments", body)
+}
+
+// Post /v2/grooming/appointment
+// Schedule a grooming appointment
+func (api *GroomingAPI) ScheduleGroomingAppointment(c *gin.Context) {
+	var payload GroomingAppointment
+	if !bindBody(c, &payload) {
+		return
+	}
+	scheduled, err := api.service.ScheduleAppointment(c.Request.Context(), petstypes.ScheduleAppointmentInput{
+		PetID:           payload.PetId,
+		Groomer:         payload.Groomer,
+		Start:           payload.StartsAt,
+		End:             payload.EndsAt,
+		RequestedTrimCm: payload.RequestedTrimCm,
+	})
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusCreated, fromDomainAppointment(scheduled))
+}
+
+// Get /v2/grooming/appointment/:appointmentId
+// Find grooming appointment by ID
+func (api *GroomingAPI) GetGroomingAppointmentById(c *gin.Context) {
+	id, ok := parseIDParam(c, "appointmentId")
+	if !ok {
+		return
+	}
+	appointment, err := api.service.GetAppointment(c.Request.Context(), id)
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, fromDomainAppointment(appointment))
+}
+
+// Put /v2/grooming/appointment/:appointmentId
+// Reschedule a grooming appointment
+func (api *GroomingAPI) RescheduleGroomingAppointment(c *gin.Context) {
+	id, ok := parseIDParam(c, "appointmentId")
+	if !ok {
+		return
+	}
+	var payload GroomingSlot
+	if !bindBody(c, &payload) {
+		return
+	}
+	rescheduled, err := api.service.RescheduleAppointment(c.Request.Context(), petstypes.RescheduleAppointmentInput{
+		ID:    id,
+		Start: payload.StartsAt,
+		End:   payload.EndsAt,
+	})
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, fromDomainAppointment(rescheduled))
+}
+
+// Post /v2/grooming/appointment/:appointmentId/cancel
+// Cancel a grooming appointment
+func (api *GroomingAPI) CancelGroomingAppointment(c *gin.Context) {
+	id, ok := parseIDParam(c, "appointmentId")
+	if !ok {
+		return
+	}
+	cancelled, err := api.service.CancelAppointment(c.Request.Context(), id)
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, fromDomainAppointment(cancelled))
+}
+
+// Post /v2/grooming/appointment/:appointmentId/complete
+// Complete a grooming appointment
+func (api *GroomingAPI) CompleteGroomingAppointment(c *gin.Context) {
+	id, ok := parseIDParam(c, "appointmentId")
+	if !ok {
+		return
+	}
+	var payload GroomingCompletion
+	if !bindBody(c, &payload) {
+		return
+	}
+	completed, err := api.service.CompleteAppointment(c.Request.Context(), petstypes.CompleteAppointmentInput{
+		ID:                  id,
+		InitialHairLengthCm: payload.InitialHairLengthCm,
+		TrimByCm:            payload.TrimByCm,
+	})
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, fromDomainAppointment(completed))
+}
+
+// Get /v2/pet/:petId/groomingHistory
+// Grooming history of a pet
+func (api *GroomingAPI) GetPetGroomingHistory(c *gin.Context) {
+	petID, ok := parseIDParam(c, "petId")
+	if !ok {
+		return
+	}
+	history, err := api.service.GroomingHistory(c.Request.Context(), petID)
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	body := make([]GroomingRecord, 0, len(history))
+	for _, record := range history {
+		body = append(body, fromDomainGroomingRecord(record))
+	}
+	respondList(c, http.StatusOK, "groomingRecords", body)
+}
+
+func parseTimeQuery(c *gin.Context, name string) (time.Time, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return time.Time{}, true
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+		return time.Time{}, false
+	}
+	return parsed, true
+}
@@ -0,0 +1,146 @@
+package petstoreserver
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// responseFormatKey stores the media type chosen by negotiateResponse on the gin context.
+const responseFormatKey = "petstore.responseFormat"
+
+// responseFormats are the representations the JSON/XML handlers can produce, in preference order.
+var responseFormats = []string{binding.MIMEJSON, binding.MIMEXML, binding.MIMEXML2}
+
+// negotiateResponse picks JSON or XML from the Accept header before the handler runs, so an
+// unsupported Accept is answered with 406 instead of after the operation has side effects.
+func negotiateResponse(c *gin.Context) {
+	format := c.NegotiateFormat(responseFormats...)
+	if format == "" {
+		respondProblem(c, apierrors.ErrNotAcceptable.
+			WithDetail(fmt.Sprintf("cannot produce any of %q", c.GetHeader("Accept"))).
+			WithExtension("acceptable", responseFormats))
+		c.Abort()
+		return
+	}
+	c.Set(responseFormatKey, format)
+	c.Next()
+}
+
+func wantsXML(c *gin.Context) bool {
+	format := c.GetString(responseFormatKey)
+	return format == binding.MIMEXML || format == binding.MIMEXML2
+}
+
+// respondBody renders body in the negotiated format, defaulting to JSON.
+func respondBody(c *gin.Context, status int, body any) {
+	if wantsXML(c) {
+		c.XML(status, body)
+		return
+	}
+	c.JSON(status, body)
+}
+
+// respondList renders a slice; XML needs a root element, so the items are wrapped in <root>.
+func respondList(c *gin.Context, status int, root string, items any) {
+	if wantsXML(c) {
+		c.XML(status, xmlList{root: root, items: items})
+		return
+	}
+	c.JSON(status, items)
+}
+
+// xmlList writes each item under its own type name, e.g. <pets><Pet/><Pet/></pets>, and reads
+// any such wrapper back into a slice regardless of the element names.
+type xmlList struct {
+	root  string
+	items any
+}
+
+func (l xmlList) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := xml.StartElement{Name: xml.Name{Local: l.root}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	items := reflect.ValueOf(l.items)
+	for i := 0; i < items.Len(); i++ {
+		item := items.Index(i)
+		name := xml.Name{Local: reflect.Indirect(item).Type().Name()}
+		if err := e.EncodeElement(item.Interface(), xml.StartElement{Name: name}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML appends every child element of the root to the slice that items points to.
+func (l *xmlList) UnmarshalXML(d *xml.Decoder, _ xml.StartElement) error {
+	items := reflect.ValueOf(l.items).Elem()
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			item := reflect.New(items.Type().Elem())
+			if err := d.DecodeElement(item.Interface(), &t); err != nil {
+				return err
+			}
+			items.Set(reflect.Append(items, item.Elem()))
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// bindBody decodes a JSON or XML request body into obj according to Content-Type. It writes
+// a 415 for other media types, a 413 for bodies over the size limit, and a 400 for malformed
+// documents, reporting false in each case.
+func bindBody(c *gin.Context, obj any) bool {
+	var err error
+	switch contentType := c.ContentType(); {
+	case contentType == "" || contentType == binding.MIMEJSON || strings.HasSuffix(contentType, "+json"):
+		err = c.ShouldBindJSON(obj)
+	case (contentType == binding.MIMEXML || contentType == binding.MIMEXML2) && isSlicePointer(obj):
+		if err = xml.NewDecoder(c.Request.Body).Decode(&xmlList{items: obj}); err == nil {
+			err = binding.Validator.ValidateStruct(obj)
+		}
+	case contentType == binding.MIMEXML || contentType == binding.MIMEXML2:
+		err = c.ShouldBindXML(obj)
+	default:
+		c.Header("Accept", binding.MIMEJSON+", "+binding.MIMEXML)
+		respondProblem(c, apierrors.ErrUnsupportedMediaType.
+			WithDetail(fmt.Sprintf("content type %q is not supported; send %s or %s", contentType, binding.MIMEJSON, binding.MIMEXML)))
+		return false
+	}
+	if err != nil {
+		respondBodyError(c, err)
+		return false
+	}
+	return true
+}
+
+// respondBodyError answers a failed body read or decode: 413 when the size limit cut the body
+// short, 400 otherwise.
+func respondBodyError(c *gin.Context, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		respondProblem(c, apierrors.NewPayloadTooLargeProblem(tooLarge.Limit))
+		return
+	}
+	respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+}
+
+func isSlicePointer(obj any) bool {
+	value := reflect.ValueOf(obj)
+	return value.Kind() == reflect.Pointer && value.Elem().Kind() == reflect.Slice
+}
@@ -0,0 +1,88 @@
+package petstoreserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// maxWebhookBodyBytes caps partner webhook bodies read into memory for signature checks.
+const maxWebhookBodyBytes = 1 << 20
+
+// PartnerWebhookAPI receives signed pet changes pushed by partners.
+type PartnerWebhookAPI struct {
+	service  petsports.Service
+	verifier *petspartner.WebhookVerifier
+	replay   petsports.WebhookReplayGuard
+}
+
+// NewPartnerWebhookAPI wires the pets service with signature verification and replay protection.
+func NewPartnerWebhookAPI(service petsports.Service, verifier *petspartner.WebhookVerifier, replay petsports.WebhookReplayGuard) PartnerWebhookAPI {
+	return PartnerWebhookAPI{service: service, verifier: verifier, replay: replay}
+}
+
+// Post /v2/partner/webhooks/:provider
+// Receive a signed pet change from a partner
+func (api *PartnerWebhookAPI) ReceivePartnerWebhook(c *gin.Context) {
+	provider := strings.TrimSpace(c.Param("provider"))
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxWebhookBodyBytes+1))
+	if err != nil {
+		respondBodyError(c, err)
+		return
+	}
+	if len(body) > maxWebhookBodyBytes {
+		respondProblem(c, apierrors.ErrBadRequest.WithDetail("webhook body too large"))
+		return
+	}
+	signedAt, err := api.verifier.Verify(provider, c.GetHeader(petspartner.TimestampHeader), c.GetHeader(petspartner.SignatureHeader), body)
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	event, candidate, err := petspartner.DecodeWebhookEvent(provider, body)
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	if api.replay != nil {
+		// Deliveries older than the tolerance are rejected as stale, so the claim only needs to outlive that window.
+		expiresAt := signedAt.Add(2 * api.verifier.Tolerance())
+		if err := api.replay.Claim(c.Request.Context(), provider, event.ID, expiresAt); err != nil {
+			respondServiceError(c, err)
+			return
+		}
+	}
+	result, err := api.service.ImportFromPartner(c.Request.Context(), petstypes.PartnerImportInput{
+		Provider:  provider,
+		EventID:   event.ID,
+		Candidate: candidate,
+	})
+	if err != nil {
+		if api.replay != nil {
+			// The delivery was not applied; free its ID so the partner's retry is not rejected as a replay.
+			if releaseErr := api.replay.Release(context.WithoutCancel(c.Request.Context()), provider, event.ID); releaseErr != nil {
+				err = errors.Join(err, releaseErr)
+			}
+		}
+		respondServiceError(c, err)
+		return
+	}
+	response := PartnerWebhookResult{Outcome: string(result.Outcome), Missing: result.Missing}
+	if result.Projection != nil && result.Projection.Pet != nil {
+		response.PetId = result.Projection.Pet.ID
+	}
+	status := http.StatusOK
+	if result.Outcome == petstypes.PartnerImportParked {
+		status = http.StatusAccepted
+	}
+	respondBody(c, status, response)
+}
@@ -0,0 +1,64 @@
+package petstoreserver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
+	petsmemory "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	petsapp "github.com/Apurer/go-gin-api-server/internal/domains/pets/application"
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	petsdomain "github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// unavailableRepository fails the first saves, like a database that is briefly down.
+type unavailableRepository struct {
+	petsports.Repository
+	failures int
+}
+
+func (r *unavailableRepository) Save(ctx context.Context, pet *petsdomain.Pet) (*petstypes.PetProjection, error) {
+	if r.failures > 0 {
+		r.failures--
+		return nil, errors.New("database unavailable")
+	}
+	return r.Repository.Save(ctx, pet)
+}
+
+func TestReceivePartnerWebhook_ReleasesTheDeliveryWhenTheImportFails(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	now := time.Now()
+	verifier := petspartner.NewWebhookVerifier(map[string]string{"acme": "s3cret"}, time.Minute)
+	verifier.WithClock(func() time.Time { return now })
+	repo := &unavailableRepository{Repository: petsmemory.NewRepository(), failures: 1}
+	api := NewPartnerWebhookAPI(petsapp.NewService(repo), verifier, petsmemory.NewWebhookReplayGuard())
+	router := gin.New()
+	router.POST("/v2/partner/webhooks/:provider", api.ReceivePartnerWebhook)
+
+	body := []byte(`{"id":"evt-1","type":"pet.created","pet":{"reference":"abc","title":"Rex","photos":["p"],"availability":"AVAILABLE"}}`)
+	deliver := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v2/partner/webhooks/acme", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(petspartner.TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+		req.Header.Set(petspartner.SignatureHeader, petspartner.SignWebhook("s3cret", now, body))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusInternalServerError, deliver().Code)
+	retried := deliver()
+	require.Equal(t, http.StatusOK, retried.Code, "the partner's retry of a failed delivery is not a replay: %s", retried.Body)
+	require.Contains(t, retried.Body.String(), `"outcome":"created"`)
+	require.Equal(t, http.StatusConflict, deliver().Code, "an applied delivery stays claimed")
+}
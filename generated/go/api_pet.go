@@ -2,10 +2,14 @@ package petstoreserver
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -18,22 +22,57 @@ import (
 
 // PetAPI wires HTTP transport with the pets bounded context service and workflows.
 type PetAPI struct {
-	service          petsports.Service
-	workflows        petsports.WorkflowOrchestrator
-	idempotencyStore petsports.IdempotencyStore
+	service        petsports.Service
+	workflows      petsports.WorkflowOrchestrator
+	events         petsports.PetEventSource
+	eventHeartbeat time.Duration
+	eventsClosing  <-chan struct{}
+}
+
+// PetAPIOption customizes optional PetAPI collaborators.
+type PetAPIOption func(*PetAPI)
+
+// WithPetEventSource serves the catalog event stream from source.
+func WithPetEventSource(source petsports.PetEventSource) PetAPIOption {
+	return func(api *PetAPI) {
+		api.events = source
+	}
+}
+
+// WithEventHeartbeat sets how often idle event streams send a keep-alive comment.
+func WithEventHeartbeat(interval time.Duration) PetAPIOption {
+	return func(api *PetAPI) {
+		api.eventHeartbeat = interval
+	}
+}
+
+// WithEventStreamsClosing ends open event streams once closing is closed, so they do not hold
+// a graceful shutdown open; clients reconnect elsewhere with Last-Event-ID.
+func WithEventStreamsClosing(closing <-chan struct{}) PetAPIOption {
+	return func(api *PetAPI) {
+		api.eventsClosing = closing
+	}
 }
 
 // NewPetAPI creates a PetAPI backed by the provided service.
-func NewPetAPI(service petsports.Service, workflows petsports.WorkflowOrchestrator, store petsports.IdempotencyStore) PetAPI {
-	return PetAPI{service: service, workflows: workflows, idempotencyStore: store}
+func NewPetAPI(service petsports.Service, workflows petsports.WorkflowOrchestrator, opts ...PetAPIOption) PetAPI {
+	api := PetAPI{service: service, workflows: workflows}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&api)
+		}
+	}
+	if api.eventHeartbeat <= 0 {
+		api.eventHeartbeat = defaultEventHeartbeat
+	}
+	return api
 }
 
 // Post /v2/pet
 // Add a new pet to the store
 func (api *PetAPI) AddPet(c *gin.Context) {
 	var payload PetCreate
-	if err := c.ShouldBindJSON(&payload); err != nil {
-		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+	if !bindBody(c, &payload) {
 		return
 	}
 	mutation := toMutationFromCreate(payload)
@@ -41,28 +80,21 @@ func (api *PetAPI) AddPet(c *gin.Context) {
 		PetMutationInput: pethttpmapper.ToMutationInput(mutation),
 		IdempotencyKey:   strings.TrimSpace(c.GetHeader("Idempotency-Key")),
 	}
-	var fingerprint string
-	if input.IdempotencyKey != "" && api.idempotencyStore != nil {
-		var err error
-		fingerprint, err = petsapp.FingerprintAddPet(input)
-		if err != nil {
-			respondProblem(c, apierrors.ErrInternal.WithDetail(err.Error()))
-			return
-		}
-		if projection, err := api.tryReplayIdempotent(c.Request.Context(), input.IdempotencyKey, fingerprint); err != nil {
-			respondPetServiceError(c, err)
+	if input.IdempotencyKey != "" {
+		if projection, err := api.service.ReplayAddPet(c.Request.Context(), input); err != nil {
+			respondServiceError(c, err)
 			return
 		} else if projection != nil {
-			c.JSON(http.StatusOK, pethttpmapper.FromProjection(projection))
+			respondBody(c, http.StatusOK, pethttpmapper.FromProjection(projection))
 			return
 		}
 	}
 	saved, err := api.createPet(c.Request.Context(), input)
 	if err != nil {
-		respondPetServiceError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, pethttpmapper.FromProjection(saved))
+	respondBody(c, http.StatusOK, pethttpmapper.FromProjection(saved))
 }
 
 func (api *PetAPI) createPet(ctx context.Context, input petstypes.AddPetInput) (*petstypes.PetProjection, error) {
@@ -80,7 +112,7 @@ func (api *PetAPI) DeletePet(c *gin.Context) {
 		return
 	}
 	if err := api.service.Delete(c.Request.Context(), petstypes.PetIdentifier{ID: id}); err != nil {
-		respondPetServiceError(c, err)
+		respondServiceError(c, err)
 		return
 	}
 	c.Status(http.StatusOK)
@@ -92,23 +124,36 @@ func (api *PetAPI) FindPetsByStatus(c *gin.Context) {
 	statuses := c.QueryArray("status")
 	result, err := api.service.FindByStatus(c.Request.Context(), petstypes.FindPetsByStatusInput{Statuses: statuses})
 	if err != nil {
-		respondPetServiceError(c, err)
+		respondServiceError(c, err)
+		return
+	}
+	if notModified(c, petListValidators(c, result)) {
 		return
 	}
-	c.JSON(http.StatusOK, pethttpmapper.FromProjectionList(result))
+	respondList(c, http.StatusOK, "pets", pethttpmapper.FromProjectionList(result))
 }
 
 // Get /v2/pet/findByTags
 // Finds Pets by tags
 // Deprecated
 func (api *PetAPI) FindPetsByTags(c *gin.Context) {
-	tags := c.QueryArray("tags")
-	result, err := api.service.FindByTags(c.Request.Context(), petstypes.FindPetsByTagsInput{Tags: tags})
+	// The spec declares the parameter unexploded, so tags arrive as tags=a,b as well as repeated keys.
+	var tags []string
+	for _, value := range c.QueryArray("tags") {
+		tags = append(tags, strings.Split(value, ",")...)
+	}
+	result, err := api.service.FindByTags(c.Request.Context(), petstypes.FindPetsByTagsInput{
+		Tags:  tags,
+		Match: petstypes.TagMatch(c.Query("match")),
+	})
 	if err != nil {
-		respondPetServiceError(c, err)
+		respondServiceError(c, err)
+		return
+	}
+	if notModified(c, petListValidators(c, result)) {
 		return
 	}
-	c.JSON(http.StatusOK, pethttpmapper.FromProjectionList(result))
+	respondList(c, http.StatusOK, "pets", pethttpmapper.FromProjectionList(result))
 }
 
 // Get /v2/pet/:petId
@@ -120,30 +165,237 @@ func (api *PetAPI) GetPetById(c *gin.Context) {
 	}
 	pet, err := api.service.GetByID(c.Request.Context(), petstypes.PetIdentifier{ID: id})
 	if err != nil {
-		respondPetServiceError(c, err)
+		respondServiceError(c, err)
+		return
+	}
+	if notModified(c, petValidators(c, pet)) {
 		return
 	}
-	c.JSON(http.StatusOK, pethttpmapper.FromProjection(pet))
+	respondBody(c, http.StatusOK, pethttpmapper.FromProjection(pet))
+}
+
+// Post /v2/pet/batchGet
+// Finds many pets by ID in one call
+func (api *PetAPI) BatchGetPets(c *gin.Context) {
+	var payload PetBatchGetRequest
+	if !bindBody(c, &payload) {
+		return
+	}
+	result, err := api.service.BatchGet(c.Request.Context(), petstypes.BatchGetInput{IDs: payload.Ids})
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	missing := result.Missing
+	if missing == nil {
+		missing = []int64{}
+	}
+	respondBody(c, http.StatusOK, PetBatchGetResponse{
+		Pets:    pethttpmapper.FromProjectionList(result.Pets),
+		Missing: missing,
+	})
+}
+
+// Post /v2/pet/batchStatus
+// Changes the status of many pets, atomically or best-effort
+func (api *PetAPI) BatchUpdatePetStatus(c *gin.Context) {
+	var payload PetBatchStatusRequest
+	if !bindBody(c, &payload) {
+		return
+	}
+	input := petstypes.BatchStatusInput{IDs: payload.Ids, Status: payload.Status, Atomic: payload.Atomic}
+	result, err := api.service.BatchUpdateStatus(c.Request.Context(), input)
+	if errors.Is(err, petsapp.ErrBatchRejected) {
+		definition, _ := apierrors.Lookup(err)
+		respondProblem(c, definition.Problem(err).WithExtension("problems", toBatchItemProblems(result.Problems)))
+		return
+	}
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, PetBatchStatusResponse{
+		Updated:  pethttpmapper.FromProjectionList(result.Updated),
+		Problems: toBatchItemProblems(result.Problems),
+		Warnings: toBatchItemProblems(result.Warnings),
+	})
+}
+
+func toBatchItemProblems(problems []petstypes.BatchItemProblem) []BatchItemProblem {
+	if len(problems) == 0 {
+		return nil
+	}
+	result := make([]BatchItemProblem, 0, len(problems))
+	for _, problem := range problems {
+		result = append(result, BatchItemProblem{Id: problem.ID, Error: problem.Error})
+	}
+	return result
 }
 
 // Put /v2/pet
 // Update an existing pet
 func (api *PetAPI) UpdatePet(c *gin.Context) {
 	var payload PetUpdate
-	if err := c.ShouldBindJSON(&payload); err != nil {
-		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+	if !bindBody(c, &payload) {
 		return
 	}
 	mutation := toMutationFromUpdate(payload)
 	input := petstypes.UpdatePetInput{PetMutationInput: pethttpmapper.ToMutationInput(mutation)}
 	updated, err := api.service.UpdatePet(c.Request.Context(), input)
 	if err != nil {
-		respondPetServiceError(c, err)
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, pethttpmapper.FromProjection(updated))
+}
+
+// Patch /v2/pet/:petId
+// Partially updates a pet with a JSON merge patch or JSON patch document
+func (api *PetAPI) PatchPet(c *gin.Context) {
+	id, ok := parseIDParam(c, "petId")
+	if !ok {
+		return
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		respondBodyError(c, err)
+		return
+	}
+	current, err := api.service.GetByID(c.Request.Context(), petstypes.PetIdentifier{ID: id})
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	mutation, err := pethttpmapper.PatchToMutationInput(pethttpmapper.FromProjection(current), c.ContentType(), body)
+	if err != nil {
+		respondPatchError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, pethttpmapper.FromProjection(updated))
+	updated, err := api.service.UpdatePet(c.Request.Context(), petstypes.UpdatePetInput{PetMutationInput: mutation})
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, pethttpmapper.FromProjection(updated))
+}
+
+// respondPatchError advertises the accepted patch media types alongside the 415.
+func respondPatchError(c *gin.Context, err error) {
+	if errors.Is(err, pethttpmapper.ErrUnsupportedPatch) {
+		c.Header("Accept-Patch", pethttpmapper.MergePatchContentType+", "+pethttpmapper.JSONPatchContentType)
+	}
+	respondServiceError(c, err)
 }
 
+// Post /v2/pet/bulk
+// Imports pets from NDJSON or CSV, streaming one NDJSON result per row and a final summary
+func (api *PetAPI) BulkImportPets(c *gin.Context) {
+	format, err := pethttpmapper.ParseBulkFormat(c.ContentType())
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	mode, err := petstypes.ParseBulkImportMode(c.Query("mode"))
+	if err != nil {
+		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+		return
+	}
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dryRun", "false"))
+	if err != nil {
+		respondProblem(c, apierrors.ErrBadRequest.WithDetail("dryRun must be a boolean"))
+		return
+	}
+	input := petstypes.BulkImportInput{
+		Rows:   pethttpmapper.DecodeBulkRows(format, c.Request.Body),
+		Mode:   mode,
+		DryRun: dryRun,
+	}
+	c.Header("Content-Type", pethttpmapper.BulkNDJSON.ContentType())
+	c.Status(http.StatusOK)
+	enc := json.NewEncoder(c.Writer)
+	summary, err := api.service.ImportPets(c.Request.Context(), input, func(result petstypes.BulkRowResult) error {
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	// The status line is already sent, so a failed run is reported in the trailing summary.
+	trailer := struct {
+		Summary petstypes.BulkImportSummary `json:"summary"`
+		Error   string                      `json:"error,omitempty"`
+	}{Summary: summary}
+	if err != nil {
+		trailer.Error = err.Error()
+	}
+	_ = enc.Encode(trailer)
+	c.Writer.Flush()
+}
+
+// Get /v2/pet/export
+// Streams the catalog as NDJSON (default) or CSV, chosen by the format query or Accept header
+func (api *PetAPI) ExportPets(c *gin.Context) {
+	requested := c.Query("format")
+	if requested == "" {
+		requested = c.GetHeader("Accept")
+	}
+	format := pethttpmapper.BulkNDJSON
+	if requested != "" && requested != "*/*" {
+		var err error
+		if format, err = pethttpmapper.ParseBulkFormat(requested); err != nil {
+			respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+			return
+		}
+	}
+	enc := pethttpmapper.NewBulkEncoder(format, c.Writer)
+	written := 0
+	startStream := func() {
+		c.Header("Content-Type", format.ContentType())
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="pets.%s"`, format))
+		c.Header("Trailer", exportStatusTrailer)
+		c.Status(http.StatusOK)
+	}
+	err := api.service.ExportPets(c.Request.Context(), func(projection *petstypes.PetProjection) error {
+		if written == 0 {
+			startStream()
+		}
+		if err := enc.Encode(pethttpmapper.FromProjection(projection)); err != nil {
+			return err
+		}
+		written++
+		if written%exportFlushEvery == 0 {
+			if err := enc.Flush(); err != nil {
+				return err
+			}
+			c.Writer.Flush()
+		}
+		return nil
+	})
+	if err != nil && written == 0 {
+		respondServiceError(c, err)
+		return
+	}
+	if written == 0 {
+		startStream()
+	}
+	if flushErr := enc.Flush(); err == nil {
+		err = flushErr
+	}
+	// The status line is already sent, so completeness is reported in the trailer.
+	if err != nil {
+		_ = c.Error(err)
+		c.Writer.Header().Set(exportStatusTrailer, "error: "+err.Error())
+		return
+	}
+	c.Writer.Header().Set(exportStatusTrailer, "complete")
+}
+
+// exportStatusTrailer is "complete" once every pet was streamed, or carries the error that truncated the export.
+const exportStatusTrailer = "X-Export-Status"
+
+// exportFlushEvery pushes exported rows to the client in chunks of this many pets.
+const exportFlushEvery = 100
+
 // Post /v2/pet/:petId
 // Updates a pet in the store with form data
 func (api *PetAPI) UpdatePetWithForm(c *gin.Context) {
@@ -164,10 +416,10 @@ func (api *PetAPI) UpdatePetWithForm(c *gin.Context) {
 	input := petstypes.UpdatePetWithFormInput{ID: id, Name: namePtr, Status: statusPtr}
 	updated, err := api.service.UpdatePetWithForm(c.Request.Context(), input)
 	if err != nil {
-		respondPetServiceError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, pethttpmapper.FromProjection(updated))
+	respondBody(c, http.StatusOK, pethttpmapper.FromProjection(updated))
 }
 
 // Post /v2/pet/:petId/groom
@@ -178,8 +430,7 @@ func (api *PetAPI) GroomPet(c *gin.Context) {
 		return
 	}
 	var payload GroomingOperation
-	if err := c.ShouldBindJSON(&payload); err != nil {
-		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+	if !bindBody(c, &payload) {
 		return
 	}
 	input, err := pethttpmapper.ToGroomPetInput(id, toGroomOperation(payload))
@@ -189,10 +440,10 @@ func (api *PetAPI) GroomPet(c *gin.Context) {
 	}
 	updated, err := api.service.GroomPet(c.Request.Context(), input)
 	if err != nil {
-		respondPetServiceError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, pethttpmapper.FromProjection(updated))
+	respondBody(c, http.StatusOK, pethttpmapper.FromProjection(updated))
 }
 
 // Post /v2/pet/:petId/uploadImage
@@ -211,11 +462,11 @@ func (api *PetAPI) UploadFile(c *gin.Context) {
 	input := petstypes.UploadImageInput{ID: id, Filename: file.Filename, Metadata: metadata}
 	result, err := api.service.UploadImage(c.Request.Context(), input)
 	if err != nil {
-		respondPetServiceError(c, err)
+		respondServiceError(c, err)
 		return
 	}
 	response := ApiResponse{Code: result.Code, Type: result.Type, Message: result.Message}
-	c.JSON(http.StatusOK, response)
+	respondBody(c, http.StatusOK, response)
 }
 
 func parseIDParam(c *gin.Context, name string) (int64, bool) {
@@ -228,42 +479,6 @@ func parseIDParam(c *gin.Context, name string) (int64, bool) {
 	return id, true
 }
 
-func respondPetServiceError(c *gin.Context, err error) {
-	if err == nil {
-		return
-	}
-	if err == petsports.ErrNotFound {
-		respondProblem(c, apierrors.ErrNotFound.WithDetail(err.Error()))
-		return
-	}
-	if errors.Is(err, petsapp.ErrInvalidInput) {
-		respondProblem(c, apierrors.ErrValidation.WithDetail(err.Error()))
-		return
-	}
-	if errors.Is(err, petsapp.ErrIdempotencyConflict) {
-		respondProblem(c, apierrors.ErrConflict.WithDetail(err.Error()))
-		return
-	}
-	respondProblem(c, apierrors.ErrInternal.WithDetail(err.Error()))
-}
-
-func (api *PetAPI) tryReplayIdempotent(ctx context.Context, key, fingerprint string) (*petstypes.PetProjection, error) {
-	if key == "" || api.idempotencyStore == nil {
-		return nil, nil
-	}
-	record, err := api.idempotencyStore.Get(ctx, key)
-	if err != nil {
-		return nil, err
-	}
-	if record == nil {
-		return nil, nil
-	}
-	if record.RequestHash != fingerprint {
-		return nil, petsapp.ErrIdempotencyConflict
-	}
-	return api.service.GetByID(ctx, petstypes.PetIdentifier{ID: record.PetID})
-}
-
 func toMutationFromCreate(model PetCreate) pethttpmapper.MutationPet {
 	mutation := pethttpmapper.MutationPet{ID: model.Id}
 	name := model.Name
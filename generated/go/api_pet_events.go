@@ -0,0 +1,177 @@
+package petstoreserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	pethttpmapper "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/http/mapper"
+	petdomain "github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// defaultEventHeartbeat keeps idle streams alive through proxies that drop silent connections.
+const defaultEventHeartbeat = 15 * time.Second
+
+// eventRetryMillis is the reconnect delay suggested to EventSource clients.
+const eventRetryMillis = 3000
+
+// Get /v2/pet/events
+// Streams catalog changes as Server-Sent Events
+func (api *PetAPI) StreamPetEvents(c *gin.Context) {
+	if api.events == nil {
+		DefaultHandleFunc(c)
+		return
+	}
+	filter, ok := parseEventFilter(c)
+	if !ok {
+		return
+	}
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		// EventSource cannot set headers on the first connection, so a query parameter works too.
+		lastEventID = c.Query("lastEventId")
+	}
+	var afterID uint64
+	if lastEventID != "" {
+		var err error
+		if afterID, err = strconv.ParseUint(lastEventID, 10, 64); err != nil {
+			respondProblem(c, apierrors.NewValidationProblem(map[string]string{
+				"header.Last-Event-ID": "must be an event id from this stream",
+			}))
+			return
+		}
+	}
+
+	subscription := api.events.Subscribe(afterID)
+	defer subscription.Cancel()
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", eventRetryMillis)
+	if subscription.Gap {
+		// Changes were missed; the client reloads the catalog and continues from the latest id.
+		fmt.Fprintf(c.Writer, "id: %s\nevent: resync\ndata: {}\n\n", formatEventID(subscription.LatestID))
+	}
+	for _, event := range subscription.Replay {
+		if err := writePetEvent(c, filter, event); err != nil {
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(api.eventHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-api.eventsClosing:
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case event, open := <-subscription.Events:
+			if !open {
+				// The client fell behind; it reconnects with Last-Event-ID and catches up.
+				return
+			}
+			if err := writePetEvent(c, filter, event); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// petEventFilter keeps events whose pet has, or had, one of the statuses and carries one of
+// the tags; an empty list matches everything.
+type petEventFilter struct {
+	statuses []petdomain.Status
+	tags     []string
+}
+
+func (f petEventFilter) matches(event petsports.PetEvent) bool {
+	if len(f.statuses) > 0 &&
+		!slices.Contains(f.statuses, event.Status) &&
+		!(event.PreviousStatus != "" && slices.Contains(f.statuses, event.PreviousStatus)) {
+		return false
+	}
+	if len(f.tags) > 0 && !slices.ContainsFunc(event.Tags, func(tag string) bool { return slices.Contains(f.tags, tag) }) {
+		return false
+	}
+	return true
+}
+
+// parseEventFilter reads the status and tags query parameters, repeated or comma separated.
+func parseEventFilter(c *gin.Context) (petEventFilter, bool) {
+	var filter petEventFilter
+	for _, status := range splitQueryValues(c.QueryArray("status")) {
+		switch petdomain.Status(status) {
+		case petdomain.StatusAvailable, petdomain.StatusPending, petdomain.StatusSold:
+			filter.statuses = append(filter.statuses, petdomain.Status(status))
+		default:
+			respondProblem(c, apierrors.NewValidationProblem(map[string]string{
+				"query.status": fmt.Sprintf("%q is not one of available, pending, sold", status),
+			}))
+			return petEventFilter{}, false
+		}
+	}
+	filter.tags = splitQueryValues(c.QueryArray("tags"))
+	return filter, true
+}
+
+func splitQueryValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
+
+// writePetEvent writes one matching event as an SSE message named after its type.
+func writePetEvent(c *gin.Context, filter petEventFilter, event petsports.PetEvent) error {
+	if !filter.matches(event) {
+		return nil
+	}
+	body := PetEvent{
+		Type:           string(event.Type),
+		PetId:          event.PetID,
+		Status:         string(event.Status),
+		PreviousStatus: string(event.PreviousStatus),
+		Tags:           event.Tags,
+		OccurredAt:     event.OccurredAt,
+	}
+	if event.Pet != nil {
+		pet := pethttpmapper.FromProjection(event.Pet)
+		body.Pet = &pet
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
+
+// formatEventID leaves the id empty for zero, which tells the client to forget its last id.
+func formatEventID(id uint64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatUint(id, 10)
+}
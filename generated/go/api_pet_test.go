@@ -0,0 +1,65 @@
+package petstoreserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	petsmemory "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	petsworkflows "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/workflows"
+	petsapp "github.com/Apurer/go-gin-api-server/internal/domains/pets/application"
+	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// recordingMetrics counts the idempotency signals emitted while serving a request.
+type recordingMetrics struct {
+	petsports.BusinessMetrics
+	replayed   int
+	conflicted int
+}
+
+func (m *recordingMetrics) IdempotencyReplayed(context.Context)   { m.replayed++ }
+func (m *recordingMetrics) IdempotencyConflicted(context.Context) { m.conflicted++ }
+
+func TestAddPet_CountsIdempotencyOutcomesOncePerRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for name, withWorkflows := range map[string]bool{"service": false, "workflows": true} {
+		t.Run(name, func(t *testing.T) {
+			metrics := &recordingMetrics{BusinessMetrics: petsports.NoopBusinessMetrics}
+			service := petsapp.NewService(petsmemory.NewRepository(),
+				petsapp.WithIdempotencyStore(petsmemory.NewIdempotencyStore()),
+				petsapp.WithBusinessMetrics(metrics),
+			)
+			var workflows petsports.WorkflowOrchestrator
+			if withWorkflows {
+				workflows = petsworkflows.NewInlinePetWorkflows(service)
+			}
+			api := NewPetAPI(service, workflows)
+			router := gin.New()
+			router.POST("/v2/pet", api.AddPet)
+
+			add := func(body string) int {
+				req := httptest.NewRequest(http.MethodPost, "/v2/pet", strings.NewReader(body))
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("Idempotency-Key", "add-rex")
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, req)
+				return rec.Code
+			}
+
+			require.Equal(t, http.StatusOK, add(`{"id":7,"name":"Rex","photoUrls":["p"]}`))
+			require.Equal(t, http.StatusOK, add(`{"id":7,"name":"Rex","photoUrls":["p"]}`))
+			require.Equal(t, 1, metrics.replayed)
+			require.Zero(t, metrics.conflicted)
+
+			require.Equal(t, http.StatusConflict, add(`{"id":7,"name":"Buddy","photoUrls":["p"]}`))
+			require.Equal(t, 1, metrics.replayed)
+			require.Equal(t, 1, metrics.conflicted)
+		})
+	}
+}
@@ -1,13 +1,13 @@
 package petstoreserver
 
 import (
-	"errors"
+	"encoding/xml"
 	"net/http"
+	"sort"
 
 	"github.com/gin-gonic/gin"
 
 	storehttpmapper "github.com/Apurer/go-gin-api-server/internal/domains/store/adapters/http/mapper"
-	storeapp "github.com/Apurer/go-gin-api-server/internal/domains/store/application"
 	storeports "github.com/Apurer/go-gin-api-server/internal/domains/store/ports"
 	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
 )
@@ -52,7 +52,7 @@ func (api *StoreAPI) DeleteOrder(c *gin.Context) {
 		return
 	}
 	if err := api.service.DeleteOrder(c.Request.Context(), id); err != nil {
-		respondStoreError(c, err)
+		respondServiceError(c, err)
 		return
 	}
 	c.Status(http.StatusOK)
@@ -63,10 +63,31 @@ func (api *StoreAPI) DeleteOrder(c *gin.Context) {
 func (api *StoreAPI) GetInventory(c *gin.Context) {
 	inv, err := api.service.Inventory(c.Request.Context())
 	if err != nil {
-		respondStoreError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, inv)
+	respondBody(c, http.StatusOK, inventory(inv))
+}
+
+// inventory maps status names to quantities; XML lists one element per status.
+type inventory map[string]int32
+
+func (inv inventory) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := xml.StartElement{Name: xml.Name{Local: "inventory"}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	statuses := make([]string, 0, len(inv))
+	for status := range inv {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+	for _, status := range statuses {
+		if err := e.EncodeElement(inv[status], xml.StartElement{Name: xml.Name{Local: status}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
 }
 
 // Get /v2/store/order/:orderId
@@ -78,18 +99,21 @@ func (api *StoreAPI) GetOrderById(c *gin.Context) {
 	}
 	order, err := api.service.GetOrderByID(c.Request.Context(), id)
 	if err != nil {
-		respondStoreError(c, err)
+		respondServiceError(c, err)
+		return
+	}
+	body := fromTransportOrder(storehttpmapper.FromDomainOrder(order))
+	if notModified(c, contentValidators(c, body)) {
 		return
 	}
-	c.JSON(http.StatusOK, fromTransportOrder(storehttpmapper.FromDomainOrder(order)))
+	respondBody(c, http.StatusOK, body)
 }
 
 // Post /v2/store/order
 // Place an order for a pet
 func (api *StoreAPI) PlaceOrder(c *gin.Context) {
 	var payload Order
-	if err := c.ShouldBindJSON(&payload); err != nil {
-		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+	if !bindBody(c, &payload) {
 		return
 	}
 	order, err := storehttpmapper.ToDomainOrder(toTransportOrder(payload))
@@ -99,22 +123,8 @@ func (api *StoreAPI) PlaceOrder(c *gin.Context) {
 	}
 	saved, err := api.service.PlaceOrder(c.Request.Context(), order)
 	if err != nil {
-		respondStoreError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, fromTransportOrder(storehttpmapper.FromDomainOrder(saved)))
-}
-
-func respondStoreError(c *gin.Context, err error) {
-	if err == nil {
-		return
-	}
-	switch {
-	case errors.Is(err, storeports.ErrNotFound):
-		respondProblem(c, apierrors.ErrNotFound.WithDetail(err.Error()))
-	case errors.Is(err, storeapp.ErrInvalidInput):
-		respondProblem(c, apierrors.ErrValidation.WithDetail(err.Error()))
-	default:
-		respondProblem(c, apierrors.ErrInternal.WithDetail(err.Error()))
-	}
+	respondBody(c, http.StatusOK, fromTransportOrder(storehttpmapper.FromDomainOrder(saved)))
 }
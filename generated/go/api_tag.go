@@ -0,0 +1,97 @@
+package petstoreserver
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	petdomain "github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// TagAPI implements the tag catalog OpenAPI operations.
+type TagAPI struct {
+	service petsports.TagService
+}
+
+// NewTagAPI wires the tag service.
+func NewTagAPI(service petsports.TagService) TagAPI {
+	return TagAPI{service: service}
+}
+
+func fromDomainTag(tag *petdomain.Tag) Tag {
+	return Tag{Id: tag.ID, Name: tag.Name}
+}
+
+func fromTagUsage(usage petstypes.TagUsage) TagSummary {
+	return TagSummary{Id: usage.Tag.ID, Name: usage.Tag.Name, PetCount: usage.PetCount}
+}
+
+// Get /v2/tag
+// Lists pet tags
+func (api *TagAPI) ListTags(c *gin.Context) {
+	usages, err := api.service.ListTags(c.Request.Context())
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	body := make([]TagSummary, 0, len(usages))
+	for _, usage := range usages {
+		body = append(body, fromTagUsage(usage))
+	}
+	respondList(c, http.StatusOK, "tags", body)
+}
+
+// Post /v2/tag
+// Create a pet tag
+func (api *TagAPI) CreateTag(c *gin.Context) {
+	var payload Tag
+	if !bindBody(c, &payload) {
+		return
+	}
+	created, err := api.service.CreateTag(c.Request.Context(), petstypes.TagMutationInput{Name: payload.Name})
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusCreated, fromDomainTag(created))
+}
+
+// Put /v2/tag/:tagId
+// Rename a pet tag
+func (api *TagAPI) RenameTag(c *gin.Context) {
+	id, ok := parseIDParam(c, "tagId")
+	if !ok {
+		return
+	}
+	var payload Tag
+	if !bindBody(c, &payload) {
+		return
+	}
+	renamed, err := api.service.RenameTag(c.Request.Context(), petstypes.TagMutationInput{ID: id, Name: payload.Name})
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, fromDomainTag(renamed))
+}
+
+// Post /v2/tag/:tagId/merge
+// Merge a pet tag into another
+func (api *TagAPI) MergeTag(c *gin.Context) {
+	id, ok := parseIDParam(c, "tagId")
+	if !ok {
+		return
+	}
+	var payload TagMerge
+	if !bindBody(c, &payload) {
+		return
+	}
+	usage, err := api.service.MergeTags(c.Request.Context(), petstypes.MergeTagsInput{SourceID: id, TargetID: payload.TargetId})
+	if err != nil {
+		respondServiceError(c, err)
+		return
+	}
+	respondBody(c, http.StatusOK, fromTagUsage(*usage))
+}
@@ -1,14 +1,12 @@
 package petstoreserver
 
 import (
-	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 
 	userhttpmapper "github.com/Apurer/go-gin-api-server/internal/domains/users/adapters/http/mapper"
-	userapp "github.com/Apurer/go-gin-api-server/internal/domains/users/application"
 	userports "github.com/Apurer/go-gin-api-server/internal/domains/users/ports"
 	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
 )
@@ -69,63 +67,60 @@ func fromTransportUsers(users []userhttpmapper.User) []User {
 // Create user
 func (api *UserAPI) CreateUser(c *gin.Context) {
 	var payload User
-	if err := c.ShouldBindJSON(&payload); err != nil {
-		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+	if !bindBody(c, &payload) {
 		return
 	}
 	user, err := userhttpmapper.ToDomainUser(toTransportUser(payload))
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
 	saved, err := api.service.CreateUser(c.Request.Context(), user)
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, fromTransportUser(userhttpmapper.FromDomainUser(saved)))
+	respondBody(c, http.StatusOK, fromTransportUser(userhttpmapper.FromDomainUser(saved)))
 }
 
 // Post /v2/user/createWithArray
 // Creates list of users with given input array
 func (api *UserAPI) CreateUsersWithArrayInput(c *gin.Context) {
 	var payload []User
-	if err := c.ShouldBindJSON(&payload); err != nil {
-		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+	if !bindBody(c, &payload) {
 		return
 	}
 	users, err := userhttpmapper.ToDomainUsers(toTransportUserList(payload))
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
 	created, err := api.service.CreateUsers(c.Request.Context(), users)
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, fromTransportUsers(userhttpmapper.FromDomainUsers(created)))
+	respondList(c, http.StatusOK, "users", fromTransportUsers(userhttpmapper.FromDomainUsers(created)))
 }
 
 // Post /v2/user/createWithList
 // Creates list of users with given input array
 func (api *UserAPI) CreateUsersWithListInput(c *gin.Context) {
 	var payload []User
-	if err := c.ShouldBindJSON(&payload); err != nil {
-		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+	if !bindBody(c, &payload) {
 		return
 	}
 	users, err := userhttpmapper.ToDomainUsers(toTransportUserList(payload))
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
 	created, err := api.service.CreateUsers(c.Request.Context(), users)
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, fromTransportUsers(userhttpmapper.FromDomainUsers(created)))
+	respondList(c, http.StatusOK, "users", fromTransportUsers(userhttpmapper.FromDomainUsers(created)))
 }
 
 // Delete /v2/user/:username
@@ -137,7 +132,7 @@ func (api *UserAPI) DeleteUser(c *gin.Context) {
 		return
 	}
 	if err := api.service.Delete(c.Request.Context(), username); err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
 	c.Status(http.StatusOK)
@@ -149,10 +144,10 @@ func (api *UserAPI) GetUserByName(c *gin.Context) {
 	username := c.Param("username")
 	user, err := api.service.GetByUsername(c.Request.Context(), username)
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, fromTransportUser(userhttpmapper.FromDomainUser(user)))
+	respondBody(c, http.StatusOK, fromTransportUser(userhttpmapper.FromDomainUser(user)))
 }
 
 // Get /v2/user/login
@@ -166,21 +161,21 @@ func (api *UserAPI) LoginUser(c *gin.Context) {
 	}
 	user, err := api.service.GetByUsername(c.Request.Context(), username)
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
 	if user == nil || user.Password != password {
 		respondProblem(c, apierrors.ErrUnauthorized.WithDetail("invalid credentials"))
 		return
 	}
-	c.JSON(http.StatusOK, "logged in user session:"+username)
+	respondBody(c, http.StatusOK, "logged in user session:"+username)
 }
 
 // Get /v2/user/logout
 // Logs out current logged in user session
 func (api *UserAPI) LogoutUser(c *gin.Context) {
 	// No-op for demo API
-	c.JSON(http.StatusOK, "ok")
+	respondBody(c, http.StatusOK, "ok")
 }
 
 // Put /v2/user/:username
@@ -188,33 +183,18 @@ func (api *UserAPI) LogoutUser(c *gin.Context) {
 func (api *UserAPI) UpdateUser(c *gin.Context) {
 	username := c.Param("username")
 	var payload User
-	if err := c.ShouldBindJSON(&payload); err != nil {
-		respondProblem(c, apierrors.ErrBadRequest.WithDetail(err.Error()))
+	if !bindBody(c, &payload) {
 		return
 	}
 	user, err := userhttpmapper.ToDomainUser(toTransportUser(payload))
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
 	updated, err := api.service.Update(c.Request.Context(), username, user)
 	if err != nil {
-		respondUserError(c, err)
+		respondServiceError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, fromTransportUser(userhttpmapper.FromDomainUser(updated)))
-}
-
-func respondUserError(c *gin.Context, err error) {
-	if err == nil {
-		return
-	}
-	switch {
-	case errors.Is(err, userports.ErrNotFound):
-		respondProblem(c, apierrors.ErrNotFound.WithDetail(err.Error()))
-	case errors.Is(err, userapp.ErrInvalidInput):
-		respondProblem(c, apierrors.ErrValidation.WithDetail(err.Error()))
-	default:
-		respondProblem(c, apierrors.ErrInternal.WithDetail(err.Error()))
-	}
+	respondBody(c, http.StatusOK, fromTransportUser(userhttpmapper.FromDomainUser(updated)))
 }
@@ -12,9 +12,9 @@ package petstoreserver
 // ApiResponse - Describes the result of uploading an image resource
 type ApiResponse struct {
 
-	Code int32 `json:"code,omitempty"`
+	Code int32 `json:"code,omitempty" xml:"code,omitempty"`
 
-	Type string `json:"type,omitempty"`
+	Type string `json:"type,omitempty" xml:"type,omitempty"`
 
-	Message string `json:"message,omitempty"`
+	Message string `json:"message,omitempty" xml:"message,omitempty"`
 }
@@ -12,7 +12,10 @@ package petstoreserver
 // Category - A category for a pet
 type Category struct {
 
-	Id int64 `json:"id,omitempty"`
+	Id int64 `json:"id,omitempty" xml:"id,omitempty"`
 
-	Name string `json:"name,omitempty" validate:"regexp=^[a-zA-Z0-9]+[a-zA-Z0-9\\\\.\\\\-_]*[a-zA-Z0-9]+$"`
+	Name string `json:"name,omitempty" xml:"name,omitempty" validate:"regexp=^[a-zA-Z0-9]+[a-zA-Z0-9\\\\.\\\\-_]*[a-zA-Z0-9]+$"`
+
+	// ID of the parent category; omitted for top-level categories
+	ParentId int64 `json:"parentId,omitempty" xml:"parentId,omitempty"`
 }
@@ -9,12 +9,16 @@
 
 package petstoreserver
 
+import (
+	pethttpmapper "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/http/mapper"
+)
+
 // ExternalPetReference - Links the local pet to a record that lives in another provider.
 type ExternalPetReference struct {
 
-	Provider string `json:"provider,omitempty"`
+	Provider string `json:"provider,omitempty" xml:"provider,omitempty"`
 
-	ExternalId string `json:"externalId,omitempty"`
+	ExternalId string `json:"externalId,omitempty" xml:"externalId,omitempty"`
 
-	Attributes map[string]string `json:"attributes,omitempty"`
+	Attributes pethttpmapper.Attributes `json:"attributes,omitempty" xml:"attributes,omitempty"`
 }
@@ -0,0 +1,38 @@
+/*
+ * OpenAPI Petstore
+ *
+ * This is a sample server Petstore server. For this sample, you can use the api key `special-key` to test the authorization filters.
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package petstoreserver
+
+import (
+	"time"
+)
+
+// GroomingAppointment - A groomer booked for a pet during a time slot
+type GroomingAppointment struct {
+
+	Id int64 `json:"id,omitempty" xml:"id,omitempty"`
+
+	PetId int64 `json:"petId" xml:"petId"`
+
+	Groomer string `json:"groomer" xml:"groomer"`
+
+	StartsAt time.Time `json:"startsAt" xml:"startsAt"`
+
+	EndsAt time.Time `json:"endsAt" xml:"endsAt"`
+
+	RequestedTrimCm float64 `json:"requestedTrimCm,omitempty" xml:"requestedTrimCm,omitempty"`
+
+	// Appointment status
+	Status string `json:"status,omitempty" xml:"status,omitempty"`
+
+	// When the reminder for the current slot was sent
+	RemindedAt *time.Time `json:"remindedAt,omitempty" xml:"remindedAt,omitempty"`
+
+	CompletedAt *time.Time `json:"completedAt,omitempty" xml:"completedAt,omitempty"`
+}
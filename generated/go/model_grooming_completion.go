@@ -0,0 +1,19 @@
+/*
+ * OpenAPI Petstore
+ *
+ * This is a sample server Petstore server. For this sample, you can use the api key `special-key` to test the authorization filters.
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package petstoreserver
+
+// GroomingCompletion - Measurements captured when a grooming appointment is completed
+type GroomingCompletion struct {
+
+	InitialHairLengthCm float64 `json:"initialHairLengthCm" xml:"initialHairLengthCm"`
+
+	// Defaults to the trim requested when the appointment was booked
+	TrimByCm *float64 `json:"trimByCm,omitempty" xml:"trimByCm,omitempty"`
+}
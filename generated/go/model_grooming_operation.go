@@ -12,7 +12,7 @@ package petstoreserver
 // GroomingOperation - Transient measurement data used to compute the new hair length during grooming.
 type GroomingOperation struct {
 
-	InitialHairLengthCm float64 `json:"initialHairLengthCm"`
+	InitialHairLengthCm float64 `json:"initialHairLengthCm" xml:"initialHairLengthCm"`
 
-	TrimByCm float64 `json:"trimByCm"`
+	TrimByCm float64 `json:"trimByCm" xml:"trimByCm"`
 }
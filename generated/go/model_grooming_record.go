@@ -0,0 +1,32 @@
+/*
+ * OpenAPI Petstore
+ *
+ * This is a sample server Petstore server. For this sample, you can use the api key `special-key` to test the authorization filters.
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package petstoreserver
+
+import (
+	"time"
+)
+
+// GroomingRecord - Measurements recorded by a completed grooming appointment
+type GroomingRecord struct {
+
+	AppointmentId int64 `json:"appointmentId,omitempty" xml:"appointmentId,omitempty"`
+
+	PetId int64 `json:"petId,omitempty" xml:"petId,omitempty"`
+
+	Groomer string `json:"groomer,omitempty" xml:"groomer,omitempty"`
+
+	InitialHairLengthCm float64 `json:"initialHairLengthCm,omitempty" xml:"initialHairLengthCm,omitempty"`
+
+	TrimByCm float64 `json:"trimByCm,omitempty" xml:"trimByCm,omitempty"`
+
+	ResultHairLengthCm float64 `json:"resultHairLengthCm,omitempty" xml:"resultHairLengthCm,omitempty"`
+
+	GroomedAt time.Time `json:"groomedAt,omitempty" xml:"groomedAt,omitempty"`
+}
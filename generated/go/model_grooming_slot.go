@@ -0,0 +1,22 @@
+/*
+ * OpenAPI Petstore
+ *
+ * This is a sample server Petstore server. For this sample, you can use the api key `special-key` to test the authorization filters.
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package petstoreserver
+
+import (
+	"time"
+)
+
+// GroomingSlot - New time slot of a grooming appointment
+type GroomingSlot struct {
+
+	StartsAt time.Time `json:"startsAt" xml:"startsAt"`
+
+	EndsAt time.Time `json:"endsAt" xml:"endsAt"`
+}
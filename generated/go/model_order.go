@@ -16,16 +16,16 @@ import (
 // Order - An order for a pets from the pet store
 type Order struct {
 
-	Id int64 `json:"id,omitempty"`
+	Id int64 `json:"id,omitempty" xml:"id,omitempty"`
 
-	PetId int64 `json:"petId,omitempty"`
+	PetId int64 `json:"petId,omitempty" xml:"petId,omitempty"`
 
-	Quantity int32 `json:"quantity,omitempty"`
+	Quantity int32 `json:"quantity,omitempty" xml:"quantity,omitempty"`
 
-	ShipDate time.Time `json:"shipDate,omitempty"`
+	ShipDate time.Time `json:"shipDate,omitempty" xml:"shipDate,omitempty"`
 
 	// Order Status
-	Status string `json:"status,omitempty"`
+	Status string `json:"status,omitempty" xml:"status,omitempty"`
 
-	Complete bool `json:"complete,omitempty"`
+	Complete bool `json:"complete,omitempty" xml:"complete,omitempty"`
 }
@@ -0,0 +1,22 @@
+/*
+ * OpenAPI Petstore
+ *
+ * This is a sample server Petstore server. For this sample, you can use the api key `special-key` to test the authorization filters.
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package petstoreserver
+
+// PartnerWebhookResult - Outcome of applying a partner webhook delivery
+type PartnerWebhookResult struct {
+
+	// created, updated, or parked
+	Outcome string `json:"outcome" xml:"outcome"`
+
+	PetId int64 `json:"petId,omitempty" xml:"petId,omitempty"`
+
+	// Mandatory fields the partner omitted; present when the event was parked for review
+	Missing []string `json:"missing,omitempty" xml:"missing>field,omitempty"`
+}
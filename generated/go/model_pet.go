@@ -16,28 +16,28 @@ import (
 // Pet - A pet for sale in the pet store
 type Pet struct {
 
-	Id int64 `json:"id,omitempty"`
+	Id int64 `json:"id,omitempty" xml:"id,omitempty"`
 
-	Category Category `json:"category,omitempty"`
+	Category Category `json:"category,omitempty" xml:"category,omitempty"`
 
-	Name string `json:"name"`
+	Name string `json:"name" xml:"name"`
 
-	PhotoUrls []string `json:"photoUrls"`
+	PhotoUrls []string `json:"photoUrls" xml:"photoUrls>photoUrl"`
 
-	Tags []Tag `json:"tags,omitempty"`
+	Tags []Tag `json:"tags,omitempty" xml:"tags>tag,omitempty"`
 
 	// Most recent measured hair length in centimeters
-	HairLengthCm float64 `json:"hairLengthCm,omitempty"`
+	HairLengthCm float64 `json:"hairLengthCm,omitempty" xml:"hairLengthCm,omitempty"`
 
-	ExternalReference ExternalPetReference `json:"externalReference,omitempty"`
+	ExternalReference ExternalPetReference `json:"externalReference,omitempty" xml:"externalReference,omitempty"`
 
 	// pet status in the store
 	// Deprecated
-	Status string `json:"status,omitempty"`
+	Status string `json:"status,omitempty" xml:"status,omitempty"`
 
 	// Timestamp when the pet record was first persisted.
-	CreatedAt time.Time `json:"createdAt,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty" xml:"createdAt,omitempty"`
 
 	// Timestamp when the pet record was last modified.
-	UpdatedAt time.Time `json:"updatedAt,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
 }
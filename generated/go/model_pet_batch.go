@@ -0,0 +1,59 @@
+/*
+ * OpenAPI Petstore
+ *
+ * This is a sample server Petstore server. For this sample, you can use the api key `special-key` to test the authorization filters.
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package petstoreserver
+
+import (
+	pethttpmapper "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/http/mapper"
+)
+
+// PetBatchGetRequest - Pet identifiers to look up in one call
+type PetBatchGetRequest struct {
+
+	Ids []int64 `json:"ids" xml:"ids>id"`
+}
+
+// PetBatchGetResponse - Pets that were found, in request order, and identifiers that were not
+type PetBatchGetResponse struct {
+
+	Pets []pethttpmapper.Pet `json:"pets" xml:"pets>Pet"`
+
+	Missing []int64 `json:"missing" xml:"missing>id"`
+}
+
+// PetBatchStatusRequest - Moves many pets to the same status
+type PetBatchStatusRequest struct {
+
+	Ids []int64 `json:"ids" xml:"ids>id"`
+
+	// pet status in the store
+	Status string `json:"status" xml:"status"`
+
+	// When true the whole batch is rejected if any item fails; otherwise valid items are applied
+	Atomic bool `json:"atomic,omitempty" xml:"atomic,omitempty"`
+}
+
+// BatchItemProblem - Reason a single batch item was not applied or synced
+type BatchItemProblem struct {
+
+	Id int64 `json:"id" xml:"id"`
+
+	Error string `json:"error" xml:"error"`
+}
+
+// PetBatchStatusResponse - Outcome of a batch status change
+type PetBatchStatusResponse struct {
+
+	Updated []pethttpmapper.Pet `json:"updated" xml:"updated>Pet"`
+
+	Problems []BatchItemProblem `json:"problems,omitempty" xml:"problems>problem,omitempty"`
+
+	// Partner sync failures for pets that were saved
+	Warnings []BatchItemProblem `json:"warnings,omitempty" xml:"warnings>warning,omitempty"`
+}
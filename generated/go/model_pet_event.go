@@ -0,0 +1,38 @@
+/*
+ * OpenAPI Petstore
+ *
+ * This is a sample server Petstore server. For this sample, you can use the api key `special-key` to test the authorization filters.
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package petstoreserver
+
+import (
+	"time"
+
+	pethttpmapper "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/http/mapper"
+)
+
+// PetEvent - A catalog change delivered on the pet event stream.
+type PetEvent struct {
+
+	// pet.created, pet.updated, pet.status_changed, or pet.deleted
+	Type string `json:"type"`
+
+	PetId int64 `json:"petId"`
+
+	// Status after the change, or the last status of a deleted pet
+	Status string `json:"status"`
+
+	// Status before a pet.status_changed event
+	PreviousStatus string `json:"previousStatus,omitempty"`
+
+	Tags []string `json:"tags,omitempty"`
+
+	// Current state of the pet; absent for deletions
+	Pet *pethttpmapper.Pet `json:"pet,omitempty"`
+
+	OccurredAt time.Time `json:"occurredAt"`
+}
@@ -12,22 +12,22 @@ package petstoreserver
 // PetUpdate - Payload used to update an existing pet; fields are optional and nullable to preserve field presence
 type PetUpdate struct {
 
-	Id int64 `json:"id"`
+	Id int64 `json:"id" xml:"id"`
 
-	Category Category `json:"category,omitempty"`
+	Category Category `json:"category,omitempty" xml:"category,omitempty"`
 
-	Name *string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty" xml:"name,omitempty"`
 
-	PhotoUrls *[]string `json:"photoUrls,omitempty"`
+	PhotoUrls *[]string `json:"photoUrls,omitempty" xml:"photoUrls>photoUrl,omitempty"`
 
-	Tags *[]Tag `json:"tags,omitempty"`
+	Tags *[]Tag `json:"tags,omitempty" xml:"tags>tag,omitempty"`
 
 	// Most recent measured hair length in centimeters
-	HairLengthCm *float64 `json:"hairLengthCm,omitempty"`
+	HairLengthCm *float64 `json:"hairLengthCm,omitempty" xml:"hairLengthCm,omitempty"`
 
-	ExternalReference ExternalPetReference `json:"externalReference,omitempty"`
+	ExternalReference ExternalPetReference `json:"externalReference,omitempty" xml:"externalReference,omitempty"`
 
 	// pet status in the store
 	// Deprecated
-	Status *string `json:"status,omitempty"`
+	Status *string `json:"status,omitempty" xml:"status,omitempty"`
 }
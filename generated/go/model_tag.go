@@ -12,7 +12,7 @@ package petstoreserver
 // Tag - A tag for a pet
 type Tag struct {
 
-	Id int64 `json:"id,omitempty"`
+	Id int64 `json:"id,omitempty" xml:"id,omitempty"`
 
-	Name string `json:"name,omitempty"`
+	Name string `json:"name,omitempty" xml:"name,omitempty"`
 }
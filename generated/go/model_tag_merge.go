@@ -0,0 +1,16 @@
+/*
+ * OpenAPI Petstore
+ *
+ * This is a sample server Petstore server. For this sample, you can use the api key `special-key` to test the authorization filters.
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package petstoreserver
+
+// TagMerge - Target of a tag merge
+type TagMerge struct {
+
+	TargetId int64 `json:"targetId" xml:"targetId"`
+}
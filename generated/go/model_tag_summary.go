@@ -0,0 +1,20 @@
+/*
+ * OpenAPI Petstore
+ *
+ * This is a sample server Petstore server. For this sample, you can use the api key `special-key` to test the authorization filters.
+ *
+ * API version: 1.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package petstoreserver
+
+// TagSummary - A catalog tag with the number of pets carrying it
+type TagSummary struct {
+
+	Id int64 `json:"id,omitempty" xml:"id,omitempty"`
+
+	Name string `json:"name,omitempty" xml:"name,omitempty"`
+
+	PetCount int64 `json:"petCount,omitempty" xml:"petCount,omitempty"`
+}
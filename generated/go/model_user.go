@@ -12,20 +12,20 @@ package petstoreserver
 // User - A User who is purchasing from the pet store
 type User struct {
 
-	Id int64 `json:"id,omitempty"`
+	Id int64 `json:"id,omitempty" xml:"id,omitempty"`
 
-	Username string `json:"username,omitempty"`
+	Username string `json:"username,omitempty" xml:"username,omitempty"`
 
-	FirstName string `json:"firstName,omitempty"`
+	FirstName string `json:"firstName,omitempty" xml:"firstName,omitempty"`
 
-	LastName string `json:"lastName,omitempty"`
+	LastName string `json:"lastName,omitempty" xml:"lastName,omitempty"`
 
-	Email string `json:"email,omitempty"`
+	Email string `json:"email,omitempty" xml:"email,omitempty"`
 
-	Password string `json:"password,omitempty"`
+	Password string `json:"password,omitempty" xml:"password,omitempty"`
 
-	Phone string `json:"phone,omitempty"`
+	Phone string `json:"phone,omitempty" xml:"phone,omitempty"`
 
 	// User Status
-	UserStatus int32 `json:"userStatus,omitempty"`
+	UserStatus int32 `json:"userStatus,omitempty" xml:"userStatus,omitempty"`
 }
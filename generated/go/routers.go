@@ -10,13 +10,9 @@
 package petstoreserver
 
 import (
-	"encoding/json"
 	"net/http"
-	"os"
-	"strings"
 
 	"github.com/gin-gonic/gin"
-	"gopkg.in/yaml.v3"
 )
 
 // Route is the information for every URI.
@@ -38,105 +34,43 @@ func NewRouter(handleFunctions ApiHandleFunctions) *gin.Engine {
 
 // NewRouter add routes to existing gin engine.
 func NewRouterWithGinEngine(router *gin.Engine, handleFunctions ApiHandleFunctions) *gin.Engine {
-	registerOpenAPIDocs(router)
-
 	for _, route := range getRoutes(handleFunctions) {
 		if route.HandlerFunc == nil {
 			route.HandlerFunc = DefaultHandleFunc
 		}
+		handlers := []gin.HandlerFunc{route.HandlerFunc}
+		if !streamingRoutes[route.Name] {
+			handlers = append([]gin.HandlerFunc{negotiateResponse}, handlers...)
+		}
 		switch route.Method {
 		case http.MethodGet:
-			router.GET(route.Pattern, route.HandlerFunc)
+			router.GET(route.Pattern, handlers...)
 		case http.MethodPost:
-			router.POST(route.Pattern, route.HandlerFunc)
+			router.POST(route.Pattern, handlers...)
 		case http.MethodPut:
-			router.PUT(route.Pattern, route.HandlerFunc)
+			router.PUT(route.Pattern, handlers...)
 		case http.MethodPatch:
-			router.PATCH(route.Pattern, route.HandlerFunc)
+			router.PATCH(route.Pattern, handlers...)
 		case http.MethodDelete:
-			router.DELETE(route.Pattern, route.HandlerFunc)
+			router.DELETE(route.Pattern, handlers...)
 		}
 	}
 
 	return router
 }
 
+// streamingRoutes choose their own NDJSON/CSV/SSE representation instead of JSON/XML negotiation.
+var streamingRoutes = map[string]bool{
+	"BulkImportPets":  true,
+	"ExportPets":      true,
+	"StreamPetEvents": true,
+}
+
 // Default handler for not yet implemented routes
 func DefaultHandleFunc(c *gin.Context) {
 	c.String(http.StatusNotImplemented, "501 not implemented")
 }
 
-func registerOpenAPIDocs(router *gin.Engine) {
-	router.GET("/openapi.yaml", func(c *gin.Context) {
-		serveOpenAPIYAML(c, "api/openapi.yaml")
-	})
-	router.GET("/openapi.json", func(c *gin.Context) {
-		serveOpenAPIJSON(c, "api/openapi.yaml")
-	})
-	router.GET("/swagger", serveSwaggerUI)
-	router.GET("/swagger/", serveSwaggerUI)
-}
-
-func serveOpenAPIYAML(c *gin.Context, path string) {
-	if _, err := os.Stat(path); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	c.File(path)
-}
-
-func serveOpenAPIJSON(c *gin.Context, path string) {
-	data, err := os.ReadFile(path)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	var payload interface{}
-	if err := yaml.Unmarshal(data, &payload); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	jsonBytes, err := json.Marshal(payload)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	c.Data(http.StatusOK, "application/json", jsonBytes)
-}
-
-func serveSwaggerUI(c *gin.Context) {
-	html := strings.ReplaceAll(swaggerHTML, "__SPEC_URL__", c.Request.URL.Scheme+"://"+c.Request.Host+"/openapi.json")
-	if c.Request.URL.Scheme == "" {
-		html = strings.ReplaceAll(swaggerHTML, "__SPEC_URL__", "/openapi.json")
-	}
-	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(html))
-}
-
-const swaggerHTML = `<!DOCTYPE html>
-<html lang="en">
-	<head>
-		<meta charset="UTF-8" />
-		<title>Petstore API Docs</title>
-		<link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css" />
-		<style>
-			body { margin: 0; background: #fafafa; }
-			#swagger-ui { width: 100%; height: 100vh; }
-		</style>
-	</head>
-	<body>
-		<div id="swagger-ui"></div>
-		<script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
-		<script>
-			window.onload = () => {
-				window.ui = SwaggerUIBundle({
-					url: '__SPEC_URL__',
-					dom_id: '#swagger-ui',
-				});
-			};
-		</script>
-	</body>
-</html>`
-
 type ApiHandleFunctions struct {
 
 	// Routes for the PetAPI part of the API
@@ -145,6 +79,14 @@ type ApiHandleFunctions struct {
 	StoreAPI StoreAPI
 	// Routes for the UserAPI part of the API
 	UserAPI UserAPI
+	// Routes for the PartnerWebhookAPI part of the API
+	PartnerWebhookAPI PartnerWebhookAPI
+	// Routes for the CategoryAPI part of the API
+	CategoryAPI CategoryAPI
+	// Routes for the TagAPI part of the API
+	TagAPI TagAPI
+	// Routes for the GroomingAPI part of the API
+	GroomingAPI GroomingAPI
 }
 
 func getRoutes(handleFunctions ApiHandleFunctions) []Route {
@@ -161,6 +103,36 @@ func getRoutes(handleFunctions ApiHandleFunctions) []Route {
 			"/v2/pet/:petId",
 			handleFunctions.PetAPI.DeletePet,
 		},
+		{
+			"BatchGetPets",
+			http.MethodPost,
+			"/v2/pet/batchGet",
+			handleFunctions.PetAPI.BatchGetPets,
+		},
+		{
+			"BatchUpdatePetStatus",
+			http.MethodPost,
+			"/v2/pet/batchStatus",
+			handleFunctions.PetAPI.BatchUpdatePetStatus,
+		},
+		{
+			"BulkImportPets",
+			http.MethodPost,
+			"/v2/pet/bulk",
+			handleFunctions.PetAPI.BulkImportPets,
+		},
+		{
+			"ExportPets",
+			http.MethodGet,
+			"/v2/pet/export",
+			handleFunctions.PetAPI.ExportPets,
+		},
+		{
+			"StreamPetEvents",
+			http.MethodGet,
+			"/v2/pet/events",
+			handleFunctions.PetAPI.StreamPetEvents,
+		},
 		{
 			"FindPetsByStatus",
 			http.MethodGet,
@@ -185,6 +157,12 @@ func getRoutes(handleFunctions ApiHandleFunctions) []Route {
 			"/v2/pet",
 			handleFunctions.PetAPI.UpdatePet,
 		},
+		{
+			"PatchPet",
+			http.MethodPatch,
+			"/v2/pet/:petId",
+			handleFunctions.PetAPI.PatchPet,
+		},
 		{
 			"UpdatePetWithForm",
 			http.MethodPost,
@@ -197,12 +175,108 @@ func getRoutes(handleFunctions ApiHandleFunctions) []Route {
 			"/v2/pet/:petId/groom",
 			handleFunctions.PetAPI.GroomPet,
 		},
+		{
+			"GetPetGroomingHistory",
+			http.MethodGet,
+			"/v2/pet/:petId/groomingHistory",
+			handleFunctions.GroomingAPI.GetPetGroomingHistory,
+		},
 		{
 			"UploadFile",
 			http.MethodPost,
 			"/v2/pet/:petId/uploadImage",
 			handleFunctions.PetAPI.UploadFile,
 		},
+		{
+			"ListCategories",
+			http.MethodGet,
+			"/v2/category",
+			handleFunctions.CategoryAPI.ListCategories,
+		},
+		{
+			"CreateCategory",
+			http.MethodPost,
+			"/v2/category",
+			handleFunctions.CategoryAPI.CreateCategory,
+		},
+		{
+			"GetCategoryById",
+			http.MethodGet,
+			"/v2/category/:categoryId",
+			handleFunctions.CategoryAPI.GetCategoryById,
+		},
+		{
+			"UpdateCategory",
+			http.MethodPut,
+			"/v2/category/:categoryId",
+			handleFunctions.CategoryAPI.UpdateCategory,
+		},
+		{
+			"DeleteCategory",
+			http.MethodDelete,
+			"/v2/category/:categoryId",
+			handleFunctions.CategoryAPI.DeleteCategory,
+		},
+		{
+			"ListTags",
+			http.MethodGet,
+			"/v2/tag",
+			handleFunctions.TagAPI.ListTags,
+		},
+		{
+			"CreateTag",
+			http.MethodPost,
+			"/v2/tag",
+			handleFunctions.TagAPI.CreateTag,
+		},
+		{
+			"RenameTag",
+			http.MethodPut,
+			"/v2/tag/:tagId",
+			handleFunctions.TagAPI.RenameTag,
+		},
+		{
+			"MergeTag",
+			http.MethodPost,
+			"/v2/tag/:tagId/merge",
+			handleFunctions.TagAPI.MergeTag,
+		},
+		{
+			"ListGroomingAppointments",
+			http.MethodGet,
+			"/v2/grooming/appointment",
+			handleFunctions.GroomingAPI.ListGroomingAppointments,
+		},
+		{
+			"ScheduleGroomingAppointment",
+			http.MethodPost,
+			"/v2/grooming/appointment",
+			handleFunctions.GroomingAPI.ScheduleGroomingAppointment,
+		},
+		{
+			"GetGroomingAppointmentById",
+			http.MethodGet,
+			"/v2/grooming/appointment/:appointmentId",
+			handleFunctions.GroomingAPI.GetGroomingAppointmentById,
+		},
+		{
+			"RescheduleGroomingAppointment",
+			http.MethodPut,
+			"/v2/grooming/appointment/:appointmentId",
+			handleFunctions.GroomingAPI.RescheduleGroomingAppointment,
+		},
+		{
+			"CancelGroomingAppointment",
+			http.MethodPost,
+			"/v2/grooming/appointment/:appointmentId/cancel",
+			handleFunctions.GroomingAPI.CancelGroomingAppointment,
+		},
+		{
+			"CompleteGroomingAppointment",
+			http.MethodPost,
+			"/v2/grooming/appointment/:appointmentId/complete",
+			handleFunctions.GroomingAPI.CompleteGroomingAppointment,
+		},
 		{
 			"DeleteOrder",
 			http.MethodDelete,
@@ -275,5 +349,11 @@ func getRoutes(handleFunctions ApiHandleFunctions) []Route {
 			"/v2/user/:username",
 			handleFunctions.UserAPI.UpdateUser,
 		},
+		{
+			"ReceivePartnerWebhook",
+			http.MethodPost,
+			"/v2/partner/webhooks/:provider",
+			handleFunctions.PartnerWebhookAPI.ReceivePartnerWebhook,
+		},
 	}
 }
@@ -8,32 +8,83 @@ import (
 	"time"
 
 	"go.temporal.io/sdk/client"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	petsevents "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/events"
+	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
+	petsapp "github.com/Apurer/go-gin-api-server/internal/domains/pets/application"
+	platformgrpcserver "github.com/Apurer/go-gin-api-server/internal/platform/grpcserver"
+	platformhttpserver "github.com/Apurer/go-gin-api-server/internal/platform/httpserver"
+	platformobservability "github.com/Apurer/go-gin-api-server/internal/platform/observability"
+	platformopenapi "github.com/Apurer/go-gin-api-server/internal/platform/openapi"
+	platformsecurity "github.com/Apurer/go-gin-api-server/internal/platform/security"
 )
 
-const defaultSessionTTLHours = 24
+const (
+	serviceName            = "petstore-api"
+	defaultSessionTTLHours = 24
+)
 
 // Config carries environment-driven settings for the API process.
 type Config struct {
 	Port                       string
+	HTTP                       platformhttpserver.Config
+	GRPC                       platformgrpcserver.Config
 	PostgresDSN                string
 	TemporalAddress            string
 	TemporalNamespace          string
 	TemporalDisabled           bool
-	PartnerAPIBaseURL          string
+	PartnerProviders           []petspartner.ProviderConfig
+	PartnerResilience          partnerclient.ResilienceConfig
+	PartnerWebhookSecrets      map[string]string
+	PartnerWebhookTolerance    time.Duration
 	SessionPurgeIntervalMinute int
 	SessionTTL                 time.Duration
+	MetricsCacheInterval       time.Duration
+	Observability              platformobservability.Config
+	// OpenAPISpecPath overrides the embedded contract used for security requirements, request
+	// validation, and the published docs; empty uses the spec compiled into the binary.
+	OpenAPISpecPath string
+	// OpenAPIServerURLs replace the servers block of the published spec.
+	OpenAPIServerURLs []string
+	Security          platformsecurity.Config
+	// OpenAPIRequestValidation rejects requests that do not match the contract with a 400 problem.
+	OpenAPIRequestValidation bool
+	// OpenAPIResponseValidation controls whether contract drift in responses is ignored, logged, or failed.
+	OpenAPIResponseValidation platformopenapi.ResponseMode
+	// PetEventReplaySize bounds how many catalog events a reconnecting stream client can catch up on.
+	PetEventReplaySize int
+	PetEventHeartbeat  time.Duration
+	// GroomingReminderLead is how long before a grooming appointment its reminder is sent.
+	GroomingReminderLead time.Duration
 }
 
 // LoadConfig reads environment variables, applies defaults, and validates basic constraints.
 func LoadConfig() (Config, error) {
 	cfg := Config{
-		Port:              envDefault("PORT", "8080"),
-		PostgresDSN:       strings.TrimSpace(os.Getenv("POSTGRES_DSN")),
-		TemporalAddress:   envDefault("TEMPORAL_ADDRESS", client.DefaultHostPort),
-		TemporalNamespace: envDefault("TEMPORAL_NAMESPACE", client.DefaultNamespace),
-		TemporalDisabled:  isTruthy(os.Getenv("TEMPORAL_DISABLED")),
-		PartnerAPIBaseURL: strings.TrimSpace(os.Getenv("PARTNER_API_BASE_URL")),
-		SessionTTL:        time.Duration(defaultSessionTTLHours) * time.Hour,
+		Port:                     envDefault("PORT", "8080"),
+		PostgresDSN:              strings.TrimSpace(os.Getenv("POSTGRES_DSN")),
+		TemporalAddress:          envDefault("TEMPORAL_ADDRESS", client.DefaultHostPort),
+		TemporalNamespace:        envDefault("TEMPORAL_NAMESPACE", client.DefaultNamespace),
+		TemporalDisabled:         isTruthy(os.Getenv("TEMPORAL_DISABLED")),
+		SessionTTL:               time.Duration(defaultSessionTTLHours) * time.Hour,
+		MetricsCacheInterval:     platformobservability.DefaultGaugeCacheInterval,
+		PartnerWebhookTolerance:  petspartner.DefaultWebhookTolerance,
+		OpenAPISpecPath:          strings.TrimSpace(os.Getenv("OPENAPI_SPEC_PATH")),
+		OpenAPIRequestValidation: !isFalsy(os.Getenv("OPENAPI_REQUEST_VALIDATION")),
+		PetEventReplaySize:       petsevents.DefaultReplaySize,
+		PetEventHeartbeat:        15 * time.Second,
+		GroomingReminderLead:     petsapp.DefaultReminderLead,
+	}
+	responseMode, err := platformopenapi.ParseResponseMode(os.Getenv("OPENAPI_RESPONSE_VALIDATION"))
+	if err != nil {
+		return Config{}, fmt.Errorf("OPENAPI_RESPONSE_VALIDATION: %w", err)
+	}
+	cfg.OpenAPIResponseValidation = responseMode
+	for _, serverURL := range strings.Split(os.Getenv("OPENAPI_SERVER_URLS"), ",") {
+		if serverURL = strings.TrimSpace(serverURL); serverURL != "" {
+			cfg.OpenAPIServerURLs = append(cfg.OpenAPIServerURLs, serverURL)
+		}
 	}
 	if raw := strings.TrimSpace(os.Getenv("SESSION_PURGE_INTERVAL_MINUTES")); raw != "" {
 		minutes, err := strconv.Atoi(raw)
@@ -49,9 +100,98 @@ func LoadConfig() (Config, error) {
 		}
 		cfg.SessionTTL = time.Duration(hours) * time.Hour
 	}
+	if raw := strings.TrimSpace(os.Getenv("METRICS_CACHE_INTERVAL_SECONDS")); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds <= 0 {
+			return Config{}, fmt.Errorf("METRICS_CACHE_INTERVAL_SECONDS must be a positive integer")
+		}
+		cfg.MetricsCacheInterval = time.Duration(seconds) * time.Second
+	}
+	if raw := strings.TrimSpace(os.Getenv("PET_EVENTS_REPLAY_SIZE")); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil || size <= 0 {
+			return Config{}, fmt.Errorf("PET_EVENTS_REPLAY_SIZE must be a positive integer")
+		}
+		cfg.PetEventReplaySize = size
+	}
+	if raw := strings.TrimSpace(os.Getenv("PET_EVENTS_HEARTBEAT_SECONDS")); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds <= 0 {
+			return Config{}, fmt.Errorf("PET_EVENTS_HEARTBEAT_SECONDS must be a positive integer")
+		}
+		cfg.PetEventHeartbeat = time.Duration(seconds) * time.Second
+	}
+	if raw := strings.TrimSpace(os.Getenv("GROOMING_REMINDER_LEAD_MINUTES")); raw != "" {
+		minutes, err := strconv.Atoi(raw)
+		if err != nil || minutes <= 0 {
+			return Config{}, fmt.Errorf("GROOMING_REMINDER_LEAD_MINUTES must be a positive integer")
+		}
+		cfg.GroomingReminderLead = time.Duration(minutes) * time.Minute
+	}
+	secrets, err := parseWebhookSecrets(os.Getenv("PARTNER_WEBHOOK_SECRETS"))
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.PartnerWebhookSecrets = secrets
+	if raw := strings.TrimSpace(os.Getenv("PARTNER_WEBHOOK_TOLERANCE_SECONDS")); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds <= 0 {
+			return Config{}, fmt.Errorf("PARTNER_WEBHOOK_TOLERANCE_SECONDS must be a positive integer")
+		}
+		cfg.PartnerWebhookTolerance = time.Duration(seconds) * time.Second
+	}
+	providers, err := petspartner.LoadProviderConfigs()
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.PartnerProviders = providers
+	partnerCfg, err := partnerclient.LoadResilienceConfig()
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.PartnerResilience = partnerCfg
+	httpCfg, err := platformhttpserver.LoadConfig()
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.HTTP = httpCfg
+	grpcCfg, err := platformgrpcserver.LoadConfig()
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.GRPC = grpcCfg
+	obsCfg, err := platformobservability.LoadConfig(serviceName)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Observability = obsCfg
+	securityCfg, err := platformsecurity.LoadConfig()
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Security = securityCfg
 	return cfg, nil
 }
 
+// parseWebhookSecrets reads "provider=secret" pairs separated by commas.
+func parseWebhookSecrets(raw string) (map[string]string, error) {
+	secrets := map[string]string{}
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		provider, secret, ok := strings.Cut(pair, "=")
+		provider = strings.TrimSpace(provider)
+		secret = strings.TrimSpace(secret)
+		if !ok || provider == "" || secret == "" {
+			return nil, fmt.Errorf("PARTNER_WEBHOOK_SECRETS entries must look like provider=secret")
+		}
+		secrets[provider] = secret
+	}
+	return secrets, nil
+}
+
 func envDefault(key, fallback string) string {
 	if val := strings.TrimSpace(os.Getenv(key)); val != "" {
 		return val
@@ -63,3 +203,8 @@ func isTruthy(value string) bool {
 	value = strings.TrimSpace(strings.ToLower(value))
 	return value == "1" || value == "true" || value == "yes"
 }
+
+func isFalsy(value string) bool {
+	value = strings.TrimSpace(strings.ToLower(value))
+	return value == "0" || value == "false" || value == "no"
+}
@@ -2,41 +2,61 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/otel/metric"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 	temporalotel "go.temporal.io/sdk/contrib/opentelemetry"
 	workerlog "go.temporal.io/sdk/log"
+	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 
+	apispec "github.com/Apurer/go-gin-api-server/api"
+	petsv1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/pets/v1"
+	storev1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/store/v1"
+	usersv1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/users/v1"
 	petstoreserver "github.com/Apurer/go-gin-api-server/generated/go"
 
 	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	petsevents "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/events"
 	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
+	petsgrpc "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/grpc"
 	petsmemory "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	petsnotifications "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/notifications"
 	petsobs "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/observability"
 	petspostgres "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/persistence/postgres"
 	petsworkflows "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/workflows"
 	petsapp "github.com/Apurer/go-gin-api-server/internal/domains/pets/application"
 	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	storegrpc "github.com/Apurer/go-gin-api-server/internal/domains/store/adapters/grpc"
 	storeobs "github.com/Apurer/go-gin-api-server/internal/domains/store/adapters/observability"
 	storepostgres "github.com/Apurer/go-gin-api-server/internal/domains/store/adapters/persistence/postgres"
+	platformgrpcserver "github.com/Apurer/go-gin-api-server/internal/platform/grpcserver"
+	platformhttpserver "github.com/Apurer/go-gin-api-server/internal/platform/httpserver"
 	platformmigrations "github.com/Apurer/go-gin-api-server/internal/platform/migrations"
 	platformobservability "github.com/Apurer/go-gin-api-server/internal/platform/observability"
+	platformopenapi "github.com/Apurer/go-gin-api-server/internal/platform/openapi"
 	platformpostgres "github.com/Apurer/go-gin-api-server/internal/platform/postgres"
+	platformsecurity "github.com/Apurer/go-gin-api-server/internal/platform/security"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/correlation"
 
 	storememory "github.com/Apurer/go-gin-api-server/internal/domains/store/adapters/memory"
 	storeapp "github.com/Apurer/go-gin-api-server/internal/domains/store/application"
 	storeports "github.com/Apurer/go-gin-api-server/internal/domains/store/ports"
 
+	usergrpc "github.com/Apurer/go-gin-api-server/internal/domains/users/adapters/grpc"
 	usermemory "github.com/Apurer/go-gin-api-server/internal/domains/users/adapters/memory"
 	userobs "github.com/Apurer/go-gin-api-server/internal/domains/users/adapters/observability"
 	userpostgres "github.com/Apurer/go-gin-api-server/internal/domains/users/adapters/persistence/postgres"
@@ -44,14 +64,14 @@ import (
 	userports "github.com/Apurer/go-gin-api-server/internal/domains/users/ports"
 )
 
-// Run boots the Petstore HTTP API with observability, repositories, and workflows wired.
+// Run boots the Petstore HTTP and gRPC APIs with observability, repositories, and workflows
+// wired. It serves until ctx is cancelled (SIGTERM in cmd/api) and then drains in-flight requests.
 func Run(ctx context.Context) error {
-	const serviceName = "petstore-api"
 	cfg, err := LoadConfig()
 	if err != nil {
 		return err
 	}
-	instruments, shutdown, err := platformobservability.Init(ctx, serviceName)
+	instruments, shutdown, err := platformobservability.Init(ctx, cfg.Observability)
 	if err != nil {
 		return fmt.Errorf("failed to initialize observability: %w", err)
 	}
@@ -72,13 +92,29 @@ func Run(ctx context.Context) error {
 		}
 	}
 
-	petRepo := buildPetRepository(db)
+	petRepo, categoryRepo, tagRepo := buildPetRepositories(db)
 	petIdempotencyStore := buildPetIdempotencyStore(db)
-	partnerSync := buildPartnerSync(cfg.PartnerAPIBaseURL, logger)
+	petImportReviews, webhookReplayGuard := buildPartnerWebhookStores(db)
+	partnerRegistry, err := buildPartnerRegistry(cfg, logger, instruments)
+	if err != nil {
+		return fmt.Errorf("init partner providers: %w", err)
+	}
+	var partnerSync petsports.PartnerSync
+	if partnerRegistry != nil {
+		partnerSync = partnerRegistry
+	}
+	businessMeter := instruments.Meter("internal.business")
+	petMetrics := petsobs.NewBusinessMetrics(businessMeter)
+	petEvents := petsevents.NewBus(petsevents.WithReplaySize(cfg.PetEventReplaySize))
 	corePetService := petsapp.NewService(
 		petRepo,
 		petsapp.WithPartnerSync(partnerSync),
 		petsapp.WithIdempotencyStore(petIdempotencyStore),
+		petsapp.WithBusinessMetrics(petMetrics),
+		petsapp.WithImportReviewStore(petImportReviews),
+		petsapp.WithEventPublisher(buildPetEventPublisher(ctx, cfg, db, petRepo, petEvents, logger)),
+		petsapp.WithCategories(categoryRepo),
+		petsapp.WithTags(tagRepo),
 	)
 	petService := petsobs.New(
 		corePetService,
@@ -86,6 +122,18 @@ func Run(ctx context.Context) error {
 		petsobs.WithTracer(instruments.Tracer("internal.pets.application")),
 		petsobs.WithMeter(instruments.Meter("internal.pets.application")),
 	)
+	categoryReferences, _ := petRepo.(petsports.CategoryReferences)
+	categoryService := petsobs.NewCategoryService(
+		petsapp.NewCategoryService(categoryRepo, categoryReferences),
+		petsobs.WithLogger(logger),
+		petsobs.WithTracer(instruments.Tracer("internal.pets.application")),
+	)
+	tagReferences, _ := petRepo.(petsports.TagReferences)
+	tagService := petsobs.NewTagService(
+		petsapp.NewTagService(tagRepo, tagReferences),
+		petsobs.WithLogger(logger),
+		petsobs.WithTracer(instruments.Tracer("internal.pets.application")),
+	)
 	storeRepo := buildStoreRepository(db)
 	storeService := storeobs.New(
 		storeapp.NewService(storeRepo),
@@ -103,6 +151,7 @@ func Run(ctx context.Context) error {
 		userobs.WithMeter(instruments.Meter("internal.users.application")),
 	)
 	startSessionPurger(ctx, logger, userSessionStore, cfg.SessionPurgeIntervalMinute)
+	registerBusinessGauges(businessMeter, cfg.MetricsCacheInterval, logger, petRepo, storeRepo, userSessionStore)
 
 	var petWorkflows petsports.WorkflowOrchestrator
 	var temporalClient client.Client
@@ -119,30 +168,240 @@ func Run(ctx context.Context) error {
 		petWorkflows = petsworkflows.NewTemporalPetWorkflows(temporalClient)
 		logger.Info("Temporal workflows enabled", slog.String("namespace", cfg.TemporalNamespace))
 	}
+	appointmentRepo := buildAppointmentRepository(db)
+	groomingReminders := petsapp.NewGroomingReminders(appointmentRepo, petsnotifications.NewLogNotifier(logger))
+	var reminderScheduler petsports.GroomingReminderScheduler
+	if temporalClient != nil {
+		reminderScheduler = petsworkflows.NewTemporalGroomingReminders(temporalClient)
+	} else {
+		reminderScheduler = petsworkflows.NewInlineGroomingReminders(groomingReminders, logger)
+	}
+	appointmentService := petsobs.NewAppointmentService(
+		petsapp.NewAppointmentService(appointmentRepo, petService,
+			petsapp.WithReminderScheduler(reminderScheduler),
+			petsapp.WithReminderLead(cfg.GroomingReminderLead),
+		),
+		petsobs.WithLogger(logger),
+		petsobs.WithTracer(instruments.Tracer("internal.pets.application")),
+	)
 
+	eventStreamsClosing := make(chan struct{})
 	handlers := petstoreserver.ApiHandleFunctions{
-		PetAPI:   petstoreserver.NewPetAPI(petService, petWorkflows, petIdempotencyStore),
+		PetAPI: petstoreserver.NewPetAPI(petService, petWorkflows,
+			petstoreserver.WithPetEventSource(petEvents),
+			petstoreserver.WithEventHeartbeat(cfg.PetEventHeartbeat),
+			petstoreserver.WithEventStreamsClosing(eventStreamsClosing),
+		),
 		StoreAPI: petstoreserver.NewStoreAPI(storeService),
 		UserAPI:  petstoreserver.NewUserAPI(userService),
+		PartnerWebhookAPI: petstoreserver.NewPartnerWebhookAPI(
+			petService,
+			petspartner.NewWebhookVerifier(cfg.PartnerWebhookSecrets, cfg.PartnerWebhookTolerance),
+			webhookReplayGuard,
+		),
+		CategoryAPI: petstoreserver.NewCategoryAPI(categoryService),
+		TagAPI:      petstoreserver.NewTagAPI(tagService),
+		GroomingAPI: petstoreserver.NewGroomingAPI(appointmentService),
+	}
+
+	// otelgin must be attached before routes are registered so every handler, including probes, sees it.
+	router := gin.Default()
+	router.Use(otelgin.Middleware(serviceName, otelgin.WithTracerProvider(instruments.TracerProvider)))
+	router.Use(platformhttpserver.RequestID())
+	// The bulk import streams rows as it reads them, so it gets its own deadline instead of the body
+	// cap; the export streams its response the same way. The event stream stays open until the
+	// client leaves or shutdown ends it, so its deadlines are lifted.
+	router.Use(platformhttpserver.LimitBody(cfg.HTTP.MaxBodyBytes,
+		platformhttpserver.RouteLimit{Method: http.MethodPost, Route: "/v2/pet/bulk", Deadline: cfg.HTTP.StreamingTimeout},
+		platformhttpserver.RouteLimit{Method: http.MethodGet, Route: "/v2/pet/export", Deadline: cfg.HTTP.StreamingTimeout},
+		platformhttpserver.RouteLimit{Method: http.MethodGet, Route: "/v2/pet/events"},
+	))
+	specData, err := openAPISpec(cfg)
+	if err != nil {
+		return err
+	}
+	spec, err := platformopenapi.Load(ctx, specData)
+	if err != nil {
+		return fmt.Errorf("load openapi spec: %w", err)
+	}
+	docs, err := platformopenapi.NewDocs(ctx, specData, openAPIDocsOptions(cfg)...)
+	if err != nil {
+		return fmt.Errorf("publish openapi spec: %w", err)
 	}
+	var authenticator *platformsecurity.Authenticator
+	if cfg.Security.Enabled {
+		authenticator, err = buildAuthenticator(ctx, cfg, spec, db, logger)
+		if err != nil {
+			return fmt.Errorf("configure api security: %w", err)
+		}
+		router.Use(authenticator.Middleware())
+		logger.Info("API security enforced", slog.Any("security", cfg.Security.Summary()))
+	} else {
+		logger.Warn("API security disabled via config; OpenAPI security requirements are not enforced")
+	}
+	// Validation runs after authentication so unauthenticated callers never learn the contract's shape.
+	router.Use(platformopenapi.NewValidator(spec,
+		platformopenapi.WithRequestValidation(cfg.OpenAPIRequestValidation),
+		platformopenapi.WithResponseValidation(cfg.OpenAPIResponseValidation),
+		platformopenapi.WithValidationLogger(logger),
+	).Middleware())
+	cacheControl, err := platformopenapi.CacheControl(spec, cfg.HTTP.CachePolicies)
+	if err != nil {
+		return fmt.Errorf("configure cache policies: %w", err)
+	}
+	router.Use(cacheControl)
+	petstoreserver.NewRouterWithGinEngine(router, handlers)
+	docs.Register(router)
+	server := platformhttpserver.New(":"+cfg.Port, router, cfg.HTTP,
+		platformhttpserver.WithLogger(logger),
+		platformhttpserver.WithOnShutdown(func() { close(eventStreamsClosing) }),
+	)
+	registerHealthRoutes(router, cfg, db, temporalClient, partnerRegistry, server.Ready)
 
-	router := petstoreserver.NewRouter(handlers)
-	router.Use(otelgin.Middleware(serviceName))
-	registerHealthRoutes(router, cfg, db, temporalClient)
-	addr := ":" + cfg.Port
-	logger.Info("Petstore API listening", slog.String("addr", addr))
-	if err := router.Run(addr); err != nil {
-		logger.Error("Petstore API server exited", slog.String("addr", addr), slog.String("error", err.Error()))
+	// Both servers stop together: cancellation or a failed listener on either ends the other.
+	group, serveCtx := errgroup.WithContext(ctx)
+	if cfg.GRPC.Disabled {
+		logger.Info("gRPC API disabled via config")
+	} else {
+		grpcServer := buildGRPCServer(cfg, instruments, authenticator, petService, petWorkflows, storeService, userService)
+		logger.Info("Petstore gRPC API starting", slog.String("addr", ":"+cfg.GRPC.Port), slog.Any("grpc", cfg.GRPC.Summary()))
+		group.Go(func() error {
+			return grpcServer.Run(serveCtx)
+		})
+	}
+	logger.Info("Petstore API starting", slog.String("addr", ":"+cfg.Port), slog.Any("http", cfg.HTTP.Summary()))
+	group.Go(func() error {
+		return server.Run(serveCtx)
+	})
+	if err := group.Wait(); err != nil {
+		logger.Error("Petstore API server exited", slog.String("error", err.Error()))
 		return err
 	}
 	return nil
 }
 
-func buildPetRepository(db *gorm.DB) petsports.Repository {
+// grpcOperations names the spec operation each RPC mirrors; the RPC enforces that operation's
+// security requirements, so a token needs the same scopes over gRPC as over HTTP.
+var grpcOperations = map[string]string{
+	petsv1.PetService_AddPet_FullMethodName:               "addPet",
+	petsv1.PetService_UpdatePet_FullMethodName:            "updatePet",
+	petsv1.PetService_GetPet_FullMethodName:               "getPetById",
+	petsv1.PetService_FindPetsByStatus_FullMethodName:     "findPetsByStatus",
+	petsv1.PetService_FindPetsByTags_FullMethodName:       "findPetsByTags",
+	petsv1.PetService_ListPets_FullMethodName:             "exportPets",
+	petsv1.PetService_BatchGetPets_FullMethodName:         "batchGetPets",
+	petsv1.PetService_BatchUpdatePetStatus_FullMethodName: "batchUpdatePetStatus",
+	petsv1.PetService_DeletePet_FullMethodName:            "deletePet",
+	petsv1.PetService_GroomPet_FullMethodName:             "groomPet",
+	petsv1.PetService_UploadImage_FullMethodName:          "uploadFile",
+	petsv1.PetService_ImportPets_FullMethodName:           "bulkImportPets",
+	petsv1.PetService_ExportPets_FullMethodName:           "exportPets",
+	storev1.StoreService_PlaceOrder_FullMethodName:        "placeOrder",
+	storev1.StoreService_GetOrder_FullMethodName:          "getOrderById",
+	storev1.StoreService_DeleteOrder_FullMethodName:       "deleteOrder",
+	storev1.StoreService_GetInventory_FullMethodName:      "getInventory",
+	usersv1.UserService_CreateUser_FullMethodName:         "createUser",
+	usersv1.UserService_CreateUsers_FullMethodName:        "createUsersWithListInput",
+	usersv1.UserService_GetUser_FullMethodName:            "getUserByName",
+	usersv1.UserService_UpdateUser_FullMethodName:         "updateUser",
+	usersv1.UserService_DeleteUser_FullMethodName:         "deleteUser",
+	usersv1.UserService_Login_FullMethodName:              "loginUser",
+	usersv1.UserService_Logout_FullMethodName:             "logoutUser",
+}
+
+// buildGRPCServer serves the same observability-decorated services as the HTTP handlers.
+// Credentials are required on every call when API security is enabled, and they must meet the
+// requirements of the spec operation the RPC mirrors (see grpcOperations).
+func buildGRPCServer(cfg Config, instruments *platformobservability.Instruments, authenticator *platformsecurity.Authenticator,
+	pets petsports.Service, petWorkflows petsports.WorkflowOrchestrator, store storeports.Service, users userports.Service) *platformgrpcserver.Server {
+	opts := []platformgrpcserver.Option{
+		platformgrpcserver.WithLogger(instruments.Logger),
+		platformgrpcserver.WithTracerProvider(instruments.TracerProvider),
+		platformgrpcserver.WithMeterProvider(instruments.MeterProvider),
+	}
+	if authenticator != nil {
+		opts = append(opts,
+			platformgrpcserver.WithUnaryInterceptors(authenticator.UnaryServerInterceptor()),
+			platformgrpcserver.WithStreamInterceptors(authenticator.StreamServerInterceptor()),
+		)
+	}
+	server := platformgrpcserver.New(cfg.GRPC, opts...)
+	petsv1.RegisterPetServiceServer(server, petsgrpc.NewServer(pets, petsgrpc.WithWorkflows(petWorkflows)))
+	storev1.RegisterStoreServiceServer(server, storegrpc.NewServer(store))
+	usersv1.RegisterUserServiceServer(server, usergrpc.NewServer(users))
+	return server
+}
+
+// buildPetEventPublisher feeds the in-process bus directly, or through Postgres LISTEN/NOTIFY
+// when a database is configured so every instance streams changes made on any of them.
+func buildPetEventPublisher(ctx context.Context, cfg Config, db *gorm.DB, repo petsports.Repository, bus *petsevents.Bus, logger *slog.Logger) petsports.PetEventPublisher {
 	if db == nil {
-		return petsmemory.NewRepository()
+		return bus
 	}
-	return petspostgres.NewRepository(db)
+	listener := petspostgres.NewEventListener(cfg.PostgresDSN, repo, bus, logger)
+	go func() {
+		if err := listener.Run(ctx); err != nil {
+			logger.Error("pet event relay stopped", slog.String("error", err.Error()))
+		}
+	}()
+	return petspostgres.NewEventNotifier(db, logger)
+}
+
+// buildAuthenticator enforces the spec's security requirements with the managed API key store and,
+// when a JWKS is configured, JWT bearer tokens.
+func buildAuthenticator(ctx context.Context, cfg Config, spec *platformopenapi.Spec, db *gorm.DB, logger *slog.Logger) (*platformsecurity.Authenticator, error) {
+	keyStore := buildAPIKeyStore(db)
+	for name, secret := range cfg.Security.APIKeys {
+		if _, err := keyStore.Register(ctx, name, secret); err != nil && !errors.Is(err, platformsecurity.ErrAPIKeyExists) {
+			return nil, fmt.Errorf("register api key %q: %w", name, err)
+		}
+	}
+	verifier, err := cfg.Security.JWTVerifier(nil)
+	if err != nil {
+		return nil, err
+	}
+	if verifier == nil {
+		logger.Warn("no JWKS configured; operations requiring OAuth2 scopes reject every request")
+	}
+	for method, operationID := range grpcOperations {
+		if _, ok := spec.OperationByID(operationID); !ok {
+			return nil, fmt.Errorf("gRPC method %s mirrors unknown operation %q", method, operationID)
+		}
+	}
+	return platformsecurity.NewAuthenticator(spec,
+		platformsecurity.WithAPIKeyStore(keyStore),
+		platformsecurity.WithJWTVerifier(verifier),
+		platformsecurity.WithLogger(logger),
+		platformsecurity.WithRPCOperations(grpcOperations),
+	), nil
+}
+
+func buildAPIKeyStore(db *gorm.DB) platformsecurity.APIKeyStore {
+	if db == nil {
+		return platformsecurity.NewMemoryAPIKeyStore()
+	}
+	return platformsecurity.NewPostgresAPIKeyStore(db)
+}
+
+// buildPetRepositories returns the pet repository together with the category and tag
+// catalogs it joins names from.
+func buildPetRepositories(db *gorm.DB) (petsports.Repository, petsports.CategoryRepository, petsports.TagRepository) {
+	if db == nil {
+		categories := petsmemory.NewCategoryRepository()
+		tags := petsmemory.NewTagRepository()
+		pets := petsmemory.NewRepository()
+		pets.WithCategories(categories)
+		pets.WithTags(tags)
+		return pets, categories, tags
+	}
+	return petspostgres.NewRepository(db), petspostgres.NewCategoryRepository(db), petspostgres.NewTagRepository(db)
+}
+
+func buildAppointmentRepository(db *gorm.DB) petsports.AppointmentRepository {
+	if db == nil {
+		return petsmemory.NewAppointmentRepository()
+	}
+	return petspostgres.NewAppointmentRepository(db)
 }
 
 func buildPetIdempotencyStore(db *gorm.DB) petsports.IdempotencyStore {
@@ -152,22 +411,31 @@ func buildPetIdempotencyStore(db *gorm.DB) petsports.IdempotencyStore {
 	return petspostgres.NewIdempotencyStore(db)
 }
 
-func buildPartnerSync(baseURL string, logger *slog.Logger) petsports.PartnerSync {
-	baseURL = strings.TrimSpace(baseURL)
-	if baseURL == "" {
-		return nil
-	}
-	if logger != nil {
-		logger.Info("partner sync enabled", slog.String("base_url", baseURL))
+func buildPartnerWebhookStores(db *gorm.DB) (petsports.ImportReviewStore, petsports.WebhookReplayGuard) {
+	if db == nil {
+		return petsmemory.NewImportReviewStore(), petsmemory.NewWebhookReplayGuard()
 	}
-	client, err := partnerclient.NewPartnerClient(baseURL, nil)
+	return petspostgres.NewImportReviewStore(db), petspostgres.NewWebhookReplayGuard(db)
+}
+
+// buildPartnerRegistry fails on unusable provider credentials (mTLS files, OAuth settings) so the
+// API does not start with partner sync silently disabled.
+func buildPartnerRegistry(cfg Config, logger *slog.Logger, instruments *platformobservability.Instruments) (*petspartner.Registry, error) {
+	if len(cfg.PartnerProviders) == 0 {
+		return nil, nil
+	}
+	registry, err := petspartner.BuildRegistry(cfg.PartnerProviders,
+		partnerclient.WithResilience(cfg.PartnerResilience),
+		partnerclient.WithMeter(instruments.Meter("internal.clients.partner")),
+		partnerclient.WithTracerProvider(instruments.TracerProvider),
+	)
 	if err != nil {
-		if logger != nil {
-			logger.Error("failed to init partner client", slog.String("base_url", baseURL), slog.String("error", err.Error()))
-		}
-		return nil
+		return nil, err
+	}
+	if logger != nil {
+		logger.Info("partner sync enabled", slog.Any("providers", registry.Providers()))
 	}
-	return petspartner.NewSyncer(client)
+	return registry, nil
 }
 
 func buildStoreRepository(db *gorm.DB) storeports.Repository {
@@ -219,6 +487,19 @@ func connectPostgresWithConfig(ctx context.Context, logger *slog.Logger, dsn str
 	return db, func() { _ = sqlDB.Close() }
 }
 
+// registerBusinessGauges exposes catalog, order, and session gauges backed by cached repository counts.
+func registerBusinessGauges(meter metric.Meter, interval time.Duration, logger *slog.Logger, petRepo petsports.Repository, storeRepo storeports.Repository, sessions userports.SessionStore) {
+	if err := petsobs.RegisterCatalogGauges(meter, petRepo, interval, logger); err != nil {
+		logger.Warn("failed to register pet catalog gauges", slog.String("error", err.Error()))
+	}
+	if err := storeobs.RegisterOrderGauges(meter, storeRepo, interval, logger); err != nil {
+		logger.Warn("failed to register order gauges", slog.String("error", err.Error()))
+	}
+	if err := userobs.RegisterSessionGauges(meter, sessions, interval, logger); err != nil {
+		logger.Warn("failed to register session gauges", slog.String("error", err.Error()))
+	}
+}
+
 type sessionPurger interface {
 	PurgeExpired(ctx context.Context) error
 }
@@ -256,7 +537,9 @@ func startSessionPurger(ctx context.Context, logger *slog.Logger, store userport
 	}()
 }
 
-func registerHealthRoutes(router *gin.Engine, cfg Config, db *gorm.DB, temporalClient client.Client) {
+// registerHealthRoutes exposes liveness, readiness, and config probes. Readiness reports 503
+// "draining" once serving reports false so load balancers stop routing before shutdown.
+func registerHealthRoutes(router *gin.Engine, cfg Config, db *gorm.DB, temporalClient client.Client, partners *petspartner.Registry, serving func() bool) {
 	router.GET("/healthz", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"status": "ok"})
 	})
@@ -264,6 +547,10 @@ func registerHealthRoutes(router *gin.Engine, cfg Config, db *gorm.DB, temporalC
 		c.JSON(http.StatusOK, debugConfig(cfg))
 	})
 	router.GET("/readyz", func(c *gin.Context) {
+		if !serving() {
+			c.JSON(http.StatusServiceUnavailable, gin.H{"status": "draining"})
+			return
+		}
 		dbStatus := databaseStatus(c.Request.Context(), db)
 		temporalStatus := temporalStatus(c.Request.Context(), temporalClient)
 		status := http.StatusOK
@@ -274,10 +561,66 @@ func registerHealthRoutes(router *gin.Engine, cfg Config, db *gorm.DB, temporalC
 			"status":   "ok",
 			"database": dbStatus,
 			"temporal": temporalStatus,
+			"partner":  partnerStatus(partners),
 		})
 	})
 }
 
+// healthRouteDocs documents the probes registered by registerHealthRoutes in the published spec.
+// They are served from the host root, outside the contract's base path, and need no credentials.
+func healthRouteDocs() []platformopenapi.DocsOption {
+	probe := func(operationID, summary string, statuses ...int) *openapi3.PathItem {
+		responses := openapi3.NewResponses()
+		responses.Delete("default")
+		for _, status := range statuses {
+			description := http.StatusText(status)
+			responses.Set(strconv.Itoa(status), &openapi3.ResponseRef{
+				Value: openapi3.NewResponse().WithDescription(description).WithJSONSchema(openapi3.NewObjectSchema()),
+			})
+		}
+		return &openapi3.PathItem{Get: &openapi3.Operation{
+			OperationID: operationID,
+			Summary:     summary,
+			Tags:        []string{"operations"},
+			Security:    &openapi3.SecurityRequirements{},
+			Responses:   responses,
+		}}
+	}
+	return []platformopenapi.DocsOption{
+		platformopenapi.WithRootPath("/healthz", probe("getLiveness", "Liveness probe", http.StatusOK)),
+		platformopenapi.WithRootPath("/readyz", probe("getReadiness", "Readiness probe reporting database, Temporal, and partner status", http.StatusOK, http.StatusServiceUnavailable)),
+		platformopenapi.WithRootPath("/debug/config", probe("getDebugConfig", "Sanitized runtime configuration", http.StatusOK)),
+	}
+}
+
+// openAPISpec returns the contract compiled into the binary unless OPENAPI_SPEC_PATH points elsewhere.
+func openAPISpec(cfg Config) ([]byte, error) {
+	if cfg.OpenAPISpecPath == "" {
+		return apispec.OpenAPI, nil
+	}
+	data, err := os.ReadFile(cfg.OpenAPISpecPath)
+	if err != nil {
+		return nil, fmt.Errorf("read openapi spec: %w", err)
+	}
+	return data, nil
+}
+
+func openAPISpecSource(cfg Config) string {
+	if cfg.OpenAPISpecPath == "" {
+		return "embedded"
+	}
+	return cfg.OpenAPISpecPath
+}
+
+// openAPIDocsOptions publishes the configured servers and the routes added outside the generator.
+func openAPIDocsOptions(cfg Config) []platformopenapi.DocsOption {
+	opts := healthRouteDocs()
+	if len(cfg.OpenAPIServerURLs) > 0 {
+		opts = append(opts, platformopenapi.WithServers(cfg.OpenAPIServerURLs...))
+	}
+	return opts
+}
+
 func databaseStatus(ctx context.Context, db *gorm.DB) string {
 	if db == nil {
 		return "disabled"
@@ -294,6 +637,23 @@ func databaseStatus(ctx context.Context, db *gorm.DB) string {
 	return "ok"
 }
 
+// partnerStatus reports each provider's circuit state; an open circuit degrades sync but does not fail readiness.
+func partnerStatus(partners *petspartner.Registry) any {
+	if partners == nil {
+		return "disabled"
+	}
+	statuses := map[string]string{}
+	for provider, state := range partners.CircuitStates() {
+		switch state {
+		case partnerclient.CircuitClosed:
+			statuses[provider] = "ok"
+		default:
+			statuses[provider] = "degraded: circuit " + string(state)
+		}
+	}
+	return statuses
+}
+
 func temporalStatus(ctx context.Context, c client.Client) string {
 	if c == nil {
 		return "inline"
@@ -322,6 +682,7 @@ func connectTemporalClient(instruments *platformobservability.Instruments, cfg C
 		Logger:    logger,
 	}
 	options.Interceptors = append(options.Interceptors, tracingInterceptor)
+	options.ContextPropagators = append(options.ContextPropagators, correlation.NewPropagator())
 	return client.Dial(options)
 }
 
@@ -339,16 +700,40 @@ func effectiveTemporalNamespace(cfg Config) string {
 	return client.DefaultNamespace
 }
 
+// webhookProviders lists configured webhook providers without exposing their secrets.
+func webhookProviders(secrets map[string]string) []string {
+	providers := make([]string, 0, len(secrets))
+	for provider := range secrets {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+	return providers
+}
+
 // debugConfig returns a sanitized view of the runtime config for troubleshooting.
 func debugConfig(cfg Config) gin.H {
 	return gin.H{
 		"port":                        cfg.Port,
+		"http":                        cfg.HTTP.Summary(),
+		"grpc":                        cfg.GRPC.Summary(),
 		"postgres_enabled":            strings.TrimSpace(cfg.PostgresDSN) != "",
 		"temporal_disabled":           cfg.TemporalDisabled,
 		"temporal_address_set":        strings.TrimSpace(cfg.TemporalAddress) != "",
 		"temporal_namespace":          effectiveTemporalNamespace(cfg),
-		"partner_api_enabled":         strings.TrimSpace(cfg.PartnerAPIBaseURL) != "",
+		"partner_providers":           petspartner.ProviderSummary(cfg.PartnerProviders),
+		"partner_resilience":          cfg.PartnerResilience.Summary(),
+		"partner_webhook_providers":   webhookProviders(cfg.PartnerWebhookSecrets),
 		"session_ttl_hours":           cfg.SessionTTL.Hours(),
 		"session_purge_interval_mins": cfg.SessionPurgeIntervalMinute,
+		"metrics_cache_interval_secs": cfg.MetricsCacheInterval.Seconds(),
+		"observability":               cfg.Observability.Summary(),
+		"openapi_spec_path":           openAPISpecSource(cfg),
+		"openapi_server_urls":         cfg.OpenAPIServerURLs,
+		"security":                    cfg.Security.Summary(),
+		"openapi_request_validation":  cfg.OpenAPIRequestValidation,
+		"openapi_response_validation": cfg.OpenAPIResponseValidation,
+		"pet_events_replay_size":      cfg.PetEventReplaySize,
+		"pet_events_heartbeat_secs":   cfg.PetEventHeartbeat.Seconds(),
+		"grooming_reminder_lead_mins": cfg.GroomingReminderLead.Minutes(),
 	}
 }
@@ -2,16 +2,87 @@ package partner
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
-	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
 )
 
 // Client wraps the generated PartnerAPIClient with a simplified SyncPet helper.
 type Client struct {
-	api *ClientWithResponses
+	api       *ClientWithResponses
+	transport *resilientTransport
+	tracer    trace.Tracer
+	provider  string
+}
+
+const tracerName = "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+
+// Option customizes the partner client wiring.
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	resilience     ResilienceConfig
+	meter          metric.Meter
+	tracerProvider trace.TracerProvider
+	credentials    Credentials
+	tlsConfig      *tls.Config
+	provider       string
+}
+
+// WithResilience overrides retry, circuit breaker, and rate limit settings.
+func WithResilience(cfg ResilienceConfig) Option {
+	return func(opts *clientOptions) {
+		opts.resilience = cfg
+	}
+}
+
+// WithMeter exports retry counts and circuit breaker state through the given meter.
+func WithMeter(m metric.Meter) Option {
+	return func(opts *clientOptions) {
+		opts.meter = m
+	}
+}
+
+// WithBearerToken authenticates every partner request with a static bearer token.
+func WithBearerToken(token string) Option {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil
+	}
+	return WithCredentials(BearerToken(token))
+}
+
+// WithCredentials authenticates every partner request attempt, including retries.
+func WithCredentials(creds Credentials) Option {
+	return func(opts *clientOptions) {
+		opts.credentials = creds
+	}
+}
+
+// WithTLSConfig dials the partner with cfg, e.g. to present a client certificate for mTLS.
+// It requires the HTTP client's transport to be an *http.Transport (or unset).
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(opts *clientOptions) {
+		opts.tlsConfig = cfg
+	}
+}
+
+// WithProviderName labels client metrics and spans with the provider the client talks to.
+func WithProviderName(name string) Option {
+	return func(opts *clientOptions) {
+		opts.provider = strings.TrimSpace(name)
+	}
 }
 
 // SyncOption configures SyncPet behavior.
@@ -29,24 +100,98 @@ func WithIdempotencyKey(key string) SyncOption {
 	}
 }
 
+// WithTracerProvider records partner call spans on the given provider instead of the global one.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(opts *clientOptions) {
+		opts.tracerProvider = tp
+	}
+}
+
 // NewPartnerClient instantiates the partner client with sane defaults.
-func NewPartnerClient(baseURL string, httpClient *http.Client) (*Client, error) {
+// The transport of httpClient (or http.DefaultTransport) is wrapped with retries,
+// a circuit breaker, and an optional rate limiter; each attempt is traced through otelhttp so
+// traceparent reaches the partner, along with the caller's X-Request-ID. The caller's client is
+// not mutated.
+func NewPartnerClient(baseURL string, httpClient *http.Client, optFns ...Option) (*Client, error) {
 	baseURL = strings.TrimSpace(baseURL)
 	if baseURL == "" {
 		return nil, errors.New("partner base URL is required")
 	}
-	if httpClient == nil {
-		httpClient = &http.Client{Timeout: 5 * time.Second}
+	opts := clientOptions{resilience: DefaultResilienceConfig()}
+	for _, fn := range optFns {
+		if fn != nil {
+			fn(&opts)
+		}
+	}
+	if opts.tracerProvider == nil {
+		opts.tracerProvider = otel.GetTracerProvider()
+	}
+	wrapped := &http.Client{}
+	if httpClient != nil {
+		*wrapped = *httpClient
+	}
+	base := wrapped.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if opts.tlsConfig != nil {
+		httpTransport, ok := base.(*http.Transport)
+		if !ok {
+			return nil, errors.New("partner TLS config requires an *http.Transport")
+		}
+		httpTransport = httpTransport.Clone()
+		httpTransport.TLSClientConfig = opts.tlsConfig
+		base = httpTransport
+	}
+	var instrumented http.RoundTripper = otelhttp.NewTransport(attemptAnnotator{base: base},
+		otelhttp.WithTracerProvider(opts.tracerProvider),
+		otelhttp.WithPropagators(otel.GetTextMapPropagator()),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "partner HTTP " + r.Method
+		}),
+	)
+	if opts.credentials != nil {
+		instrumented = authTransport{base: instrumented, creds: opts.credentials}
+	}
+	transport := newResilientTransport(instrumented, opts.resilience)
+	if err := transport.registerMetrics(opts.meter, opts.provider); err != nil {
+		return nil, fmt.Errorf("register partner client metrics: %w", err)
 	}
-	api, err := NewClientWithResponses(baseURL, WithHTTPClient(httpClient))
+	wrapped.Transport = requestIDTransport{base: transport}
+	api, err := NewClientWithResponses(baseURL, WithHTTPClient(wrapped))
 	if err != nil {
 		return nil, fmt.Errorf("build partner client: %w", err)
 	}
-	return &Client{api: api}, nil
+	return &Client{api: api, transport: transport, tracer: opts.tracerProvider.Tracer(tracerName), provider: opts.provider}, nil
+}
+
+// requestIDTransport forwards the caller's request ID so the partner can correlate its logs
+// with ours; every retry of the call carries the same ID.
+type requestIDTransport struct {
+	base http.RoundTripper
+}
+
+func (t requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	id := requestid.FromContext(req.Context())
+	if id == "" || req.Header.Get(requestid.Header) != "" {
+		return t.base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set(requestid.Header, id)
+	return t.base.RoundTrip(req)
+}
+
+// CircuitState reports the circuit breaker position for readiness probes.
+func (c *Client) CircuitState() CircuitState {
+	if c == nil || c.transport == nil {
+		return CircuitClosed
+	}
+	return c.transport.breaker.current()
 }
 
 // SyncPet pushes the payload to the partner API.
-func (c *Client) SyncPet(ctx context.Context, payload PetPayload, optFns ...SyncOption) error {
+// The call is wrapped in a span that records the reference, final status, and attempt count.
+func (c *Client) SyncPet(ctx context.Context, payload PetPayload, optFns ...SyncOption) (err error) {
 	if c == nil || c.api == nil {
 		return errors.New("partner client not configured")
 	}
@@ -64,6 +209,17 @@ func (c *Client) SyncPet(ctx context.Context, payload PetPayload, optFns ...Sync
 	if opts.idempotencyKey != "" {
 		params = &SyncPetParams{IdempotencyKey: &opts.idempotencyKey}
 	}
+	ctx, span := c.startSpan(ctx, "partner.SyncPet", append([]attribute.KeyValue{
+		attribute.String("partner.reference", reference),
+		attribute.Bool("partner.idempotency_key", opts.idempotencyKey != ""),
+	}, providerAttributes(c.provider)...)...)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
 	resp, err := c.api.SyncPetWithResponse(ctx, reference, params, payload)
 	if err != nil {
 		return fmt.Errorf("call partner API: %w", err)
@@ -72,6 +228,7 @@ func (c *Client) SyncPet(ctx context.Context, payload PetPayload, optFns ...Sync
 		return errors.New("partner API returned an empty response")
 	}
 	status := resp.StatusCode()
+	span.SetAttributes(attribute.Int("http.response.status_code", status))
 	switch {
 	case status == http.StatusOK:
 		return nil
@@ -84,6 +241,91 @@ func (c *Client) SyncPet(ctx context.Context, payload PetPayload, optFns ...Sync
 	}
 }
 
+// ErrRemoteNotFound means the partner holds no pet for the requested reference.
+var ErrRemoteNotFound = errors.New("partner pet not found")
+
+// GetPet fetches the partner's current view of the pet, returning ErrRemoteNotFound on 404.
+func (c *Client) GetPet(ctx context.Context, reference string) (_ *PetPayload, err error) {
+	if c == nil || c.api == nil {
+		return nil, errors.New("partner client not configured")
+	}
+	reference = strings.TrimSpace(reference)
+	if reference == "" {
+		return nil, errors.New("partner reference is required")
+	}
+	ctx, span := c.startSpan(ctx, "partner.GetPet", append([]attribute.KeyValue{
+		attribute.String("partner.reference", reference),
+	}, providerAttributes(c.provider)...)...)
+	defer func() {
+		if err != nil && !errors.Is(err, ErrRemoteNotFound) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+	resp, err := c.api.GetPetWithResponse(ctx, reference)
+	if err != nil {
+		return nil, fmt.Errorf("call partner API: %w", err)
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode()))
+	switch status := resp.StatusCode(); {
+	case status == http.StatusOK && resp.JSON200 != nil:
+		return resp.JSON200, nil
+	case status == http.StatusNotFound:
+		return nil, ErrRemoteNotFound
+	case status >= http.StatusBadRequest:
+		return nil, fmt.Errorf("partner API error: %s", errorMessage(firstNonNil(resp.JSON4XX, resp.JSON5XX), resp.Status()))
+	default:
+		return nil, fmt.Errorf("partner API unexpected status: %s", resp.Status())
+	}
+}
+
+// ListPets returns one page of partner pets; an empty next cursor marks the last page.
+func (c *Client) ListPets(ctx context.Context, cursor string, limit int) (_ []PetPayload, next string, err error) {
+	if c == nil || c.api == nil {
+		return nil, "", errors.New("partner client not configured")
+	}
+	ctx, span := c.startSpan(ctx, "partner.ListPets", providerAttributes(c.provider)...)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+	params := &ListPetsParams{}
+	if cursor != "" {
+		params.Cursor = &cursor
+	}
+	if limit > 0 {
+		params.Limit = &limit
+	}
+	resp, err := c.api.ListPetsWithResponse(ctx, params)
+	if err != nil {
+		return nil, "", fmt.Errorf("call partner API: %w", err)
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode()))
+	switch status := resp.StatusCode(); {
+	case status == http.StatusOK && resp.JSON200 != nil:
+		if resp.JSON200.NextCursor != nil {
+			next = *resp.JSON200.NextCursor
+		}
+		return resp.JSON200.Items, next, nil
+	case status >= http.StatusBadRequest:
+		return nil, "", fmt.Errorf("partner API error: %s", errorMessage(firstNonNil(resp.JSON4XX, resp.JSON5XX), resp.Status()))
+	default:
+		return nil, "", fmt.Errorf("partner API unexpected status: %s", resp.Status())
+	}
+}
+
+func (c *Client) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	tracer := c.tracer
+	if tracer == nil {
+		tracer = otel.GetTracerProvider().Tracer(tracerName)
+	}
+	return tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
 func firstError(resp *SyncPetResponse) *Error {
 	if resp == nil {
 		return nil
@@ -100,6 +342,15 @@ func firstError(resp *SyncPetResponse) *Error {
 	return nil
 }
 
+func firstNonNil(bodies ...*Error) *Error {
+	for _, body := range bodies {
+		if body != nil {
+			return body
+		}
+	}
+	return nil
+}
+
 func errorMessage(body *Error, fallback string) string {
 	if body == nil {
 		return fallback
@@ -0,0 +1,309 @@
+package partner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
+)
+
+// stubPartner is an httptest stand-in that answers each call with the next scripted status.
+type stubPartner struct {
+	mu         sync.Mutex
+	statuses   []int
+	retryAfter string
+	calls      atomic.Int32
+	bodies     []string
+}
+
+func (s *stubPartner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	s.bodies = append(s.bodies, string(body))
+	idx := int(s.calls.Add(1)) - 1
+	status := http.StatusOK
+	if idx < len(s.statuses) {
+		status = s.statuses[idx]
+	}
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if status != http.StatusOK && s.retryAfter != "" {
+		w.Header().Set("Retry-After", s.retryAfter)
+	}
+	w.WriteHeader(status)
+	if status == http.StatusOK {
+		_ = json.NewEncoder(w).Encode(SyncResponse{})
+		return
+	}
+	message := http.StatusText(status)
+	_ = json.NewEncoder(w).Encode(Error{Message: &message})
+}
+
+func newTestClient(t *testing.T, stub *stubPartner, cfg ResilienceConfig) (*Client, *[]time.Duration) {
+	t.Helper()
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	client, err := NewPartnerClient(server.URL, server.Client(), WithResilience(cfg))
+	require.NoError(t, err)
+	sleeps := &[]time.Duration{}
+	client.transport.sleep = func(_ context.Context, d time.Duration) error {
+		*sleeps = append(*sleeps, d)
+		return nil
+	}
+	client.transport.jitter = func(d time.Duration) time.Duration { return d }
+	return client, sleeps
+}
+
+func testPayload() PetPayload {
+	return PetPayload{Reference: "42", Title: "Rex", Availability: "AVAILABLE", Photos: []string{"https://example.com/rex.jpg"}}
+}
+
+func TestSyncPet_RetriesServerErrorsWithBackoffAndReplaysBody(t *testing.T) {
+	stub := &stubPartner{statuses: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK}}
+	cfg := DefaultResilienceConfig()
+	cfg.BaseBackoff = 100 * time.Millisecond
+	client, sleeps := newTestClient(t, stub, cfg)
+
+	require.NoError(t, client.SyncPet(t.Context(), testPayload()))
+	require.EqualValues(t, 3, stub.calls.Load())
+	require.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}, *sleeps)
+	require.Equal(t, stub.bodies[0], stub.bodies[2])
+}
+
+func TestSyncPet_HonorsRetryAfter(t *testing.T) {
+	stub := &stubPartner{statuses: []int{http.StatusTooManyRequests}, retryAfter: "2"}
+	client, sleeps := newTestClient(t, stub, DefaultResilienceConfig())
+
+	require.NoError(t, client.SyncPet(t.Context(), testPayload()))
+	require.Equal(t, []time.Duration{2 * time.Second}, *sleeps)
+	require.Equal(t, CircuitClosed, client.CircuitState())
+}
+
+func TestSyncPet_DoesNotRetryClientErrors(t *testing.T) {
+	stub := &stubPartner{statuses: []int{http.StatusBadRequest}}
+	client, sleeps := newTestClient(t, stub, DefaultResilienceConfig())
+
+	require.Error(t, client.SyncPet(t.Context(), testPayload()))
+	require.EqualValues(t, 1, stub.calls.Load())
+	require.Empty(t, *sleeps)
+}
+
+func TestSyncPet_CircuitOpensAndRecoversAfterCooldown(t *testing.T) {
+	stub := &stubPartner{statuses: []int{500, 500, 500, 500}}
+	cfg := DefaultResilienceConfig()
+	cfg.MaxAttempts = 2
+	cfg.BreakerFailureThreshold = 3
+	cfg.BreakerCooldown = time.Minute
+	client, _ := newTestClient(t, stub, cfg)
+	now := time.Unix(0, 0)
+	client.transport.breaker.now = func() time.Time { return now }
+
+	require.Error(t, client.SyncPet(t.Context(), testPayload()))
+	err := client.SyncPet(t.Context(), testPayload())
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.EqualValues(t, 3, stub.calls.Load())
+	require.Equal(t, CircuitOpen, client.CircuitState())
+
+	require.ErrorIs(t, client.SyncPet(t.Context(), testPayload()), ErrCircuitOpen)
+	require.EqualValues(t, 3, stub.calls.Load())
+
+	now = now.Add(2 * time.Minute)
+	require.Equal(t, CircuitHalfOpen, client.CircuitState())
+	err = client.SyncPet(t.Context(), testPayload())
+	require.ErrorIs(t, err, ErrCircuitOpen, "failed half-open probe reopens the circuit")
+	require.EqualValues(t, 4, stub.calls.Load())
+
+	now = now.Add(2 * time.Minute)
+	require.NoError(t, client.SyncPet(t.Context(), testPayload()))
+	require.Equal(t, CircuitClosed, client.CircuitState())
+}
+
+func TestRoundTrip_ReleasesHalfOpenProbeWhenBodyCannotBeReplayed(t *testing.T) {
+	stub := &stubPartner{statuses: []int{http.StatusInternalServerError}}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	cfg := DefaultResilienceConfig()
+	cfg.MaxAttempts = 2
+	cfg.BreakerFailureThreshold = 1
+	cfg.BreakerCooldown = time.Minute
+	transport := newResilientTransport(server.Client().Transport, cfg)
+	now := time.Unix(0, 0)
+	transport.breaker.now = func() time.Time { return now }
+	transport.sleep = func(context.Context, time.Duration) error {
+		// The retry waits out the cooldown, so it is admitted as the half-open probe.
+		now = now.Add(2 * time.Minute)
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, strings.NewReader("{}"))
+	require.NoError(t, err)
+	req.GetBody = func() (io.ReadCloser, error) { return nil, errors.New("body gone") }
+	_, err = transport.RoundTrip(req)
+	require.ErrorContains(t, err, "body gone")
+	require.Equal(t, CircuitHalfOpen, transport.breaker.current())
+
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err, "the abandoned probe must not keep the circuit half-open")
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, CircuitClosed, transport.breaker.current())
+}
+
+func TestRoundTrip_CancelledOrThrottledProbeLeavesCircuitHalfOpen(t *testing.T) {
+	stub := &stubPartner{statuses: []int{http.StatusInternalServerError, http.StatusTooManyRequests}}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	cfg := DefaultResilienceConfig()
+	cfg.MaxAttempts = 1
+	cfg.BreakerFailureThreshold = 1
+	cfg.BreakerCooldown = time.Minute
+	transport := newResilientTransport(server.Client().Transport, cfg)
+	now := time.Unix(0, 0)
+	transport.breaker.now = func() time.Time { return now }
+	send := func(ctx context.Context) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		return transport.RoundTrip(req)
+	}
+
+	resp, err := send(t.Context())
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, CircuitOpen, transport.breaker.current())
+	now = now.Add(2 * time.Minute)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err = send(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, CircuitHalfOpen, transport.breaker.current(), "a cancelled probe does not close the circuit")
+
+	resp, err = send(t.Context())
+	require.NoError(t, err, "the cancelled probe is handed back")
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, CircuitHalfOpen, transport.breaker.current(), "throttling does not close the circuit")
+	require.EqualValues(t, 2, stub.calls.Load())
+
+	resp, err = send(t.Context())
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, CircuitClosed, transport.breaker.current())
+}
+
+func TestSyncPet_RateLimiterRespectsContext(t *testing.T) {
+	stub := &stubPartner{}
+	cfg := DefaultResilienceConfig()
+	cfg.RateLimit = 0.001
+	cfg.RateBurst = 1
+	client, _ := newTestClient(t, stub, cfg)
+
+	require.NoError(t, client.SyncPet(t.Context(), testPayload()))
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+	err := client.SyncPet(ctx, testPayload())
+	require.Error(t, err)
+	require.False(t, errors.Is(err, ErrCircuitOpen))
+	require.EqualValues(t, 1, stub.calls.Load())
+}
+
+func TestSyncPet_PropagatesTraceContextAndIdempotencyKeyPerAttempt(t *testing.T) {
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+	var (
+		mu           sync.Mutex
+		traceparents []string
+		keys         []string
+	)
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		calls++
+		first := calls == 1
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if first {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client, err := NewPartnerClient(server.URL, server.Client(), WithTracerProvider(tp))
+	require.NoError(t, err)
+	client.transport.sleep = func(context.Context, time.Duration) error { return nil }
+
+	require.NoError(t, client.SyncPet(t.Context(), testPayload(), WithIdempotencyKey("pet-42-abc")))
+	require.Equal(t, []string{"pet-42-abc", "pet-42-abc"}, keys)
+	require.Len(t, traceparents, 2)
+	require.NotEmpty(t, traceparents[0])
+	require.NotEqual(t, traceparents[0], traceparents[1], "each attempt gets its own client span")
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	parent := spans[len(spans)-1]
+	require.Equal(t, "partner.SyncPet", parent.Name())
+	require.Contains(t, parent.Attributes(), attribute.Int("partner.attempts", 2))
+	require.Contains(t, parent.Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+	for i, span := range spans[:2] {
+		require.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		require.Contains(t, span.Attributes(), attribute.Int("partner.attempt", i+1))
+	}
+}
+
+func TestSyncPet_ForwardsRequestIDOnEveryAttempt(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		ids   []string
+		calls int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		ids = append(ids, r.Header.Get(requestid.Header))
+		calls++
+		first := calls == 1
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if first {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	client, err := NewPartnerClient(server.URL, server.Client())
+	require.NoError(t, err)
+	client.transport.sleep = func(context.Context, time.Duration) error { return nil }
+
+	ctx := requestid.WithID(t.Context(), "req-123")
+	require.NoError(t, client.SyncPet(ctx, testPayload()))
+	require.Equal(t, []string{"req-123", "req-123"}, ids)
+
+	ids = nil
+	require.NoError(t, client.SyncPet(t.Context(), testPayload()))
+	require.Equal(t, []string{""}, ids, "calls without a request ID send no header")
+}
@@ -0,0 +1,466 @@
+package partner
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultAPIKeyHeader carries static API keys when no header is configured.
+	DefaultAPIKeyHeader = "X-API-Key"
+	// DefaultTokenRefreshBefore refreshes OAuth2 tokens in the background this long before they expire.
+	DefaultTokenRefreshBefore = 30 * time.Second
+
+	tokenFetchTimeout = 10 * time.Second
+)
+
+// Credentials authenticates outgoing partner requests; Apply is called once per attempt.
+type Credentials interface {
+	Apply(ctx context.Context, req *http.Request) error
+}
+
+// invalidator is implemented by credentials caching a token the partner may revoke early.
+type invalidator interface {
+	Invalidate()
+}
+
+// BearerToken sends a static "Authorization: Bearer" token.
+type BearerToken string
+
+// Apply implements Credentials.
+func (t BearerToken) Apply(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+string(t))
+	return nil
+}
+
+// APIKey sends a static key in Header (DefaultAPIKeyHeader when empty).
+type APIKey struct {
+	Header string
+	Key    string
+}
+
+// Apply implements Credentials.
+func (k APIKey) Apply(_ context.Context, req *http.Request) error {
+	header := k.Header
+	if header == "" {
+		header = DefaultAPIKeyHeader
+	}
+	req.Header.Set(header, k.Key)
+	return nil
+}
+
+// Token is an OAuth2 access token; a zero Expiry means the token does not expire.
+type Token struct {
+	AccessToken string
+	TokenType   string
+	Expiry      time.Time
+}
+
+func (t Token) validAt(now time.Time) bool {
+	return t.AccessToken != "" && (t.Expiry.IsZero() || now.Before(t.Expiry))
+}
+
+// TokenSource yields OAuth2 access tokens.
+type TokenSource interface {
+	Token(ctx context.Context) (Token, error)
+}
+
+// OAuth2 adapts a TokenSource into request credentials. A 401 from the partner invalidates the
+// cached token and the request is retried once with a fresh one.
+func OAuth2(source TokenSource) Credentials {
+	return oauth2Credentials{source: source}
+}
+
+type oauth2Credentials struct {
+	source TokenSource
+}
+
+func (c oauth2Credentials) Apply(ctx context.Context, req *http.Request) error {
+	token, err := c.source.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("partner oauth2 token: %w", err)
+	}
+	tokenType := token.TokenType
+	if tokenType == "" || strings.EqualFold(tokenType, "bearer") {
+		tokenType = "Bearer"
+	}
+	req.Header.Set("Authorization", tokenType+" "+token.AccessToken)
+	return nil
+}
+
+func (c oauth2Credentials) Invalidate() {
+	if inv, ok := c.source.(invalidator); ok {
+		inv.Invalidate()
+	}
+}
+
+// ClientCredentialsConfig configures the OAuth2 client credentials grant (RFC 6749 §4.4).
+type ClientCredentialsConfig struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	Audience     string
+	// RefreshBefore starts a background refresh once the cached token is this close to expiry.
+	RefreshBefore time.Duration
+	// HTTPClient calls the token endpoint; it defaults to a client sharing the partner TLS settings.
+	HTTPClient *http.Client
+}
+
+// ClientCredentialsSource caches client-credentials tokens. Callers keep using a still-valid token
+// while a single background refresh runs; once a token expires, concurrent callers share one fetch.
+type ClientCredentialsSource struct {
+	cfg ClientCredentialsConfig
+	now func() time.Time
+
+	mu       sync.Mutex
+	token    Token
+	inflight *tokenFetch
+}
+
+type tokenFetch struct {
+	done  chan struct{}
+	token Token
+	err   error
+}
+
+// NewClientCredentialsSource validates cfg and returns an empty token cache.
+func NewClientCredentialsSource(cfg ClientCredentialsConfig) (*ClientCredentialsSource, error) {
+	if strings.TrimSpace(cfg.TokenURL) == "" {
+		return nil, errors.New("oauth2 token URL is required")
+	}
+	if cfg.ClientID == "" || cfg.ClientSecret == "" {
+		return nil, errors.New("oauth2 client id and secret are required")
+	}
+	if cfg.RefreshBefore <= 0 {
+		cfg.RefreshBefore = DefaultTokenRefreshBefore
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: tokenFetchTimeout}
+	}
+	return &ClientCredentialsSource{cfg: cfg, now: time.Now}, nil
+}
+
+// Token returns the cached token, refreshing it proactively or synchronously as needed.
+func (s *ClientCredentialsSource) Token(ctx context.Context) (Token, error) {
+	s.mu.Lock()
+	now := s.now()
+	if s.token.validAt(now) {
+		token := s.token
+		if !token.Expiry.IsZero() && !now.Before(token.Expiry.Add(-s.cfg.RefreshBefore)) && s.inflight == nil {
+			s.startFetchLocked(ctx)
+		}
+		s.mu.Unlock()
+		return token, nil
+	}
+	fetch := s.inflight
+	if fetch == nil {
+		fetch = s.startFetchLocked(ctx)
+	}
+	s.mu.Unlock()
+	select {
+	case <-fetch.done:
+		return fetch.token, fetch.err
+	case <-ctx.Done():
+		return Token{}, ctx.Err()
+	}
+}
+
+// Invalidate drops the cached token so the next call fetches a new one.
+func (s *ClientCredentialsSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = Token{}
+}
+
+// startFetchLocked launches the single in-flight fetch. It is detached from the caller's
+// cancellation so one abandoned request does not fail everyone waiting on it.
+func (s *ClientCredentialsSource) startFetchLocked(ctx context.Context) *tokenFetch {
+	fetch := &tokenFetch{done: make(chan struct{})}
+	s.inflight = fetch
+	fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), tokenFetchTimeout)
+	go func() {
+		defer cancel()
+		token, err := s.fetch(fetchCtx)
+		s.mu.Lock()
+		if err == nil {
+			s.token = token
+		}
+		s.inflight = nil
+		s.mu.Unlock()
+		fetch.token, fetch.err = token, err
+		close(fetch.done)
+	}()
+	return fetch
+}
+
+func (s *ClientCredentialsSource) fetch(ctx context.Context) (Token, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.cfg.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.cfg.Scopes, " "))
+	}
+	if s.cfg.Audience != "" {
+		form.Set("audience", s.cfg.Audience)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(s.cfg.ClientID), url.QueryEscape(s.cfg.ClientSecret))
+	issuedAt := s.now()
+	resp, err := s.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return Token{}, fmt.Errorf("call token endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return Token{}, fmt.Errorf("read token response: %w", err)
+	}
+	var payload struct {
+		AccessToken      string          `json:"access_token"`
+		TokenType        string          `json:"token_type"`
+		ExpiresIn        json.RawMessage `json:"expires_in"`
+		Error            string          `json:"error"`
+		ErrorDescription string          `json:"error_description"`
+	}
+	_ = json.Unmarshal(body, &payload)
+	if resp.StatusCode != http.StatusOK {
+		if payload.Error != "" {
+			return Token{}, fmt.Errorf("token endpoint returned %s: %s %s", resp.Status, payload.Error, payload.ErrorDescription)
+		}
+		return Token{}, fmt.Errorf("token endpoint returned %s", resp.Status)
+	}
+	if payload.AccessToken == "" {
+		return Token{}, errors.New("token endpoint response has no access_token")
+	}
+	token := Token{AccessToken: payload.AccessToken, TokenType: payload.TokenType}
+	// Some providers encode expires_in as a string.
+	if raw := strings.Trim(string(payload.ExpiresIn), `"`); raw != "" && raw != "null" {
+		seconds, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return Token{}, fmt.Errorf("invalid expires_in %q", raw)
+		}
+		if seconds > 0 {
+			token.Expiry = issuedAt.Add(time.Duration(seconds) * time.Second)
+		}
+	}
+	return token, nil
+}
+
+// authTransport applies credentials to every attempt and retries once with a fresh token on 401.
+type authTransport struct {
+	base  http.RoundTripper
+	creds Credentials
+}
+
+func (t authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.send(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	inv, ok := t.creds.(invalidator)
+	if !ok || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		return resp, nil
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	inv.Invalidate()
+	retry := req
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry = req.Clone(req.Context())
+		retry.Body = body
+	}
+	return t.send(retry)
+}
+
+func (t authTransport) send(req *http.Request) (*http.Response, error) {
+	authed := req.Clone(req.Context())
+	if err := t.creds.Apply(req.Context(), authed); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(authed)
+}
+
+// TLSFiles points at PEM files for mutual TLS with the partner.
+type TLSFiles struct {
+	CertFile string
+	KeyFile  string
+	// CAFile replaces the system roots when set, e.g. for a private partner CA.
+	CAFile string
+}
+
+func (f TLSFiles) enabled() bool {
+	return f.CertFile != "" || f.KeyFile != "" || f.CAFile != ""
+}
+
+// Load builds the client TLS configuration.
+func (f TLSFiles) Load() (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if f.CertFile != "" || f.KeyFile != "" {
+		if f.CertFile == "" || f.KeyFile == "" {
+			return nil, errors.New("mTLS needs both a certificate and a key file")
+		}
+		cert, err := tls.LoadX509KeyPair(f.CertFile, f.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load partner client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	if f.CAFile != "" {
+		pem, err := os.ReadFile(f.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read partner CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("partner CA file contains no certificates")
+		}
+		cfg.RootCAs = pool
+	}
+	return cfg, nil
+}
+
+// CredentialsConfig selects at most one credential type per provider, optionally combined with mTLS.
+type CredentialsConfig struct {
+	BearerToken  string
+	APIKey       string
+	APIKeyHeader string
+	OAuth2       ClientCredentialsConfig
+	TLS          TLSFiles
+}
+
+// Kind names the configured credential type: "none", "bearer", "api_key", or "oauth2".
+func (c CredentialsConfig) Kind() string {
+	switch {
+	case c.OAuth2.TokenURL != "":
+		return "oauth2"
+	case c.APIKey != "":
+		return "api_key"
+	case c.BearerToken != "":
+		return "bearer"
+	default:
+		return "none"
+	}
+}
+
+// Validate rejects ambiguous or incomplete settings.
+func (c CredentialsConfig) Validate() error {
+	kinds := 0
+	for _, set := range []bool{c.OAuth2.TokenURL != "", c.APIKey != "", c.BearerToken != ""} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds > 1 {
+		return errors.New("configure only one of bearer token, API key, or OAuth2 client credentials")
+	}
+	if c.OAuth2.TokenURL != "" && (c.OAuth2.ClientID == "" || c.OAuth2.ClientSecret == "") {
+		return errors.New("OAuth2 client credentials need a client id and secret")
+	}
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return errors.New("mTLS needs both a certificate and a key file")
+	}
+	return nil
+}
+
+// Summary describes the credentials without exposing secrets.
+func (c CredentialsConfig) Summary() map[string]any {
+	summary := map[string]any{"type": c.Kind(), "mtls": c.TLS.CertFile != ""}
+	if c.OAuth2.TokenURL != "" {
+		summary["token_url"] = c.OAuth2.TokenURL
+		summary["scopes"] = c.OAuth2.Scopes
+	}
+	return summary
+}
+
+// Options turns the configuration into client options.
+func (c CredentialsConfig) Options() ([]Option, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	var opts []Option
+	var tlsConfig *tls.Config
+	if c.TLS.enabled() {
+		cfg, err := c.TLS.Load()
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig = cfg
+		opts = append(opts, WithTLSConfig(cfg))
+	}
+	switch c.Kind() {
+	case "oauth2":
+		oauthCfg := c.OAuth2
+		if oauthCfg.HTTPClient == nil && tlsConfig != nil {
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.TLSClientConfig = tlsConfig
+			oauthCfg.HTTPClient = &http.Client{Timeout: tokenFetchTimeout, Transport: transport}
+		}
+		source, err := NewClientCredentialsSource(oauthCfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithCredentials(OAuth2(source)))
+	case "api_key":
+		opts = append(opts, WithCredentials(APIKey{Header: c.APIKeyHeader, Key: c.APIKey}))
+	case "bearer":
+		opts = append(opts, WithCredentials(BearerToken(c.BearerToken)))
+	}
+	return opts, nil
+}
+
+// LoadCredentialsConfig reads <prefix>API_TOKEN, <prefix>API_KEY, <prefix>API_KEY_HEADER,
+// <prefix>OAUTH_TOKEN_URL, <prefix>OAUTH_CLIENT_ID, <prefix>OAUTH_CLIENT_SECRET, <prefix>OAUTH_SCOPES,
+// <prefix>OAUTH_AUDIENCE, <prefix>OAUTH_REFRESH_BEFORE_SECONDS, and <prefix>TLS_CERT_FILE/_KEY_FILE/_CA_FILE.
+func LoadCredentialsConfig(prefix string) (CredentialsConfig, error) {
+	env := func(key string) string { return strings.TrimSpace(os.Getenv(prefix + key)) }
+	cfg := CredentialsConfig{
+		BearerToken:  env("API_TOKEN"),
+		APIKey:       env("API_KEY"),
+		APIKeyHeader: env("API_KEY_HEADER"),
+		OAuth2: ClientCredentialsConfig{
+			TokenURL:     env("OAUTH_TOKEN_URL"),
+			ClientID:     env("OAUTH_CLIENT_ID"),
+			ClientSecret: env("OAUTH_CLIENT_SECRET"),
+			Audience:     env("OAUTH_AUDIENCE"),
+		},
+		TLS: TLSFiles{
+			CertFile: env("TLS_CERT_FILE"),
+			KeyFile:  env("TLS_KEY_FILE"),
+			CAFile:   env("TLS_CA_FILE"),
+		},
+	}
+	if scopes := env("OAUTH_SCOPES"); scopes != "" {
+		cfg.OAuth2.Scopes = strings.FieldsFunc(scopes, func(r rune) bool { return r == ',' || r == ' ' })
+	}
+	if raw := env("OAUTH_REFRESH_BEFORE_SECONDS"); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds <= 0 {
+			return CredentialsConfig{}, fmt.Errorf("%sOAUTH_REFRESH_BEFORE_SECONDS must be a positive integer", prefix)
+		}
+		cfg.OAuth2.RefreshBefore = time.Duration(seconds) * time.Second
+	}
+	if err := cfg.Validate(); err != nil {
+		return CredentialsConfig{}, fmt.Errorf("%scredentials: %w", prefix, err)
+	}
+	return cfg, nil
+}
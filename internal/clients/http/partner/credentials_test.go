@@ -0,0 +1,176 @@
+package partner
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// tokenEndpoint is an httptest stand-in for an OAuth2 token endpoint issuing t1, t2, ...
+type tokenEndpoint struct {
+	issued    atomic.Int32
+	expiresIn int
+	release   chan struct{}
+}
+
+func (e *tokenEndpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, secret, ok := r.BasicAuth()
+	if !ok || id != "client" || secret != "s3cret" || r.FormValue("grant_type") != "client_credentials" {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+		return
+	}
+	if e.release != nil {
+		<-e.release
+	}
+	n := e.issued.Add(1)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": fmt.Sprintf("t%d", n),
+		"token_type":   "bearer",
+		"expires_in":   e.expiresIn,
+		"scope":        r.FormValue("scope"),
+	})
+}
+
+func newTokenSource(t *testing.T, endpoint *tokenEndpoint, now *atomic.Pointer[time.Time]) *ClientCredentialsSource {
+	t.Helper()
+	server := httptest.NewServer(endpoint)
+	t.Cleanup(server.Close)
+	source, err := NewClientCredentialsSource(ClientCredentialsConfig{
+		TokenURL:      server.URL,
+		ClientID:      "client",
+		ClientSecret:  "s3cret",
+		Scopes:        []string{"pets.write"},
+		RefreshBefore: 10 * time.Second,
+		HTTPClient:    server.Client(),
+	})
+	require.NoError(t, err)
+	source.now = func() time.Time { return *now.Load() }
+	return source
+}
+
+func TestClientCredentialsSource_SharesFetchesAndRefreshesBeforeExpiry(t *testing.T) {
+	endpoint := &tokenEndpoint{expiresIn: 60, release: make(chan struct{})}
+	var now atomic.Pointer[time.Time]
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now.Store(&start)
+	source := newTokenSource(t, endpoint, &now)
+
+	// Concurrent callers on an empty cache wait for a single fetch.
+	var wg sync.WaitGroup
+	tokens := make([]Token, 8)
+	errs := make([]error, len(tokens))
+	for i := range tokens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tokens[i], errs[i] = source.Token(t.Context())
+		}()
+	}
+	require.Eventually(t, func() bool {
+		source.mu.Lock()
+		defer source.mu.Unlock()
+		return source.inflight != nil
+	}, time.Second, time.Millisecond)
+	close(endpoint.release)
+	wg.Wait()
+	for i, token := range tokens {
+		require.NoError(t, errs[i])
+		require.Equal(t, "t1", token.AccessToken)
+	}
+	require.EqualValues(t, 1, endpoint.issued.Load())
+
+	// Inside the refresh window the cached token is still served while one refresh runs.
+	early := start.Add(55 * time.Second)
+	now.Store(&early)
+	for range 5 {
+		token, err := source.Token(t.Context())
+		require.NoError(t, err)
+		require.Contains(t, []string{"t1", "t2"}, token.AccessToken)
+	}
+	require.Eventually(t, func() bool {
+		token, err := source.Token(t.Context())
+		return err == nil && token.AccessToken == "t2"
+	}, time.Second, time.Millisecond)
+	require.EqualValues(t, 2, endpoint.issued.Load())
+
+	// Once expired, callers block on a fresh token instead of sending a stale one.
+	expired := early.Add(2 * time.Minute)
+	now.Store(&expired)
+	token, err := source.Token(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "t3", token.AccessToken)
+	require.Equal(t, expired.Add(time.Minute), token.Expiry)
+}
+
+func TestClientCredentialsSource_ReportsTokenEndpointErrors(t *testing.T) {
+	server := httptest.NewServer(&tokenEndpoint{expiresIn: 60})
+	t.Cleanup(server.Close)
+	source, err := NewClientCredentialsSource(ClientCredentialsConfig{TokenURL: server.URL, ClientID: "client", ClientSecret: "wrong", HTTPClient: server.Client()})
+	require.NoError(t, err)
+	_, err = source.Token(t.Context())
+	require.ErrorContains(t, err, "invalid_client")
+}
+
+func TestClient_OAuth2RetriesOnceWithFreshTokenAfter401(t *testing.T) {
+	endpoint := &tokenEndpoint{expiresIn: 3600}
+	var now atomic.Pointer[time.Time]
+	start := time.Now()
+	now.Store(&start)
+	source := newTokenSource(t, endpoint, &now)
+
+	var mu sync.Mutex
+	var seen []string
+	partner := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		mu.Lock()
+		seen = append(seen, auth)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if auth != "Bearer t2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(Error{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(SyncResponse{})
+	}))
+	t.Cleanup(partner.Close)
+
+	cfg := DefaultResilienceConfig()
+	cfg.MaxAttempts = 1
+	client, err := NewPartnerClient(partner.URL, partner.Client(), WithResilience(cfg), WithCredentials(OAuth2(source)))
+	require.NoError(t, err)
+
+	require.NoError(t, client.SyncPet(t.Context(), PetPayload{Reference: "1", Title: "Rex", Photos: []string{"p"}, Availability: "AVAILABLE"}))
+	require.Equal(t, []string{"Bearer t1", "Bearer t2"}, seen)
+	require.EqualValues(t, 2, endpoint.issued.Load())
+}
+
+func TestCredentialsConfig_SelectsOneCredentialType(t *testing.T) {
+	t.Setenv("PARTNER_ACME_OAUTH_TOKEN_URL", "https://auth.example/token")
+	t.Setenv("PARTNER_ACME_OAUTH_CLIENT_ID", "client")
+	t.Setenv("PARTNER_ACME_OAUTH_CLIENT_SECRET", "s3cret")
+	t.Setenv("PARTNER_ACME_OAUTH_SCOPES", "pets.read, pets.write")
+	cfg, err := LoadCredentialsConfig("PARTNER_ACME_")
+	require.NoError(t, err)
+	require.Equal(t, "oauth2", cfg.Kind())
+	require.Equal(t, []string{"pets.read", "pets.write"}, cfg.OAuth2.Scopes)
+	require.NotContains(t, fmt.Sprint(cfg.Summary()), "s3cret")
+
+	t.Setenv("PARTNER_ACME_API_KEY", "key")
+	_, err = LoadCredentialsConfig("PARTNER_ACME_")
+	require.Error(t, err)
+
+	t.Setenv("PARTNER_ACME_OAUTH_TOKEN_URL", "")
+	t.Setenv("PARTNER_ACME_TLS_CERT_FILE", "/tmp/client.pem")
+	_, err = LoadCredentialsConfig("PARTNER_ACME_")
+	require.ErrorContains(t, err, "certificate and a key")
+}
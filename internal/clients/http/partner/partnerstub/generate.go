@@ -0,0 +1,3 @@
+package partnerstub
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.0 --config=oapi-codegen.yaml ../../../../../api/partner_openapi.yaml
@@ -0,0 +1,75 @@
+package partnerstub
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// registerInspection mounts the control API:
+//
+//	GET    /_stub/pets                 partner store
+//	PUT    /_stub/pets/{reference}     overwrite the partner copy (simulates partner-side edits)
+//	DELETE /_stub/pets/{reference}     drop the partner copy
+//	GET    /_stub/requests             recorded partner API calls
+//	POST   /_stub/faults               queue a Fault
+//	POST   /_stub/conflicts            {"count": n} forces n idempotency conflicts
+//	PUT    /_stub/latency              {"latencyMs": n}
+//	POST   /_stub/reset                clear all state
+func (s *Server) registerInspection(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+InspectionPrefix+"/pets", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, s.Pets())
+	})
+	mux.HandleFunc("PUT "+InspectionPrefix+"/pets/{reference}", func(w http.ResponseWriter, r *http.Request) {
+		var pet PetPayload
+		if err := json.NewDecoder(r.Body).Decode(&pet); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON payload")
+			return
+		}
+		pet.Reference = r.PathValue("reference")
+		s.Put(pet)
+		writeJSON(w, http.StatusOK, pet)
+	})
+	mux.HandleFunc("DELETE "+InspectionPrefix+"/pets/{reference}", func(w http.ResponseWriter, r *http.Request) {
+		s.Delete(r.PathValue("reference"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET "+InspectionPrefix+"/requests", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, s.Requests())
+	})
+	mux.HandleFunc("POST "+InspectionPrefix+"/faults", func(w http.ResponseWriter, r *http.Request) {
+		var fault Fault
+		if err := json.NewDecoder(r.Body).Decode(&fault); err != nil || fault.Status < 400 {
+			writeError(w, http.StatusBadRequest, "fault needs an error status")
+			return
+		}
+		s.InjectFault(fault)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST "+InspectionPrefix+"/conflicts", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Count int `json:"count"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Count < 0 {
+			writeError(w, http.StatusBadRequest, "count must be a non-negative integer")
+			return
+		}
+		s.ForceConflicts(body.Count)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("PUT "+InspectionPrefix+"/latency", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			LatencyMs int `json:"latencyMs"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.LatencyMs < 0 {
+			writeError(w, http.StatusBadRequest, "latencyMs must be a non-negative integer")
+			return
+		}
+		s.SetLatency(time.Duration(body.LatencyMs) * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST "+InspectionPrefix+"/reset", func(w http.ResponseWriter, _ *http.Request) {
+		s.Reset()
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
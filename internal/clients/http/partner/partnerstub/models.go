@@ -0,0 +1,13 @@
+package partnerstub
+
+import partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+
+// The stub shares the client's generated models so tests can pass payloads between them directly.
+type (
+	PetPayload     = partnerclient.PetPayload
+	PetPage        = partnerclient.PetPage
+	SyncResponse   = partnerclient.SyncResponse
+	Error          = partnerclient.Error
+	ListPetsParams = partnerclient.ListPetsParams
+	SyncPetParams  = partnerclient.SyncPetParams
+)
@@ -0,0 +1,393 @@
+// Package partnerstub is an in-memory partner implementing api/partner_openapi.yaml.
+// It backs cmd/partner-stub for local development and can be mounted with httptest
+// so partner sync, reconciliation, and Temporal activity tests run hermetically.
+package partnerstub
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// InspectionPrefix is the path prefix of the stub's control and inspection API.
+	InspectionPrefix = "/_stub"
+
+	defaultListLimit = 100
+	maxListLimit     = 500
+)
+
+// Fault is a scripted failure returned instead of handling the next partner API calls.
+type Fault struct {
+	Status            int `json:"status"`
+	Count             int `json:"count"`
+	RetryAfterSeconds int `json:"retryAfterSeconds,omitempty"`
+}
+
+// RecordedRequest is one partner API call as seen by the stub.
+type RecordedRequest struct {
+	Method         string          `json:"method"`
+	Path           string          `json:"path"`
+	IdempotencyKey string          `json:"idempotencyKey,omitempty"`
+	Authorization  bool            `json:"authorization"`
+	TraceParent    string          `json:"traceparent,omitempty"`
+	Status         int             `json:"status"`
+	Body           json.RawMessage `json:"body,omitempty"`
+	At             time.Time       `json:"at"`
+}
+
+// Server is the stub partner; it is safe for concurrent use.
+type Server struct {
+	mu          sync.Mutex
+	pets        map[string]PetPayload
+	idempotency map[string]string
+	requests    []RecordedRequest
+	faults      []Fault
+	conflicts   int
+	latency     time.Duration
+	errorRate   float64
+	errorStatus int
+	now         func() time.Time
+	handler     http.Handler
+}
+
+// Option customizes the stub.
+type Option func(*Server)
+
+// WithLatency delays every partner API response.
+func WithLatency(d time.Duration) Option {
+	return func(s *Server) {
+		s.latency = d
+	}
+}
+
+// WithErrorRate fails the given fraction (0..1) of partner API calls with status.
+func WithErrorRate(rate float64, status int) Option {
+	return func(s *Server) {
+		s.errorRate = rate
+		s.errorStatus = status
+	}
+}
+
+// WithPets seeds the partner's store.
+func WithPets(pets ...PetPayload) Option {
+	return func(s *Server) {
+		for _, pet := range pets {
+			s.pets[pet.Reference] = pet
+		}
+	}
+}
+
+// New builds a stub serving the partner contract plus the inspection API under InspectionPrefix.
+func New(opts ...Option) *Server {
+	s := &Server{
+		pets:        map[string]PetPayload{},
+		idempotency: map[string]string{},
+		errorStatus: http.StatusServiceUnavailable,
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	mux := http.NewServeMux()
+	s.registerInspection(mux)
+	partner := HandlerWithOptions(s, StdHTTPServerOptions{
+		ErrorHandlerFunc: func(w http.ResponseWriter, _ *http.Request, err error) {
+			writeError(w, http.StatusBadRequest, err.Error())
+		},
+	})
+	mux.Handle("/pets", s.simulate(partner))
+	mux.Handle("/pets/", s.simulate(partner))
+	s.handler = mux
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.handler.ServeHTTP(w, r)
+}
+
+// InjectFault queues a failure for the next fault.Count partner API calls.
+func (s *Server) InjectFault(fault Fault) {
+	if fault.Count <= 0 {
+		fault.Count = 1
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, fault)
+}
+
+// ForceConflicts answers the next n syncs with 409 as if the idempotency key had been reused.
+func (s *Server) ForceConflicts(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conflicts = n
+}
+
+// SetLatency changes the response delay for subsequent calls.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency = d
+}
+
+// Put stores the partner's copy directly, e.g. to simulate an edit made on the partner side.
+func (s *Server) Put(pet PetPayload) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pets[pet.Reference] = pet
+}
+
+// Delete removes the partner's copy of reference.
+func (s *Server) Delete(reference string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pets, reference)
+}
+
+// Pet returns the partner's copy of reference.
+func (s *Server) Pet(reference string) (PetPayload, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pet, ok := s.pets[reference]
+	return pet, ok
+}
+
+// Pets returns the partner's store ordered by reference.
+func (s *Server) Pets() []PetPayload {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sortedPets()
+}
+
+// Requests returns every partner API call received so far.
+func (s *Server) Requests() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.requests)
+}
+
+// Reset clears pets, idempotency keys, recorded requests, and pending faults.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pets = map[string]PetPayload{}
+	s.idempotency = map[string]string{}
+	s.requests = nil
+	s.faults = nil
+	s.conflicts = 0
+}
+
+// ListPets implements ServerInterface; the cursor is the last reference of the previous page.
+func (s *Server) ListPets(w http.ResponseWriter, _ *http.Request, params ListPetsParams) {
+	limit := defaultListLimit
+	if params.Limit != nil {
+		limit = min(max(*params.Limit, 1), maxListLimit)
+	}
+	s.mu.Lock()
+	pets := s.sortedPets()
+	s.mu.Unlock()
+	if params.Cursor != nil {
+		start, _ := slices.BinarySearchFunc(pets, *params.Cursor, func(p PetPayload, ref string) int {
+			return strings.Compare(p.Reference, ref)
+		})
+		if start < len(pets) && pets[start].Reference == *params.Cursor {
+			start++
+		}
+		pets = pets[start:]
+	}
+	page := PetPage{Items: pets}
+	if len(pets) > limit {
+		page.Items = pets[:limit]
+		next := page.Items[limit-1].Reference
+		page.NextCursor = &next
+	}
+	if page.Items == nil {
+		page.Items = []PetPayload{}
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+// GetPet implements ServerInterface.
+func (s *Server) GetPet(w http.ResponseWriter, _ *http.Request, reference string) {
+	pet, ok := s.Pet(reference)
+	if !ok {
+		writeError(w, http.StatusNotFound, "pet not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, pet)
+}
+
+// SyncPet implements ServerInterface. A reused Idempotency-Key replays the earlier success for the same
+// payload and is rejected with 409 for a different one.
+func (s *Server) SyncPet(w http.ResponseWriter, r *http.Request, reference string, params SyncPetParams) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "unreadable body")
+		return
+	}
+	var payload PetPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON payload")
+		return
+	}
+	if msg := validate(reference, payload); msg != "" {
+		writeError(w, http.StatusBadRequest, msg)
+		return
+	}
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conflicts > 0 {
+		s.conflicts--
+		writeError(w, http.StatusConflict, "idempotency key reused with a different payload")
+		return
+	}
+	if params.IdempotencyKey != nil && *params.IdempotencyKey != "" {
+		key := *params.IdempotencyKey
+		if previous, seen := s.idempotency[key]; seen {
+			if previous != hash {
+				writeError(w, http.StatusConflict, "idempotency key reused with a different payload")
+				return
+			}
+			writeJSON(w, http.StatusOK, syncResponse("replayed"))
+			return
+		}
+		s.idempotency[key] = hash
+	}
+	s.pets[reference] = payload
+	writeJSON(w, http.StatusOK, syncResponse("ok"))
+}
+
+// simulate applies latency and injected faults, then records the call.
+func (s *Server) simulate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body []byte
+		if r.Body != nil {
+			body, _ = io.ReadAll(r.Body)
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			s.record(r, recorder.status, body)
+		}()
+
+		s.mu.Lock()
+		latency := s.latency
+		fault, faulted := s.nextFault()
+		s.mu.Unlock()
+		if latency > 0 {
+			select {
+			case <-time.After(latency):
+			case <-r.Context().Done():
+				recorder.status = 499
+				return
+			}
+		}
+		if faulted {
+			if fault.RetryAfterSeconds > 0 {
+				recorder.Header().Set("Retry-After", strconv.Itoa(fault.RetryAfterSeconds))
+			}
+			writeError(recorder, fault.Status, "injected fault")
+			return
+		}
+		next.ServeHTTP(recorder, r)
+	})
+}
+
+// nextFault pops a scripted fault or rolls the random error rate; callers hold s.mu.
+func (s *Server) nextFault() (Fault, bool) {
+	if len(s.faults) > 0 {
+		fault := s.faults[0]
+		s.faults[0].Count--
+		if s.faults[0].Count <= 0 {
+			s.faults = s.faults[1:]
+		}
+		return fault, true
+	}
+	if s.errorRate > 0 && rand.Float64() < s.errorRate {
+		return Fault{Status: s.errorStatus, Count: 1}, true
+	}
+	return Fault{}, false
+}
+
+func (s *Server) record(r *http.Request, status int, body []byte) {
+	entry := RecordedRequest{
+		Method:         r.Method,
+		Path:           r.URL.Path,
+		IdempotencyKey: r.Header.Get("Idempotency-Key"),
+		Authorization:  r.Header.Get("Authorization") != "",
+		TraceParent:    r.Header.Get("traceparent"),
+		Status:         status,
+		At:             s.now().UTC(),
+	}
+	if json.Valid(body) {
+		entry.Body = json.RawMessage(body)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, entry)
+}
+
+func (s *Server) sortedPets() []PetPayload {
+	pets := make([]PetPayload, 0, len(s.pets))
+	for _, pet := range s.pets {
+		pets = append(pets, pet)
+	}
+	slices.SortFunc(pets, func(a, b PetPayload) int { return strings.Compare(a.Reference, b.Reference) })
+	return pets
+}
+
+func validate(reference string, payload PetPayload) string {
+	switch {
+	case payload.Reference != reference:
+		return "payload reference must match the path"
+	case strings.TrimSpace(payload.Title) == "":
+		return "title is required"
+	case len(payload.Photos) == 0:
+		return "at least one photo is required"
+	case strings.TrimSpace(payload.Availability) == "":
+		return "availability is required"
+	default:
+		return ""
+	}
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func syncResponse(status string) SyncResponse {
+	message := "Pet synced"
+	return SyncResponse{Status: &status, Message: &message}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	label := "error"
+	writeJSON(w, status, Error{Status: &label, Message: &message})
+}
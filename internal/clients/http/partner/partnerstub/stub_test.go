@@ -0,0 +1,93 @@
+package partnerstub
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+)
+
+func newClient(t *testing.T, stub *Server) *partnerclient.Client {
+	t.Helper()
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	cfg := partnerclient.DefaultResilienceConfig()
+	cfg.BaseBackoff = 0
+	cfg.MaxBackoff = 0
+	client, err := partnerclient.NewPartnerClient(server.URL, server.Client(), partnerclient.WithResilience(cfg))
+	require.NoError(t, err)
+	return client
+}
+
+func payload(ref, title string) partnerclient.PetPayload {
+	return partnerclient.PetPayload{Reference: ref, Title: title, Photos: []string{"https://example.com/p.jpg"}, Availability: "AVAILABLE"}
+}
+
+func TestStub_IdempotencyReplayAndConflict(t *testing.T) {
+	stub := New()
+	client := newClient(t, stub)
+
+	require.NoError(t, client.SyncPet(t.Context(), payload("1", "Rex"), partnerclient.WithIdempotencyKey("k1")))
+	require.NoError(t, client.SyncPet(t.Context(), payload("1", "Rex"), partnerclient.WithIdempotencyKey("k1")))
+	err := client.SyncPet(t.Context(), payload("1", "Rex II"), partnerclient.WithIdempotencyKey("k1"))
+	require.ErrorContains(t, err, "idempotency conflict")
+
+	stub.ForceConflicts(1)
+	require.ErrorContains(t, client.SyncPet(t.Context(), payload("2", "Luna")), "idempotency conflict")
+	require.NoError(t, client.SyncPet(t.Context(), payload("2", "Luna")))
+
+	got, err := client.GetPet(t.Context(), "1")
+	require.NoError(t, err)
+	require.Equal(t, "Rex", got.Title)
+	_, err = client.GetPet(t.Context(), "404")
+	require.ErrorIs(t, err, partnerclient.ErrRemoteNotFound)
+	require.Len(t, stub.Requests(), 7)
+}
+
+func TestStub_FaultsArePickedUpByClientRetries(t *testing.T) {
+	stub := New()
+	client := newClient(t, stub)
+	stub.InjectFault(Fault{Status: http.StatusServiceUnavailable, Count: 2})
+
+	require.NoError(t, client.SyncPet(t.Context(), payload("1", "Rex")))
+	var statuses []int
+	for _, req := range stub.Requests() {
+		statuses = append(statuses, req.Status)
+	}
+	require.Equal(t, []int{503, 503, 200}, statuses)
+}
+
+func TestStub_ListPagesAndInspectionAPI(t *testing.T) {
+	stub := New(WithPets(payload("a", "A"), payload("b", "B"), payload("c", "C")))
+	client := newClient(t, stub)
+
+	items, next, err := client.ListPets(t.Context(), "", 2)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, "b", next)
+	items, next, err = client.ListPets(t.Context(), next, 2)
+	require.NoError(t, err)
+	require.Equal(t, []partnerclient.PetPayload{payload("c", "C")}, items)
+	require.Empty(t, next)
+
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	req, err := http.NewRequest(http.MethodPut, server.URL+InspectionPrefix+"/pets/a", strings.NewReader(`{"title":"edited","photos":["p"],"availability":"SOLD"}`))
+	require.NoError(t, err)
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	edited, ok := stub.Pet("a")
+	require.True(t, ok)
+	require.Equal(t, "edited", edited.Title)
+
+	resp, err = server.Client().Post(server.URL+InspectionPrefix+"/reset", "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Empty(t, stub.Pets())
+}
@@ -0,0 +1,510 @@
+package partner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
+)
+
+// ErrCircuitOpen is returned without calling the partner while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("partner circuit breaker is open")
+
+// CircuitState describes the circuit breaker position.
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"
+	CircuitHalfOpen CircuitState = "half-open"
+	CircuitOpen     CircuitState = "open"
+)
+
+// ResilienceConfig tunes retries, the circuit breaker, and the client-side rate limiter.
+type ResilienceConfig struct {
+	// MaxAttempts is the total number of attempts per request, including the first one.
+	MaxAttempts int
+	// AttemptTimeout bounds each individual attempt; the caller's context bounds the whole call.
+	AttemptTimeout time.Duration
+	BaseBackoff    time.Duration
+	MaxBackoff     time.Duration
+	// BreakerFailureThreshold consecutive failures open the circuit; zero disables the breaker.
+	BreakerFailureThreshold int
+	BreakerCooldown         time.Duration
+	// RateLimit is the sustained requests per second; zero disables limiting.
+	RateLimit float64
+	RateBurst int
+}
+
+// DefaultResilienceConfig returns the settings used when callers do not override them.
+func DefaultResilienceConfig() ResilienceConfig {
+	return ResilienceConfig{
+		MaxAttempts:             3,
+		AttemptTimeout:          5 * time.Second,
+		BaseBackoff:             200 * time.Millisecond,
+		MaxBackoff:              5 * time.Second,
+		BreakerFailureThreshold: 5,
+		BreakerCooldown:         30 * time.Second,
+	}
+}
+
+// LoadResilienceConfig reads PARTNER_* environment variables on top of the defaults.
+func LoadResilienceConfig() (ResilienceConfig, error) {
+	cfg := DefaultResilienceConfig()
+	if raw := strings.TrimSpace(os.Getenv("PARTNER_MAX_ATTEMPTS")); raw != "" {
+		attempts, err := strconv.Atoi(raw)
+		if err != nil || attempts <= 0 {
+			return ResilienceConfig{}, errors.New("PARTNER_MAX_ATTEMPTS must be a positive integer")
+		}
+		cfg.MaxAttempts = attempts
+	}
+	if raw := strings.TrimSpace(os.Getenv("PARTNER_BREAKER_FAILURES")); raw != "" {
+		failures, err := strconv.Atoi(raw)
+		if err != nil || failures < 0 {
+			return ResilienceConfig{}, errors.New("PARTNER_BREAKER_FAILURES must be a non-negative integer")
+		}
+		cfg.BreakerFailureThreshold = failures
+	}
+	if raw := strings.TrimSpace(os.Getenv("PARTNER_BREAKER_COOLDOWN_SECONDS")); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds <= 0 {
+			return ResilienceConfig{}, errors.New("PARTNER_BREAKER_COOLDOWN_SECONDS must be a positive integer")
+		}
+		cfg.BreakerCooldown = time.Duration(seconds) * time.Second
+	}
+	if raw := strings.TrimSpace(os.Getenv("PARTNER_RATE_LIMIT_RPS")); raw != "" {
+		rps, err := strconv.ParseFloat(raw, 64)
+		if err != nil || rps < 0 {
+			return ResilienceConfig{}, errors.New("PARTNER_RATE_LIMIT_RPS must be a non-negative number")
+		}
+		cfg.RateLimit = rps
+	}
+	if raw := strings.TrimSpace(os.Getenv("PARTNER_RATE_LIMIT_BURST")); raw != "" {
+		burst, err := strconv.Atoi(raw)
+		if err != nil || burst <= 0 {
+			return ResilienceConfig{}, errors.New("PARTNER_RATE_LIMIT_BURST must be a positive integer")
+		}
+		cfg.RateBurst = burst
+	}
+	return cfg, nil
+}
+
+// Summary returns a loggable view of the resilience settings.
+func (c ResilienceConfig) Summary() map[string]any {
+	return map[string]any{
+		"max_attempts":              c.MaxAttempts,
+		"attempt_timeout_ms":        c.AttemptTimeout.Milliseconds(),
+		"base_backoff_ms":           c.BaseBackoff.Milliseconds(),
+		"max_backoff_ms":            c.MaxBackoff.Milliseconds(),
+		"breaker_failure_threshold": c.BreakerFailureThreshold,
+		"breaker_cooldown_secs":     c.BreakerCooldown.Seconds(),
+		"rate_limit_rps":            c.RateLimit,
+		"rate_limit_burst":          c.RateBurst,
+	}
+}
+
+// circuitBreaker is a consecutive-failure breaker with a single half-open probe.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+	state     CircuitState
+	failures  int
+	openedAt  time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now, state: CircuitClosed}
+}
+
+// allow reports whether a call may proceed, moving an expired open circuit to half-open.
+func (b *circuitBreaker) allow() bool {
+	if b == nil || b.threshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = CircuitHalfOpen
+		b.probing = true
+		return true
+	case CircuitHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *circuitBreaker) recordSuccess() {
+	if b == nil || b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state = CircuitClosed
+	b.failures = 0
+	b.probing = false
+}
+
+func (b *circuitBreaker) recordFailure() {
+	if b == nil || b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.state == CircuitHalfOpen || b.failures >= b.threshold {
+		b.state = CircuitOpen
+		b.openedAt = b.now()
+	}
+	b.probing = false
+}
+
+// release returns an admitted half-open probe without an outcome, for calls abandoned before
+// reaching the partner.
+func (b *circuitBreaker) release() {
+	if b == nil || b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *circuitBreaker) current() CircuitState {
+	if b == nil || b.threshold <= 0 {
+		return CircuitClosed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// resilientTransport applies rate limiting, the circuit breaker, and retries around a base transport.
+type resilientTransport struct {
+	base    http.RoundTripper
+	cfg     ResilienceConfig
+	breaker *circuitBreaker
+	limiter *rate.Limiter
+	metrics transportMetrics
+	sleep   func(ctx context.Context, d time.Duration) error
+	jitter  func(d time.Duration) time.Duration
+}
+
+func newResilientTransport(base http.RoundTripper, cfg ResilienceConfig) *resilientTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	t := &resilientTransport{
+		base:    base,
+		cfg:     cfg,
+		breaker: newCircuitBreaker(cfg.BreakerFailureThreshold, cfg.BreakerCooldown),
+		sleep:   sleepContext,
+		jitter:  fullJitter,
+	}
+	if cfg.RateLimit > 0 {
+		burst := cfg.RateBurst
+		if burst <= 0 {
+			burst = 1
+		}
+		t.limiter = rate.NewLimiter(rate.Limit(cfg.RateLimit), burst)
+	}
+	return t
+}
+
+// RoundTrip sends the request, retrying 5xx, 429, and network errors with jittered exponential backoff.
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		if t.limiter != nil {
+			if err := t.limiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("partner rate limiter: %w", err)
+			}
+		}
+		if !t.breaker.allow() {
+			t.metrics.recordRejected(ctx)
+			return nil, ErrCircuitOpen
+		}
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			// Nothing reached the partner, so the probe says nothing about its health.
+			t.breaker.release()
+			return nil, err
+		}
+		attemptReq = attemptReq.WithContext(context.WithValue(attemptReq.Context(), attemptKey{}, attempt))
+		resp, err := t.roundTripAttempt(attemptReq)
+		retryable, outcome := classify(ctx, resp, err)
+		switch outcome {
+		case attemptFailed:
+			t.breaker.recordFailure()
+		case attemptInconclusive:
+			t.breaker.release()
+		default:
+			t.breaker.recordSuccess()
+		}
+		if !retryable || attempt >= t.cfg.MaxAttempts {
+			trace.SpanFromContext(ctx).SetAttributes(attribute.Int("partner.attempts", attempt))
+			return resp, err
+		}
+		delay := t.backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(resp, time.Now()); ok {
+			if retryAfter > t.cfg.MaxBackoff {
+				// The partner asked for a longer pause than we are willing to wait; surface its answer.
+				trace.SpanFromContext(ctx).SetAttributes(attribute.Int("partner.attempts", attempt))
+				return resp, err
+			}
+			delay = retryAfter
+		}
+		drainAndClose(resp)
+		t.metrics.recordRetry(ctx, statusLabel(resp, err))
+		trace.SpanFromContext(ctx).AddEvent("partner.retry", trace.WithAttributes(
+			attribute.Int("partner.attempt", attempt),
+			attribute.String("partner.retry_reason", statusLabel(resp, err)),
+			attribute.Int64("partner.retry_delay_ms", delay.Milliseconds()),
+		))
+		if err := t.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (t *resilientTransport) roundTripAttempt(req *http.Request) (*http.Response, error) {
+	if t.cfg.AttemptTimeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.cfg.AttemptTimeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil || resp == nil || resp.Body == nil {
+		cancel()
+		return resp, err
+	}
+	// Keep the attempt context alive until the caller finishes reading the body.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *resilientTransport) backoff(attempt int) time.Duration {
+	delay := t.cfg.BaseBackoff << (attempt - 1)
+	if delay <= 0 || (t.cfg.MaxBackoff > 0 && delay > t.cfg.MaxBackoff) {
+		delay = t.cfg.MaxBackoff
+	}
+	return t.jitter(delay)
+}
+
+// attemptOutcome is what one attempt says about the partner's health.
+type attemptOutcome int
+
+const (
+	attemptSucceeded attemptOutcome = iota
+	attemptFailed
+	// attemptInconclusive covers throttling and calls the caller abandoned: neither closes nor
+	// opens the circuit, but a half-open probe is handed back.
+	attemptInconclusive
+)
+
+// classify reports whether an attempt should be retried and what it means for the breaker.
+func classify(ctx context.Context, resp *http.Response, err error) (retryable bool, outcome attemptOutcome) {
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, attemptInconclusive
+		}
+		return true, attemptFailed
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true, attemptInconclusive
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return true, attemptFailed
+	default:
+		return false, attemptSucceeded
+	}
+}
+
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 1 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("partner request body cannot be replayed for retry")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("rewind partner request body: %w", err)
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
+
+// parseRetryAfter understands both delta-seconds and HTTP-date forms of Retry-After.
+func parseRetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	raw := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if raw == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(raw); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(raw); err == nil {
+		if delay := at.Sub(now); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func drainAndClose(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_ = resp.Body.Close()
+}
+
+type attemptKey struct{}
+
+// attemptAnnotator sits beneath otelhttp so each attempt span carries its retry position.
+type attemptAnnotator struct {
+	base http.RoundTripper
+}
+
+func (a attemptAnnotator) RoundTrip(req *http.Request) (*http.Response, error) {
+	if attempt, ok := req.Context().Value(attemptKey{}).(int); ok {
+		trace.SpanFromContext(req.Context()).SetAttributes(
+			attribute.Int("partner.attempt", attempt),
+			attribute.Int("http.request.resend_count", attempt-1),
+		)
+	}
+	return a.base.RoundTrip(req)
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+func statusLabel(resp *http.Response, err error) string {
+	if err != nil || resp == nil {
+		return "network_error"
+	}
+	return strconv.Itoa(resp.StatusCode)
+}
+
+func fullJitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(d))) + 1
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type transportMetrics struct {
+	retries  metric.Int64Counter
+	rejected metric.Int64Counter
+	provider []attribute.KeyValue
+}
+
+func (m transportMetrics) recordRetry(ctx context.Context, reason string) {
+	if m.retries != nil {
+		attrs := append([]attribute.KeyValue{attribute.String("partner.retry_reason", reason)}, m.provider...)
+		m.retries.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
+func (m transportMetrics) recordRejected(ctx context.Context) {
+	if m.rejected != nil {
+		m.rejected.Add(ctx, 1, metric.WithAttributes(m.provider...))
+	}
+}
+
+// providerAttributes labels client metrics with the provider name when several partners share a meter.
+func providerAttributes(provider string) []attribute.KeyValue {
+	if provider == "" {
+		return nil
+	}
+	return []attribute.KeyValue{attribute.String("partner.provider", provider)}
+}
+
+// registerMetrics creates the retry and breaker instruments, including an observable breaker state gauge.
+func (t *resilientTransport) registerMetrics(m metric.Meter, provider string) error {
+	if m == nil {
+		return nil
+	}
+	retries, err := m.Int64Counter("partner.client.retries", metric.WithDescription("Partner requests retried after a retryable failure"))
+	if err != nil {
+		return err
+	}
+	rejected, err := m.Int64Counter("partner.client.breaker.rejections", metric.WithDescription("Partner requests rejected while the circuit was open"))
+	if err != nil {
+		return err
+	}
+	t.metrics = transportMetrics{retries: retries, rejected: rejected, provider: providerAttributes(provider)}
+	state, err := m.Int64ObservableGauge("partner.client.breaker.state", metric.WithDescription("Circuit breaker state (0 closed, 1 half-open, 2 open)"))
+	if err != nil {
+		return err
+	}
+	_, err = m.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(state, circuitStateValue(t.breaker.current()), metric.WithAttributes(t.metrics.provider...))
+		return nil
+	}, state)
+	return err
+}
+
+func circuitStateValue(state CircuitState) int64 {
+	switch state {
+	case CircuitHalfOpen:
+		return 1
+	case CircuitOpen:
+		return 2
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,124 @@
+// Package events fans catalog changes out to in-process subscribers and keeps a bounded replay
+// buffer so reconnecting clients can resume after the last event they saw.
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// DefaultReplaySize is how many recent events a reconnecting subscriber can catch up on.
+const DefaultReplaySize = 1024
+
+// subscriberBuffer is how far a subscriber may fall behind before it is dropped; a dropped
+// client reconnects with Last-Event-ID and catches up from the replay buffer.
+const subscriberBuffer = 64
+
+// Bus is an in-process PetEventPublisher and PetEventSource.
+type Bus struct {
+	mu          sync.Mutex
+	replaySize  int
+	replay      []ports.PetEvent
+	lastID      uint64
+	evictedUpTo uint64
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	events chan ports.PetEvent
+}
+
+// Option customizes the bus.
+type Option func(*Bus)
+
+// WithReplaySize bounds the replay buffer; non-positive sizes keep the default.
+func WithReplaySize(size int) Option {
+	return func(b *Bus) {
+		if size > 0 {
+			b.replaySize = size
+		}
+	}
+}
+
+// NewBus creates an empty bus.
+func NewBus(opts ...Option) *Bus {
+	b := &Bus{replaySize: DefaultReplaySize, subscribers: map[*subscriber]struct{}{}}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(b)
+		}
+	}
+	return b
+}
+
+// Publish buffers the event and delivers it to every subscriber. Events without an id get the
+// next local one; ids assigned elsewhere (e.g. a Postgres sequence shared by all instances) are
+// kept so Last-Event-ID means the same thing on every instance.
+func (b *Bus) Publish(_ context.Context, event ports.PetEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if event.ID == 0 {
+		event.ID = b.lastID + 1
+	} else if b.lastID == 0 && len(b.replay) == 0 {
+		// Everything before the first relayed event happened before this instance started.
+		b.evictedUpTo = event.ID - 1
+	}
+	if event.ID > b.lastID {
+		b.lastID = event.ID
+	}
+	if len(b.replay) == b.replaySize {
+		if evicted := b.replay[0].ID; evicted > b.evictedUpTo {
+			b.evictedUpTo = evicted
+		}
+		b.replay = append(b.replay[:0], b.replay[1:]...)
+	}
+	b.replay = append(b.replay, event)
+	for sub := range b.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			delete(b.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// Subscribe replays buffered events newer than afterID and streams new ones. An afterID the bus
+// cannot account for, because it was evicted or predates this process, is reported as a gap.
+func (b *Bus) Subscribe(afterID uint64) ports.PetEventSubscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sub := &subscriber{events: make(chan ports.PetEvent, subscriberBuffer)}
+	b.subscribers[sub] = struct{}{}
+	subscription := ports.PetEventSubscription{
+		LatestID: b.lastID,
+		Events:   sub.events,
+		Cancel:   func() { b.unsubscribe(sub) },
+	}
+	if afterID > 0 && (afterID < b.evictedUpTo || afterID > b.lastID) {
+		subscription.Gap = true
+		return subscription
+	}
+	for _, event := range b.replay {
+		if event.ID > afterID {
+			subscription.Replay = append(subscription.Replay, event)
+		}
+	}
+	return subscription
+}
+
+func (b *Bus) unsubscribe(sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+var (
+	_ ports.PetEventPublisher = (*Bus)(nil)
+	_ ports.PetEventSource    = (*Bus)(nil)
+)
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+func TestBus_ReplaysAfterLastEventIDAndReportsGaps(t *testing.T) {
+	ctx := context.Background()
+	bus := NewBus(WithReplaySize(3))
+	for petID := int64(1); petID <= 5; petID++ {
+		bus.Publish(ctx, ports.PetEvent{Type: ports.PetCreated, PetID: petID})
+	}
+
+	sub := bus.Subscribe(3)
+	defer sub.Cancel()
+	require.False(t, sub.Gap)
+	require.Len(t, sub.Replay, 2)
+	require.Equal(t, uint64(4), sub.Replay[0].ID)
+	require.Equal(t, uint64(5), sub.Replay[1].ID)
+
+	bus.Publish(ctx, ports.PetEvent{Type: ports.PetDeleted, PetID: 1})
+	live := <-sub.Events
+	require.Equal(t, uint64(6), live.ID)
+
+	// Events 2 and 3 were evicted, and id 99 was never issued by this bus.
+	for _, afterID := range []uint64{1, 99} {
+		stale := bus.Subscribe(afterID)
+		require.True(t, stale.Gap, afterID)
+		require.Empty(t, stale.Replay)
+		require.Equal(t, uint64(6), stale.LatestID)
+		stale.Cancel()
+	}
+}
+
+func TestBus_KeepsRelayedIDsAndDropsSlowSubscribers(t *testing.T) {
+	ctx := context.Background()
+	bus := NewBus()
+	bus.Publish(ctx, ports.PetEvent{ID: 500, Type: ports.PetCreated, PetID: 1})
+
+	// History before the first relayed event predates this instance.
+	require.True(t, bus.Subscribe(480).Gap)
+	require.False(t, bus.Subscribe(499).Gap)
+
+	slow := bus.Subscribe(500)
+	for i := 0; i <= subscriberBuffer; i++ {
+		bus.Publish(ctx, ports.PetEvent{Type: ports.PetUpdated, PetID: 1})
+	}
+	received := 0
+	for range slow.Events {
+		received++
+	}
+	require.Equal(t, subscriberBuffer, received)
+	slow.Cancel()
+}
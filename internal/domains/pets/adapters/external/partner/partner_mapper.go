@@ -9,8 +9,17 @@ import (
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
 )
 
+// Mapper converts a pet and the reference held for the target provider into that provider's payload.
+type Mapper func(p *domain.Pet, ref *domain.ExternalReference) partnerclient.PetPayload
+
 // ToPayload converts the local domain aggregate into the partner payload shape.
 func ToPayload(p *domain.Pet) partnerclient.PetPayload {
+	return DefaultMapper(p, p.ExternalRef)
+}
+
+// DefaultMapper renders tags and the provider reference attributes as partner labels. Internal
+// bookkeeping such as the stored sync hash is left out, so the body matches the payload hash.
+func DefaultMapper(p *domain.Pet, ref *domain.ExternalReference) partnerclient.PetPayload {
 	availability := strings.ToUpper(string(p.Status))
 	if availability == "" {
 		availability = "AVAILABLE"
@@ -19,8 +28,11 @@ func ToPayload(p *domain.Pet) partnerclient.PetPayload {
 	for _, tag := range p.Tags {
 		labels[tag.Name] = "true"
 	}
-	if p.ExternalRef != nil {
-		for k, v := range p.ExternalRef.Attributes {
+	if ref != nil {
+		for k, v := range ref.Attributes {
+			if isInternalAttribute(k) {
+				continue
+			}
 			labels[k] = v
 		}
 	}
@@ -29,7 +41,7 @@ func ToPayload(p *domain.Pet) partnerclient.PetPayload {
 		labelsPtr = &labels
 	}
 	return partnerclient.PetPayload{
-		Reference:    strconv.FormatInt(p.ID, 10),
+		Reference:    PayloadReference(p, ref),
 		Title:        p.Name,
 		Photos:       append([]string{}, p.PhotoURLs...),
 		Labels:       labelsPtr,
@@ -37,6 +49,15 @@ func ToPayload(p *domain.Pet) partnerclient.PetPayload {
 	}
 }
 
+// PayloadReference is the partner's id for the pet: the id from the provider's reference, e.g. a
+// pet imported from that partner, or the local id for pets the partner only knows from our syncs.
+func PayloadReference(p *domain.Pet, ref *domain.ExternalReference) string {
+	if ref != nil && ref.ID != "" {
+		return ref.ID
+	}
+	return strconv.FormatInt(p.ID, 10)
+}
+
 // FromPayload builds an import candidate the application layer can vet before hydrating a domain pet.
 func FromPayload(payload partnerclient.PetPayload) petstypes.PartnerImportCandidate {
 	photos := append([]string{}, payload.Photos...)
@@ -0,0 +1,352 @@
+package partner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// DefaultReconcilePageSize bounds how many local and remote pets are loaded per page.
+const DefaultReconcilePageSize = 100
+
+// Reconciler compares local pets with each provider's copy and classifies the differences.
+type Reconciler struct {
+	repo     ports.Repository
+	registry *Registry
+	progress func(ctx context.Context, processed int)
+	now      func() time.Time
+}
+
+// ReconcilerOption customizes a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithReconcileProgress is called after every local page, e.g. to heartbeat a Temporal activity.
+func WithReconcileProgress(fn func(ctx context.Context, processed int)) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.progress = fn
+	}
+}
+
+// NewReconciler wires the local repository and the provider registry.
+func NewReconciler(repo ports.Repository, registry *Registry, opts ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{repo: repo, registry: registry, now: time.Now}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(r)
+		}
+	}
+	return r
+}
+
+// ReconcileInput selects providers (all when empty) and the page size.
+type ReconcileInput struct {
+	Providers []string
+	PageSize  int
+}
+
+// LocalPageInput selects the page of local pets after AfterID to compare with the providers.
+type LocalPageInput struct {
+	Providers []string
+	PageSize  int
+	AfterID   int64
+}
+
+// RemotePageInput selects the page of a provider's pets at Cursor to check for orphans.
+type RemotePageInput struct {
+	Provider string
+	PageSize int
+	Cursor   string
+}
+
+// ReconcilePage is the outcome of one local or remote page, small enough for an activity result.
+type ReconcilePage struct {
+	// Providers names the providers compared, in order; set on local pages.
+	Providers []string
+	Records   []petstypes.ReconciliationRecord
+	// Pets counts the local pets compared; InSync those matching each provider's copy.
+	Pets   int
+	InSync map[string]int
+	// AfterID and Cursor continue local and remote paging; Done marks the last page.
+	AfterID int64
+	Cursor  string
+	Done    bool
+}
+
+// Reconcile pages through local pets, fetches each provider's copy, and then lists every provider
+// to find records with no local pet. Lookup failures are reported per record rather than aborting the run.
+func (r *Reconciler) Reconcile(ctx context.Context, input ReconcileInput) (*petstypes.ReconciliationReport, error) {
+	if r == nil || r.repo == nil || r.registry == nil {
+		return nil, errors.New("partner reconciler not configured")
+	}
+	syncers, err := r.selectProviders(input.Providers)
+	if err != nil {
+		return nil, err
+	}
+	report := &petstypes.ReconciliationReport{StartedAt: r.now().UTC()}
+	for _, s := range syncers {
+		report.AddInSync(s.Provider(), 0)
+	}
+
+	processed := 0
+	page := &ReconcilePage{}
+	for !page.Done {
+		page, err = r.ReconcileLocalPage(ctx, LocalPageInput{Providers: input.Providers, PageSize: input.PageSize, AfterID: page.AfterID})
+		if err != nil {
+			return nil, err
+		}
+		report.Records = append(report.Records, page.Records...)
+		for provider, n := range page.InSync {
+			report.AddInSync(provider, n)
+		}
+		processed += page.Pets
+		if r.progress != nil {
+			r.progress(ctx, processed)
+		}
+	}
+
+	for _, s := range syncers {
+		page := &ReconcilePage{}
+		for !page.Done {
+			page, err = r.ReconcileRemotePage(ctx, RemotePageInput{Provider: s.Provider(), PageSize: input.PageSize, Cursor: page.Cursor})
+			if err != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return nil, ctxErr
+				}
+				report.Records = append(report.Records, petstypes.ReconciliationRecord{
+					Provider: s.Provider(),
+					Status:   petstypes.ReconciliationFailed,
+					Error:    fmt.Sprintf("list remote pets: %v", err),
+				})
+				break
+			}
+			report.Records = append(report.Records, page.Records...)
+		}
+	}
+
+	report.Summarize()
+	report.FinishedAt = r.now().UTC()
+	return report, nil
+}
+
+// ReconcileLocalPage compares one page of local pets, in ID order, with every selected provider.
+func (r *Reconciler) ReconcileLocalPage(ctx context.Context, input LocalPageInput) (*ReconcilePage, error) {
+	if r == nil || r.repo == nil || r.registry == nil {
+		return nil, errors.New("partner reconciler not configured")
+	}
+	syncers, err := r.selectProviders(input.Providers)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := pageSizeOrDefault(input.PageSize)
+	pets, done, err := r.localPage(ctx, input.AfterID, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	page := &ReconcilePage{InSync: map[string]int{}, AfterID: input.AfterID, Done: done}
+	for _, s := range syncers {
+		page.Providers = append(page.Providers, s.Provider())
+	}
+	for _, projection := range pets {
+		if projection == nil || projection.Pet == nil {
+			continue
+		}
+		pet := projection.Pet
+		page.AfterID = max(page.AfterID, pet.ID)
+		for _, s := range syncers {
+			if !s.Policy().Applies(pet, s.Provider()) {
+				continue
+			}
+			local := s.Payload(pet)
+			record := petstypes.ReconciliationRecord{Provider: s.Provider(), PetID: pet.ID, Reference: local.Reference}
+			remote, err := s.Fetch(ctx, local.Reference)
+			switch {
+			case errors.Is(err, partnerclient.ErrRemoteNotFound):
+				record.Status = petstypes.ReconciliationMissingRemote
+			case err != nil:
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return nil, ctxErr
+				}
+				record.Status = petstypes.ReconciliationFailed
+				record.Error = err.Error()
+			default:
+				record.Differences = DiffPayloads(local, *remote)
+				if len(record.Differences) == 0 {
+					page.InSync[s.Provider()]++
+					continue
+				}
+				record.Status = petstypes.ReconciliationDrifted
+			}
+			page.Records = append(page.Records, record)
+		}
+	}
+	page.Pets = len(pets)
+	return page, nil
+}
+
+// ReconcileRemotePage reports the records on one page of the provider's pets that no local pet
+// renders to.
+func (r *Reconciler) ReconcileRemotePage(ctx context.Context, input RemotePageInput) (*ReconcilePage, error) {
+	if r == nil || r.repo == nil || r.registry == nil {
+		return nil, errors.New("partner reconciler not configured")
+	}
+	s, ok := r.registry.Lookup(input.Provider)
+	if !ok {
+		return nil, fmt.Errorf("unknown partner provider %q", input.Provider)
+	}
+	items, next, err := s.ListRemote(ctx, input.Cursor, pageSizeOrDefault(input.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	known, err := r.knownReferences(ctx, s, items)
+	if err != nil {
+		return nil, err
+	}
+	page := &ReconcilePage{Cursor: next, Done: next == "" || next == input.Cursor}
+	for _, item := range items {
+		if _, ok := known[item.Reference]; ok {
+			continue
+		}
+		page.Records = append(page.Records, petstypes.ReconciliationRecord{
+			Provider:  s.Provider(),
+			Reference: item.Reference,
+			Status:    petstypes.ReconciliationOrphanedRemote,
+		})
+	}
+	return page, nil
+}
+
+func pageSizeOrDefault(pageSize int) int {
+	if pageSize <= 0 {
+		return DefaultReconcilePageSize
+	}
+	return pageSize
+}
+
+func (r *Reconciler) selectProviders(names []string) ([]*Syncer, error) {
+	if len(names) == 0 {
+		names = r.registry.Providers()
+	}
+	syncers := make([]*Syncer, 0, len(names))
+	for _, name := range names {
+		s, ok := r.registry.Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown partner provider %q", name)
+		}
+		syncers = append(syncers, s)
+	}
+	return syncers, nil
+}
+
+// localPage returns the pets after afterID in ID order and whether no more follow. Repositories
+// that cannot page return every pet as one page.
+func (r *Reconciler) localPage(ctx context.Context, afterID int64, pageSize int) ([]*petstypes.PetProjection, bool, error) {
+	lister, ok := r.repo.(ports.PageLister)
+	if !ok {
+		pets, err := r.repo.List(ctx)
+		if err != nil {
+			return nil, false, fmt.Errorf("list local pets: %w", err)
+		}
+		pets = slices.DeleteFunc(pets, func(p *petstypes.PetProjection) bool {
+			return p == nil || p.Pet == nil || p.Pet.ID <= afterID
+		})
+		slices.SortFunc(pets, compareProjectionIDs)
+		return pets, true, nil
+	}
+	page, err := lister.ListAfter(ctx, afterID, pageSize)
+	if err != nil {
+		return nil, false, fmt.Errorf("list local pets: %w", err)
+	}
+	return page, len(page) < pageSize, nil
+}
+
+// knownReferences returns the references among items that a local pet renders to for the provider:
+// pets holding the reference for that provider, or whose local id it is when they hold none.
+func (r *Reconciler) knownReferences(ctx context.Context, s *Syncer, items []partnerclient.PetPayload) (map[string]struct{}, error) {
+	known := make(map[string]struct{}, len(items))
+	var ids []int64
+	for _, item := range items {
+		projection, err := r.repo.FindByExternalReference(ctx, s.Provider(), item.Reference)
+		switch {
+		case err == nil:
+			known[s.Payload(projection.Pet).Reference] = struct{}{}
+			continue
+		case !errors.Is(err, ports.ErrNotFound):
+			return nil, fmt.Errorf("load local pets: %w", err)
+		}
+		if id, err := strconv.ParseInt(item.Reference, 10, 64); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return known, nil
+	}
+	pets, err := r.repo.GetMany(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("load local pets: %w", err)
+	}
+	for _, projection := range pets {
+		if projection != nil && projection.Pet != nil {
+			known[s.Payload(projection.Pet).Reference] = struct{}{}
+		}
+	}
+	return known, nil
+}
+
+func compareProjectionIDs(a, b *petstypes.PetProjection) int {
+	var left, right int64
+	if a != nil && a.Pet != nil {
+		left = a.Pet.ID
+	}
+	if b != nil && b.Pet != nil {
+		right = b.Pet.ID
+	}
+	switch {
+	case left < right:
+		return -1
+	case left > right:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// DiffPayloads names the payload fields that differ between the local rendering and the partner copy.
+// The stored sync hash label is ignored because the partner keeps the value from the previous sync.
+func DiffPayloads(local, remote partnerclient.PetPayload) []string {
+	var diffs []string
+	if local.Title != remote.Title {
+		diffs = append(diffs, "title")
+	}
+	if local.Availability != remote.Availability {
+		diffs = append(diffs, "availability")
+	}
+	if !slices.Equal(local.Photos, remote.Photos) {
+		diffs = append(diffs, "photos")
+	}
+	if !maps.Equal(comparableLabels(local.Labels), comparableLabels(remote.Labels)) {
+		diffs = append(diffs, "labels")
+	}
+	return diffs
+}
+
+func comparableLabels(labels *map[string]string) map[string]string {
+	if labels == nil {
+		return map[string]string{}
+	}
+	filtered := make(map[string]string, len(*labels))
+	for k, v := range *labels {
+		if isInternalAttribute(k) {
+			continue
+		}
+		filtered[k] = v
+	}
+	return filtered
+}
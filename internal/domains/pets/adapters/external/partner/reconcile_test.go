@@ -0,0 +1,119 @@
+package partner
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+// remotePets serves GET /pets and GET /pets/{reference} from a fixed set, one pet per list page.
+func remotePets(t *testing.T, pets map[string]partnerclient.PetPayload, order []string) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/pets" {
+			idx := 0
+			for i, ref := range order {
+				if ref == r.URL.Query().Get("cursor") {
+					idx = i
+				}
+			}
+			page := partnerclient.PetPage{Items: []partnerclient.PetPayload{pets[order[idx]]}}
+			if idx+1 < len(order) {
+				next := order[idx+1]
+				page.NextCursor = &next
+			}
+			_ = json.NewEncoder(w).Encode(page)
+			return
+		}
+		pet, ok := pets[strings.TrimPrefix(r.URL.Path, "/pets/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(pet)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestReconciler_ClassifiesRecords(t *testing.T) {
+	repo := memory.NewRepository()
+	for _, pet := range []*domain.Pet{
+		{ID: 1, Name: "Rex", PhotoURLs: []string{"p"}, Status: domain.StatusAvailable},
+		{ID: 2, Name: "Luna", PhotoURLs: []string{"p"}, Status: domain.StatusAvailable},
+		{ID: 3, Name: "Milo", PhotoURLs: []string{"p"}, Status: domain.StatusSold},
+	} {
+		_, err := repo.Save(t.Context(), pet)
+		require.NoError(t, err)
+	}
+	inSync := ToPayload(&domain.Pet{ID: 1, Name: "Rex", PhotoURLs: []string{"p"}, Status: domain.StatusAvailable})
+	drifted := ToPayload(&domain.Pet{ID: 2, Name: "Luna (edited)", PhotoURLs: []string{"p"}, Status: domain.StatusPending})
+	orphan := partnerclient.PetPayload{Reference: "99", Title: "Ghost", Photos: []string{"p"}, Availability: "AVAILABLE"}
+	baseURL := remotePets(t, map[string]partnerclient.PetPayload{"1": inSync, "2": drifted, "99": orphan}, []string{"1", "2", "99"})
+
+	client, err := partnerclient.NewPartnerClient(baseURL, nil)
+	require.NoError(t, err)
+	registry, err := NewRegistry(NewSyncer(client))
+	require.NoError(t, err)
+	var progress []int
+	reconciler := NewReconciler(repo, registry, WithReconcileProgress(func(_ context.Context, processed int) {
+		progress = append(progress, processed)
+	}))
+
+	report, err := reconciler.Reconcile(t.Context(), ReconcileInput{PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 3}, progress)
+	require.Equal(t, []petstypes.ReconciliationRecord{
+		{Provider: "partner", PetID: 2, Reference: "2", Status: petstypes.ReconciliationDrifted, Differences: []string{"title", "availability"}},
+		{Provider: "partner", PetID: 3, Reference: "3", Status: petstypes.ReconciliationMissingRemote},
+		{Provider: "partner", Reference: "99", Status: petstypes.ReconciliationOrphanedRemote},
+	}, report.Records)
+	require.Equal(t, []petstypes.ReconciliationSummary{
+		{Provider: "partner", InSync: 1, Drifted: 1, MissingRemote: 1, OrphanedRemote: 1},
+	}, report.Summaries)
+
+	_, err = reconciler.Reconcile(t.Context(), ReconcileInput{Providers: []string{"acme"}})
+	require.ErrorContains(t, err, "unknown partner provider")
+}
+
+func TestReconciler_MatchesImportedPetsByPartnerReference(t *testing.T) {
+	repo := memory.NewRepository()
+	imported := &domain.Pet{ID: 1, Name: "Rex", PhotoURLs: []string{"p"}, Status: domain.StatusAvailable}
+	imported.LinkExternalReference(domain.ExternalReference{Provider: "partner", ID: "abc", Attributes: map[string]string{"color": "brown"}})
+	local := &domain.Pet{ID: 2, Name: "Luna", PhotoURLs: []string{"p"}, Status: domain.StatusAvailable}
+	for _, pet := range []*domain.Pet{imported, local} {
+		_, err := repo.Save(t.Context(), pet)
+		require.NoError(t, err)
+	}
+	remote := ToPayload(imported)
+	require.Equal(t, "abc", remote.Reference, "imported pets are pushed under the partner's id")
+	// The partner also holds a record under the imported pet's local id, e.g. a duplicate from
+	// an earlier sync; it matches no local pet.
+	duplicate := ToPayload(local)
+	duplicate.Reference = "1"
+	baseURL := remotePets(t, map[string]partnerclient.PetPayload{"abc": remote, "2": ToPayload(local), "1": duplicate}, []string{"abc", "2", "1"})
+
+	client, err := partnerclient.NewPartnerClient(baseURL, nil)
+	require.NoError(t, err)
+	registry, err := NewRegistry(NewSyncer(client))
+	require.NoError(t, err)
+
+	report, err := NewReconciler(repo, registry).Reconcile(t.Context(), ReconcileInput{PageSize: 2})
+	require.NoError(t, err)
+	require.Equal(t, []petstypes.ReconciliationRecord{
+		{Provider: "partner", Reference: "1", Status: petstypes.ReconciliationOrphanedRemote},
+	}, report.Records)
+	require.Equal(t, []petstypes.ReconciliationSummary{{Provider: "partner", InSync: 2, OrphanedRemote: 1}}, report.Summaries)
+}
@@ -0,0 +1,265 @@
+package partner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// SyncPolicy selects which pets a provider receives.
+type SyncPolicy string
+
+const (
+	// SyncAll pushes every mutated pet to the provider.
+	SyncAll SyncPolicy = "all"
+	// SyncLinked only pushes pets that already carry a reference to the provider.
+	SyncLinked SyncPolicy = "linked"
+)
+
+// Applies reports whether pet should be pushed to provider under the policy.
+func (p SyncPolicy) Applies(pet *domain.Pet, provider string) bool {
+	switch p {
+	case SyncLinked:
+		return pet != nil && pet.ExternalReferenceFor(provider) != nil
+	default:
+		return true
+	}
+}
+
+func parseSyncPolicy(raw string) (SyncPolicy, error) {
+	switch policy := SyncPolicy(strings.ToLower(strings.TrimSpace(raw))); policy {
+	case "":
+		return SyncAll, nil
+	case SyncAll, SyncLinked:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown partner sync policy %q (want %q or %q)", raw, SyncAll, SyncLinked)
+	}
+}
+
+// ProviderError attributes a fan-out failure to the provider that returned it.
+type ProviderError struct {
+	Provider string
+	Err      error
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("partner %s: %v", e.Provider, e.Err)
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// Registry fans pet syncs out to every configured provider.
+// A failing provider does not stop the others; its error is reported as a *ProviderError.
+type Registry struct {
+	syncers []*Syncer
+	byName  map[string]*Syncer
+}
+
+// NewRegistry indexes provider syncers by name, rejecting duplicates.
+func NewRegistry(syncers ...*Syncer) (*Registry, error) {
+	r := &Registry{byName: make(map[string]*Syncer, len(syncers))}
+	for _, s := range syncers {
+		if s == nil {
+			continue
+		}
+		if _, exists := r.byName[s.Provider()]; exists {
+			return nil, fmt.Errorf("duplicate partner provider %q", s.Provider())
+		}
+		r.byName[s.Provider()] = s
+		r.syncers = append(r.syncers, s)
+	}
+	return r, nil
+}
+
+// Providers lists the configured provider names in registration order.
+func (r *Registry) Providers() []string {
+	if r == nil {
+		return nil
+	}
+	names := make([]string, 0, len(r.syncers))
+	for _, s := range r.syncers {
+		names = append(names, s.Provider())
+	}
+	return names
+}
+
+// Lookup returns the syncer registered for provider.
+func (r *Registry) Lookup(provider string) (*Syncer, bool) {
+	if r == nil {
+		return nil, false
+	}
+	s, ok := r.byName[provider]
+	return s, ok
+}
+
+// CircuitStates reports the circuit breaker position of every provider client.
+func (r *Registry) CircuitStates() map[string]partnerclient.CircuitState {
+	if r == nil {
+		return nil
+	}
+	states := make(map[string]partnerclient.CircuitState, len(r.syncers))
+	for _, s := range r.syncers {
+		states[s.Provider()] = s.CircuitState()
+	}
+	return states
+}
+
+// Targets returns the providers whose sync policy accepts pet.
+func (r *Registry) Targets(pet *domain.Pet) []ports.ProviderSync {
+	if r == nil {
+		return nil
+	}
+	targets := make([]ports.ProviderSync, 0, len(r.syncers))
+	for _, s := range r.syncers {
+		if s.Policy().Applies(pet, s.Provider()) {
+			targets = append(targets, s)
+		}
+	}
+	return targets
+}
+
+// Sync pushes pet to every target provider and joins the per-provider failures.
+func (r *Registry) Sync(ctx context.Context, pet *domain.Pet) error {
+	var errs []error
+	for _, target := range r.Targets(pet) {
+		if err := target.Sync(ctx, pet); err != nil {
+			errs = append(errs, &ProviderError{Provider: target.Provider(), Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+var (
+	_ ports.PartnerSync    = (*Registry)(nil)
+	_ ports.PartnerTargets = (*Registry)(nil)
+)
+
+// ProviderConfig describes one named partner provider.
+type ProviderConfig struct {
+	Name        string
+	BaseURL     string
+	Credentials partnerclient.CredentialsConfig
+	Policy      SyncPolicy
+	// Mapper renders the provider payload; nil uses DefaultMapper.
+	Mapper Mapper
+}
+
+// LoadProviderConfigs reads the provider registry from the environment.
+// PARTNER_API_BASE_URL configures the default "partner" provider; PARTNER_PROVIDERS lists extra
+// provider names, each configured through PARTNER_<NAME>_BASE_URL and PARTNER_<NAME>_SYNC_POLICY.
+// Credentials are read by partnerclient.LoadCredentialsConfig with the PARTNER_ prefix for the
+// default provider and PARTNER_<NAME>_ for the others.
+func LoadProviderConfigs() ([]ProviderConfig, error) {
+	var configs []ProviderConfig
+	if baseURL := strings.TrimSpace(os.Getenv("PARTNER_API_BASE_URL")); baseURL != "" {
+		creds, err := partnerclient.LoadCredentialsConfig("PARTNER_")
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, ProviderConfig{
+			Name:        ports.DefaultPartnerProvider,
+			BaseURL:     baseURL,
+			Credentials: creds,
+			Policy:      SyncAll,
+		})
+	}
+	seen := map[string]bool{ports.DefaultPartnerProvider: true}
+	for _, name := range strings.Split(os.Getenv("PARTNER_PROVIDERS"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("PARTNER_PROVIDERS lists %q more than once or reuses the default provider name", name)
+		}
+		seen[name] = true
+		prefix := providerEnvPrefix(name)
+		baseURL := strings.TrimSpace(os.Getenv(prefix + "BASE_URL"))
+		if baseURL == "" {
+			return nil, fmt.Errorf("%sBASE_URL is required for partner provider %q", prefix, name)
+		}
+		policy, err := parseSyncPolicy(os.Getenv(prefix + "SYNC_POLICY"))
+		if err != nil {
+			return nil, fmt.Errorf("%sSYNC_POLICY: %w", prefix, err)
+		}
+		creds, err := partnerclient.LoadCredentialsConfig(prefix)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, ProviderConfig{
+			Name:        name,
+			BaseURL:     baseURL,
+			Credentials: creds,
+			Policy:      policy,
+		})
+	}
+	return configs, nil
+}
+
+// providerEnvPrefix upper-cases the provider name and replaces non-alphanumerics with underscores.
+func providerEnvPrefix(name string) string {
+	var b strings.Builder
+	b.WriteString("PARTNER_")
+	for _, r := range strings.ToUpper(name) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	b.WriteString("_")
+	return b.String()
+}
+
+// BuildRegistry creates one resilient client per provider. clientOpts apply to every client;
+// per-provider credentials are added on top. A nil registry is returned when no provider is configured.
+func BuildRegistry(configs []ProviderConfig, clientOpts ...partnerclient.Option) (*Registry, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	syncers := make([]*Syncer, 0, len(configs))
+	for _, cfg := range configs {
+		opts := append([]partnerclient.Option{}, clientOpts...)
+		opts = append(opts, partnerclient.WithProviderName(cfg.Name))
+		credentialOpts, err := cfg.Credentials.Options()
+		if err != nil {
+			return nil, fmt.Errorf("partner provider %q credentials: %w", cfg.Name, err)
+		}
+		opts = append(opts, credentialOpts...)
+		client, err := partnerclient.NewPartnerClient(cfg.BaseURL, nil, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("partner provider %q: %w", cfg.Name, err)
+		}
+		syncers = append(syncers, NewSyncer(client,
+			WithProvider(cfg.Name),
+			WithMapper(cfg.Mapper),
+			WithSyncPolicy(cfg.Policy),
+		))
+	}
+	return NewRegistry(syncers...)
+}
+
+// ProviderSummary describes the configured providers without exposing credentials.
+func ProviderSummary(configs []ProviderConfig) []map[string]any {
+	summary := make([]map[string]any, 0, len(configs))
+	for _, cfg := range configs {
+		summary = append(summary, map[string]any{
+			"name":        cfg.Name,
+			"base_url":    cfg.BaseURL,
+			"sync_policy": string(cfg.Policy),
+			"credentials": cfg.Credentials.Summary(),
+		})
+	}
+	sort.SliceStable(summary, func(i, j int) bool { return summary[i]["name"].(string) < summary[j]["name"].(string) })
+	return summary
+}
@@ -0,0 +1,101 @@
+package partner
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+func newProviderServer(t *testing.T, status int, calls *atomic.Int32, auth *atomic.Value) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if auth != nil {
+			auth.Store(r.Header.Get("Authorization"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func TestRegistry_FansOutWithFailureIsolationAndPolicies(t *testing.T) {
+	var acmeCalls, globexCalls, initechCalls atomic.Int32
+	var acmeAuth atomic.Value
+	resilience := partnerclient.DefaultResilienceConfig()
+	resilience.MaxAttempts = 1
+	registry, err := BuildRegistry([]ProviderConfig{
+		{Name: "acme", BaseURL: newProviderServer(t, http.StatusOK, &acmeCalls, &acmeAuth), Credentials: partnerclient.CredentialsConfig{BearerToken: "acme-token"}, Policy: SyncAll},
+		{Name: "globex", BaseURL: newProviderServer(t, http.StatusBadRequest, &globexCalls, nil), Policy: SyncAll},
+		{Name: "initech", BaseURL: newProviderServer(t, http.StatusOK, &initechCalls, nil), Policy: SyncLinked},
+	}, partnerclient.WithResilience(resilience))
+	require.NoError(t, err)
+	require.Equal(t, []string{"acme", "globex", "initech"}, registry.Providers())
+
+	pet := &domain.Pet{ID: 7, Name: "Rex", PhotoURLs: []string{"p"}, Status: domain.StatusAvailable}
+	err = registry.Sync(t.Context(), pet)
+	var providerErr *ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	require.Equal(t, "globex", providerErr.Provider)
+	require.EqualValues(t, 1, acmeCalls.Load(), "a failing provider must not block the others")
+	require.EqualValues(t, 1, globexCalls.Load())
+	require.Zero(t, initechCalls.Load(), "linked policy skips pets without an initech reference")
+	require.Equal(t, "Bearer acme-token", acmeAuth.Load())
+
+	pet.LinkExternalReference(domain.ExternalReference{Provider: "initech", ID: "i-7"})
+	require.Len(t, registry.Targets(pet), 3)
+}
+
+func TestSyncHashFor_TracksProvidersIndependently(t *testing.T) {
+	pet := &domain.Pet{ID: 7, Name: "Rex", PhotoURLs: []string{"p"}, Status: domain.StatusAvailable}
+	pet.LinkExternalReference(domain.ExternalReference{Provider: "acme", ID: "a-7", Attributes: map[string]string{"color": "brown"}})
+	pet.LinkExternalReference(domain.ExternalReference{Provider: "globex", ID: "g-7"})
+
+	acme, err := SyncHashFor(pet, "acme")
+	require.NoError(t, err)
+	globex, err := SyncHashFor(pet, "globex")
+	require.NoError(t, err)
+	require.NotEqual(t, acme, globex)
+
+	pet.LinkExternalReference(domain.ExternalReference{Provider: "globex", ID: "g-7", Attributes: map[string]string{SyncHashAttribute: globex}})
+	unchanged, err := SyncHashFor(pet, "acme")
+	require.NoError(t, err)
+	require.Equal(t, acme, unchanged)
+	require.Equal(t, "acme", pet.ExternalRef.Provider)
+	require.Len(t, pet.PartnerRefs, 1)
+}
+
+func TestLoadProviderConfigs_ReadsNamedProviders(t *testing.T) {
+	t.Setenv("PARTNER_API_BASE_URL", "https://partner.example")
+	t.Setenv("PARTNER_PROVIDERS", "acme, pet-hub")
+	t.Setenv("PARTNER_ACME_BASE_URL", "https://acme.example")
+	t.Setenv("PARTNER_ACME_API_TOKEN", "secret")
+	t.Setenv("PARTNER_PET_HUB_BASE_URL", "https://hub.example")
+	t.Setenv("PARTNER_PET_HUB_SYNC_POLICY", "linked")
+	t.Setenv("PARTNER_PET_HUB_API_KEY", "hub-key")
+
+	configs, err := LoadProviderConfigs()
+	require.NoError(t, err)
+	require.Equal(t, []ProviderConfig{
+		{Name: "partner", BaseURL: "https://partner.example", Policy: SyncAll},
+		{Name: "acme", BaseURL: "https://acme.example", Credentials: partnerclient.CredentialsConfig{BearerToken: "secret"}, Policy: SyncAll},
+		{Name: "pet-hub", BaseURL: "https://hub.example", Credentials: partnerclient.CredentialsConfig{APIKey: "hub-key"}, Policy: SyncLinked},
+	}, configs)
+
+	t.Setenv("PARTNER_ACME_OAUTH_TOKEN_URL", "https://auth.acme.example/token")
+	_, err = LoadProviderConfigs()
+	require.ErrorContains(t, err, "PARTNER_ACME_", "a bearer token and OAuth2 together are ambiguous")
+
+	t.Setenv("PARTNER_ACME_OAUTH_TOKEN_URL", "")
+	t.Setenv("PARTNER_PET_HUB_SYNC_POLICY", "sometimes")
+	_, err = LoadProviderConfigs()
+	require.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package partner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+// SyncHashAttribute is the external reference attribute that remembers the last synced payload hash.
+const SyncHashAttribute = "partner_sync_hash"
+
+// isInternalAttribute reports whether a reference attribute is local bookkeeping that the
+// partner never receives and that therefore stays out of payloads, hashes, and drift checks.
+func isInternalAttribute(key string) bool {
+	return key == SyncHashAttribute
+}
+
+// SyncHash builds a deterministic hash of the pet payload used for partner sync.
+// The stored hash attribute itself is excluded so the value is stable across syncs.
+func SyncHash(p *domain.Pet) (string, error) {
+	if p == nil {
+		return "", errors.New("nil pet")
+	}
+	return hashPet(p, p.ExternalRef)
+}
+
+// SyncHashFor hashes the payload sent to provider, covering the attributes of that provider's reference only.
+func SyncHashFor(p *domain.Pet, provider string) (string, error) {
+	if p == nil {
+		return "", errors.New("nil pet")
+	}
+	return hashPet(p, p.ExternalReferenceFor(provider))
+}
+
+func hashPet(p *domain.Pet, ref *domain.ExternalReference) (string, error) {
+	normalized := struct {
+		ID           int64             `json:"id"`
+		Name         string            `json:"name"`
+		Status       domain.Status     `json:"status"`
+		CategoryID   int64             `json:"categoryId"`
+		CategoryName string            `json:"categoryName"`
+		PhotoURLs    []string          `json:"photoUrls"`
+		Tags         []syncTag         `json:"tags"`
+		Attributes   []syncAttributeKV `json:"attributes"`
+	}{
+		ID:        p.ID,
+		Name:      p.Name,
+		Status:    p.Status,
+		PhotoURLs: append([]string{}, p.PhotoURLs...),
+	}
+	if p.Category != nil {
+		normalized.CategoryID = p.Category.ID
+		normalized.CategoryName = p.Category.Name
+	}
+	if len(p.Tags) > 0 {
+		tags := make([]syncTag, 0, len(p.Tags))
+		for _, t := range p.Tags {
+			tags = append(tags, syncTag{ID: t.ID, Name: t.Name})
+		}
+		sort.Slice(tags, func(i, j int) bool {
+			if tags[i].Name == tags[j].Name {
+				return tags[i].ID < tags[j].ID
+			}
+			return tags[i].Name < tags[j].Name
+		})
+		normalized.Tags = tags
+	}
+	if ref != nil && len(ref.Attributes) > 0 {
+		attrs := make([]syncAttributeKV, 0, len(ref.Attributes))
+		for k, v := range ref.Attributes {
+			if isInternalAttribute(k) {
+				continue
+			}
+			attrs = append(attrs, syncAttributeKV{Key: k, Value: v})
+		}
+		if len(attrs) > 0 {
+			sort.Slice(attrs, func(i, j int) bool { return attrs[i].Key < attrs[j].Key })
+			normalized.Attributes = attrs
+		}
+	}
+
+	bytes, err := json.Marshal(normalized)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// IdempotencyKey derives the partner Idempotency-Key from the pet ID and payload hash,
+// so retries of the same payload deduplicate while real changes get a fresh key.
+func IdempotencyKey(petID int64, hash string) string {
+	return fmt.Sprintf("pet-%d-%s", petID, hash)
+}
+
+type syncTag struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type syncAttributeKV struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,31 @@
+package partner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+func TestSyncHash_IgnoresStoredHashAttribute(t *testing.T) {
+	pet, err := domain.NewPet(42, "Rex", []string{"https://example.com/rex.jpg"})
+	require.NoError(t, err)
+
+	before, err := SyncHash(pet)
+	require.NoError(t, err)
+	pet.UpdateExternalReference(&domain.ExternalReference{
+		Provider:   "partner",
+		ID:         "42",
+		Attributes: map[string]string{SyncHashAttribute: before},
+	})
+	after, err := SyncHash(pet)
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+	require.Equal(t, "pet-42-"+before, IdempotencyKey(pet.ID, after))
+
+	require.NoError(t, pet.Rename("Max"))
+	changed, err := SyncHash(pet)
+	require.NoError(t, err)
+	require.NotEqual(t, before, changed)
+}
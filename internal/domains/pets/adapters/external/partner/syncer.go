@@ -3,23 +3,99 @@ package partner
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
 )
 
-// Syncer implements the outbound partner sync port.
+// Syncer implements the outbound partner sync port for a single named provider.
 type Syncer struct {
-	client *partnerclient.Client
+	client   *partnerclient.Client
+	provider string
+	mapper   Mapper
+	policy   SyncPolicy
+}
+
+// SyncerOption customizes a provider syncer.
+type SyncerOption func(*Syncer)
+
+// WithProvider names the provider the syncer pushes to; it defaults to ports.DefaultPartnerProvider.
+func WithProvider(name string) SyncerOption {
+	return func(s *Syncer) {
+		if name != "" {
+			s.provider = name
+		}
+	}
+}
+
+// WithMapper overrides how pets are rendered into the provider payload.
+func WithMapper(mapper Mapper) SyncerOption {
+	return func(s *Syncer) {
+		if mapper != nil {
+			s.mapper = mapper
+		}
+	}
+}
+
+// WithSyncPolicy decides which pets the provider receives when fanned out from a Registry.
+func WithSyncPolicy(policy SyncPolicy) SyncerOption {
+	return func(s *Syncer) {
+		if policy != "" {
+			s.policy = policy
+		}
+	}
 }
 
 // NewSyncer wires a partner HTTP client into a sync adapter.
-func NewSyncer(client *partnerclient.Client) *Syncer {
-	return &Syncer{client: client}
+func NewSyncer(client *partnerclient.Client, opts ...SyncerOption) *Syncer {
+	s := &Syncer{
+		client:   client,
+		provider: ports.DefaultPartnerProvider,
+		mapper:   DefaultMapper,
+		policy:   SyncAll,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	return s
+}
+
+// Provider reports the provider name used for references, hashes, and metrics.
+func (s *Syncer) Provider() string {
+	return s.provider
+}
+
+// Policy reports the sync policy applied when fanned out from a Registry.
+func (s *Syncer) Policy() SyncPolicy {
+	return s.policy
+}
+
+// CircuitState reports the circuit breaker position of the provider client.
+func (s *Syncer) CircuitState() partnerclient.CircuitState {
+	return s.client.CircuitState()
+}
+
+// Payload renders the pet as this provider expects it.
+func (s *Syncer) Payload(pet *domain.Pet) partnerclient.PetPayload {
+	return s.mapper(pet, pet.ExternalReferenceFor(s.provider))
 }
 
-// Sync pushes the pet aggregate to the partner API.
+// Fetch returns the provider's copy of the pet, or partnerclient.ErrRemoteNotFound.
+func (s *Syncer) Fetch(ctx context.Context, reference string) (*partnerclient.PetPayload, error) {
+	return s.client.GetPet(ctx, reference)
+}
+
+// ListRemote returns one page of the provider's pets and the cursor of the next page.
+func (s *Syncer) ListRemote(ctx context.Context, cursor string, limit int) ([]partnerclient.PetPayload, string, error) {
+	return s.client.ListPets(ctx, cursor, limit)
+}
+
+// Sync pushes the pet aggregate to the partner API with an Idempotency-Key derived from the payload hash.
+// Forced re-syncs (ports.WithResync) extend the key so the partner applies the payload again.
 func (s *Syncer) Sync(ctx context.Context, pet *domain.Pet) error {
 	if s == nil || s.client == nil {
 		return errors.New("partner syncer not configured")
@@ -27,8 +103,15 @@ func (s *Syncer) Sync(ctx context.Context, pet *domain.Pet) error {
 	if pet == nil {
 		return errors.New("pet is nil")
 	}
-	payload := ToPayload(pet)
-	return s.client.SyncPet(ctx, payload)
+	hash, err := SyncHashFor(pet, s.provider)
+	if err != nil {
+		return fmt.Errorf("compute partner sync hash: %w", err)
+	}
+	key := IdempotencyKey(pet.ID, hash)
+	if token := ports.ResyncToken(ctx); token != "" {
+		key += "-resync-" + token
+	}
+	return s.client.SyncPet(ctx, s.Payload(pet), partnerclient.WithIdempotencyKey(key))
 }
 
-var _ ports.PartnerSync = (*Syncer)(nil)
+var _ ports.ProviderSync = (*Syncer)(nil)
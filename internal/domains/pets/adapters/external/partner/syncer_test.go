@@ -0,0 +1,69 @@
+package partner
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+// idempotentPartner answers PUT /pets/{reference} like a partner that enforces Idempotency-Key:
+// replaying a key with a different body is a 409.
+type idempotentPartner struct {
+	mu     sync.Mutex
+	bodies map[string]string
+	keys   []string
+	sent   []string
+}
+
+func (p *idempotentPartner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	key := r.Header.Get("Idempotency-Key")
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.keys = append(p.keys, key)
+	p.sent = append(p.sent, string(body))
+	w.Header().Set("Content-Type", "application/json")
+	if previous, ok := p.bodies[key]; ok && previous != string(body) {
+		w.WriteHeader(http.StatusConflict)
+		message := "idempotency key reused with a different body"
+		_ = json.NewEncoder(w).Encode(partnerclient.Error{Message: &message})
+		return
+	}
+	p.bodies[key] = string(body)
+	_ = json.NewEncoder(w).Encode(partnerclient.SyncResponse{})
+}
+
+func TestSyncer_ResyncAfterStoringHashReusesKeyAndBody(t *testing.T) {
+	partner := &idempotentPartner{bodies: map[string]string{}}
+	server := httptest.NewServer(partner)
+	defer server.Close()
+	client, err := partnerclient.NewPartnerClient(server.URL, nil)
+	require.NoError(t, err)
+	syncer := NewSyncer(client)
+
+	pet, err := domain.NewPet(42, "Rex", []string{"https://example.com/rex.jpg"})
+	require.NoError(t, err)
+	pet.LinkExternalReference(domain.ExternalReference{Provider: "partner", ID: "42", Attributes: map[string]string{"color": "brown"}})
+	require.NoError(t, syncer.Sync(t.Context(), pet))
+
+	hash, err := SyncHashFor(pet, "partner")
+	require.NoError(t, err)
+	ref := pet.ExternalReferenceFor("partner")
+	ref.Attributes[SyncHashAttribute] = hash
+	pet.LinkExternalReference(*ref)
+	require.NoError(t, syncer.Sync(t.Context(), pet), "the stored hash must not change the body sent under the same key")
+
+	require.Len(t, partner.keys, 2)
+	require.Equal(t, partner.keys[0], partner.keys[1])
+	require.Equal(t, partner.sent[0], partner.sent[1])
+	require.NotContains(t, partner.sent[1], SyncHashAttribute)
+	require.Contains(t, partner.sent[1], `"color":"brown"`)
+}
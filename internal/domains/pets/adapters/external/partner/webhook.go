@@ -0,0 +1,151 @@
+package partner
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+const (
+	// SignatureHeader carries the hex HMAC-SHA256 of "<timestamp>.<body>" prefixed with the scheme version.
+	SignatureHeader = "X-Partner-Signature"
+	// TimestampHeader carries the Unix timestamp (seconds) the partner signed.
+	TimestampHeader = "X-Partner-Timestamp"
+	// DefaultWebhookTolerance bounds clock skew and the replay window for signed deliveries.
+	DefaultWebhookTolerance = 5 * time.Minute
+
+	signatureScheme = "v1="
+)
+
+var (
+	// ErrUnknownProvider means no webhook secret is configured for the provider.
+	ErrUnknownProvider = errors.New("unknown partner provider")
+	// ErrInvalidSignature means the signature header is missing or does not match the body.
+	ErrInvalidSignature = errors.New("invalid partner webhook signature")
+	// ErrStaleWebhook means the signed timestamp is outside the accepted tolerance.
+	ErrStaleWebhook = errors.New("partner webhook timestamp outside tolerance")
+	// ErrInvalidWebhookPayload means the body could not be decoded into a webhook event.
+	ErrInvalidWebhookPayload = errors.New("invalid partner webhook payload")
+)
+
+func init() {
+	apierrors.Register(
+		apierrors.Definition{Err: ErrUnknownProvider, Code: "pets.partner_webhook_unknown_provider", Status: http.StatusNotFound, Title: "Unknown Partner Provider"},
+		apierrors.Definition{Err: ErrInvalidSignature, Code: "pets.partner_webhook_invalid_signature", Status: http.StatusUnauthorized, Title: "Invalid Partner Webhook Signature"},
+		apierrors.Definition{Err: ErrStaleWebhook, Code: "pets.partner_webhook_stale", Status: http.StatusUnauthorized, Title: "Stale Partner Webhook"},
+		apierrors.Definition{Err: ErrInvalidWebhookPayload, Code: "pets.partner_webhook_invalid_payload", Status: http.StatusBadRequest, Title: "Invalid Partner Webhook Payload"},
+	)
+}
+
+// WebhookEvent is the envelope partners post to the webhook endpoint.
+type WebhookEvent struct {
+	ID   string                   `json:"id"`
+	Type string                   `json:"type"`
+	Pet  partnerclient.PetPayload `json:"pet"`
+}
+
+// WebhookVerifier checks HMAC signatures and timestamps against per-provider secrets.
+type WebhookVerifier struct {
+	secrets   map[string][]byte
+	tolerance time.Duration
+	now       func() time.Time
+}
+
+// NewWebhookVerifier builds a verifier; providers without a secret are rejected.
+func NewWebhookVerifier(secrets map[string]string, tolerance time.Duration) *WebhookVerifier {
+	if tolerance <= 0 {
+		tolerance = DefaultWebhookTolerance
+	}
+	keyed := make(map[string][]byte, len(secrets))
+	for provider, secret := range secrets {
+		provider = strings.TrimSpace(provider)
+		if provider == "" || secret == "" {
+			continue
+		}
+		keyed[provider] = []byte(secret)
+	}
+	return &WebhookVerifier{secrets: keyed, tolerance: tolerance, now: time.Now}
+}
+
+// WithClock overrides the time source for deterministic testing.
+func (v *WebhookVerifier) WithClock(now func() time.Time) {
+	if now != nil {
+		v.now = now
+	}
+}
+
+// Tolerance reports how long a signed delivery stays acceptable.
+func (v *WebhookVerifier) Tolerance() time.Duration {
+	return v.tolerance
+}
+
+// Verify authenticates a delivery and returns the signed timestamp.
+func (v *WebhookVerifier) Verify(provider, timestamp, signature string, body []byte) (time.Time, error) {
+	if v == nil {
+		return time.Time{}, ErrUnknownProvider
+	}
+	secret, ok := v.secrets[provider]
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+	}
+	seconds, err := strconv.ParseInt(strings.TrimSpace(timestamp), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: missing or malformed %s", ErrInvalidSignature, TimestampHeader)
+	}
+	signedAt := time.Unix(seconds, 0)
+	if skew := v.now().Sub(signedAt); skew > v.tolerance || skew < -v.tolerance {
+		return time.Time{}, ErrStaleWebhook
+	}
+	provided, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signature), signatureScheme))
+	if err != nil || len(provided) == 0 {
+		return time.Time{}, ErrInvalidSignature
+	}
+	if !hmac.Equal(provided, computeSignature(secret, seconds, body)) {
+		return time.Time{}, ErrInvalidSignature
+	}
+	return signedAt, nil
+}
+
+// SignWebhook produces the signature header value a partner would send for body at signedAt.
+func SignWebhook(secret string, signedAt time.Time, body []byte) string {
+	return signatureScheme + hex.EncodeToString(computeSignature([]byte(secret), signedAt.Unix(), body))
+}
+
+func computeSignature(secret []byte, seconds int64, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(strconv.FormatInt(seconds, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
+
+// DecodeWebhookEvent parses the envelope and maps the partner pet into an import candidate for provider.
+func DecodeWebhookEvent(provider string, body []byte) (WebhookEvent, petstypes.PartnerImportCandidate, error) {
+	var event WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return WebhookEvent{}, petstypes.PartnerImportCandidate{}, fmt.Errorf("%w: %w", ErrInvalidWebhookPayload, err)
+	}
+	event.ID = strings.TrimSpace(event.ID)
+	if event.ID == "" {
+		return WebhookEvent{}, petstypes.PartnerImportCandidate{}, fmt.Errorf("%w: event id is required", ErrInvalidWebhookPayload)
+	}
+	if strings.TrimSpace(event.Pet.Reference) == "" {
+		return WebhookEvent{}, petstypes.PartnerImportCandidate{}, fmt.Errorf("%w: pet reference is required", ErrInvalidWebhookPayload)
+	}
+	candidate := FromPayload(event.Pet)
+	if candidate.ExternalReference != nil {
+		candidate.ExternalReference.Provider = provider
+	}
+	return event, candidate, nil
+}
@@ -0,0 +1,47 @@
+package partner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookVerifier_AcceptsSignedDeliveriesWithinTolerance(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	verifier := NewWebhookVerifier(map[string]string{"acme": "s3cret"}, time.Minute)
+	verifier.WithClock(func() time.Time { return now })
+	body := []byte(`{"id":"evt-1","pet":{"reference":"42","title":"Rex","photos":["p"],"availability":"AVAILABLE"}}`)
+	timestamp := "1700000000"
+
+	signedAt, err := verifier.Verify("acme", timestamp, SignWebhook("s3cret", now, body), body)
+	require.NoError(t, err)
+	require.Equal(t, now, signedAt)
+
+	_, err = verifier.Verify("acme", timestamp, SignWebhook("wrong", now, body), body)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	_, err = verifier.Verify("acme", timestamp, SignWebhook("s3cret", now, body), append(body, ' '))
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	_, err = verifier.Verify("other", timestamp, SignWebhook("s3cret", now, body), body)
+	require.ErrorIs(t, err, ErrUnknownProvider)
+
+	verifier.WithClock(func() time.Time { return now.Add(2 * time.Minute) })
+	_, err = verifier.Verify("acme", timestamp, SignWebhook("s3cret", now, body), body)
+	require.ErrorIs(t, err, ErrStaleWebhook)
+}
+
+func TestDecodeWebhookEvent_MapsProviderOntoCandidate(t *testing.T) {
+	body := []byte(`{"id":"evt-1","type":"pet.updated","pet":{"reference":"42","title":"Rex","photos":[],"availability":"SOLD"}}`)
+
+	event, candidate, err := DecodeWebhookEvent("acme", body)
+	require.NoError(t, err)
+	require.Equal(t, "evt-1", event.ID)
+	require.Equal(t, "acme", candidate.ExternalReference.Provider)
+	require.Equal(t, "42", candidate.ExternalReference.ID)
+	require.Equal(t, []string{"photos"}, candidate.MissingFields())
+
+	_, _, err = DecodeWebhookEvent("acme", []byte(`{"pet":{"reference":"42"}}`))
+	require.ErrorIs(t, err, ErrInvalidWebhookPayload)
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// toStatus maps service errors through the error catalog, so codes match the HTTP problems.
+func toStatus(err error) error {
+	return apierrors.GRPCError(err)
+}
+
+// batchRejected lists the failed items as precondition violations, the counterpart of the
+// problems extension on the HTTP 422, followed by the catalogued error code.
+func batchRejected(err error, problems []pettypes.BatchItemProblem) error {
+	failure := &errdetails.PreconditionFailure{}
+	for _, problem := range problems {
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "PET",
+			Subject:     strconv.FormatInt(problem.ID, 10),
+			Description: problem.Error,
+		})
+	}
+	definition, _ := apierrors.Lookup(err)
+	info := &errdetails.ErrorInfo{Reason: definition.Code, Domain: apierrors.ErrorInfoDomain}
+	st, detailErr := status.New(codes.FailedPrecondition, err.Error()).WithDetails(failure, info)
+	if detailErr != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return st.Err()
+}
+
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
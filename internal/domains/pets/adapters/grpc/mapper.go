@@ -0,0 +1,166 @@
+package grpc
+
+import (
+	"fmt"
+	"maps"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	petsv1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/pets/v1"
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+var statusToProto = map[domain.Status]petsv1.PetStatus{
+	domain.StatusAvailable: petsv1.PetStatus_PET_STATUS_AVAILABLE,
+	domain.StatusPending:   petsv1.PetStatus_PET_STATUS_PENDING,
+	domain.StatusSold:      petsv1.PetStatus_PET_STATUS_SOLD,
+}
+
+var statusFromProto = map[petsv1.PetStatus]domain.Status{
+	petsv1.PetStatus_PET_STATUS_AVAILABLE: domain.StatusAvailable,
+	petsv1.PetStatus_PET_STATUS_PENDING:   domain.StatusPending,
+	petsv1.PetStatus_PET_STATUS_SOLD:      domain.StatusSold,
+}
+
+var importModes = map[petsv1.ImportMode]pettypes.BulkImportMode{
+	petsv1.ImportMode_IMPORT_MODE_UNSPECIFIED: pettypes.BulkImportCreate,
+	petsv1.ImportMode_IMPORT_MODE_CREATE:      pettypes.BulkImportCreate,
+	petsv1.ImportMode_IMPORT_MODE_UPSERT:      pettypes.BulkImportUpsert,
+}
+
+var rowStatuses = map[pettypes.BulkRowStatus]petsv1.ImportRowStatus{
+	pettypes.BulkRowCreated: petsv1.ImportRowStatus_IMPORT_ROW_STATUS_CREATED,
+	pettypes.BulkRowUpdated: petsv1.ImportRowStatus_IMPORT_ROW_STATUS_UPDATED,
+	pettypes.BulkRowValid:   petsv1.ImportRowStatus_IMPORT_ROW_STATUS_VALID,
+	pettypes.BulkRowFailed:  petsv1.ImportRowStatus_IMPORT_ROW_STATUS_FAILED,
+}
+
+// toDomainStatus rejects PET_STATUS_UNSPECIFIED and values this server does not know.
+func toDomainStatus(status petsv1.PetStatus) (string, error) {
+	value, ok := statusFromProto[status]
+	if !ok {
+		return "", fmt.Errorf("pet status %v is not one of available, pending, sold", status)
+	}
+	return string(value), nil
+}
+
+// toMutationInput keeps field presence: unset message fields stay nil so updates leave them alone.
+func toMutationInput(pet *petsv1.PetMutation) (pettypes.PetMutationInput, error) {
+	input := pettypes.PetMutationInput{
+		ID:                     pet.GetId(),
+		ClearCategory:          pet.GetClearCategory(),
+		ClearTags:              pet.GetClearTags(),
+		ClearExternalReference: pet.GetClearExternalReference(),
+	}
+	if pet.Name != nil {
+		name := pet.GetName()
+		input.Name = &name
+	}
+	if pet.GetPhotoUrls() != nil {
+		urls := append([]string{}, pet.GetPhotoUrls().GetUrls()...)
+		input.PhotoURLs = &urls
+	}
+	if category := pet.GetCategory(); category != nil {
+		input.Category = &pettypes.CategoryInput{ID: category.GetId(), Name: category.GetName()}
+	}
+	if pet.GetTags() != nil {
+		tags := make([]pettypes.TagInput, 0, len(pet.GetTags().GetTags()))
+		for _, tag := range pet.GetTags().GetTags() {
+			tags = append(tags, pettypes.TagInput{ID: tag.GetId(), Name: tag.GetName()})
+		}
+		input.Tags = &tags
+	}
+	if pet.GetStatus() != petsv1.PetStatus_PET_STATUS_UNSPECIFIED {
+		status, err := toDomainStatus(pet.GetStatus())
+		if err != nil {
+			return pettypes.PetMutationInput{}, err
+		}
+		input.Status = &status
+	}
+	if pet.HairLengthCm != nil {
+		hair := pet.GetHairLengthCm()
+		input.HairLengthCm = &hair
+	}
+	if ref := pet.GetExternalReference(); ref != nil {
+		input.ExternalReference = &pettypes.ExternalReferenceInput{
+			Provider:   ref.GetProvider(),
+			ID:         ref.GetId(),
+			Attributes: maps.Clone(ref.GetAttributes()),
+		}
+	}
+	return input, nil
+}
+
+func fromProjection(projection *pettypes.PetProjection) *petsv1.Pet {
+	if projection == nil || projection.Pet == nil {
+		return nil
+	}
+	p := projection.Pet
+	pet := &petsv1.Pet{
+		Id:           p.ID,
+		Name:         p.Name,
+		PhotoUrls:    append([]string{}, p.PhotoURLs...),
+		Status:       statusToProto[p.Status],
+		HairLengthCm: p.HairLengthCm,
+	}
+	if p.Category != nil {
+		pet.Category = &petsv1.Category{Id: p.Category.ID, Name: p.Category.Name}
+	}
+	for _, tag := range p.Tags {
+		pet.Tags = append(pet.Tags, &petsv1.Tag{Id: tag.ID, Name: tag.Name})
+	}
+	if p.ExternalRef != nil {
+		pet.ExternalReference = &petsv1.ExternalReference{
+			Provider:   p.ExternalRef.Provider,
+			Id:         p.ExternalRef.ID,
+			Attributes: maps.Clone(p.ExternalRef.Attributes),
+		}
+	}
+	if !projection.Metadata.CreatedAt.IsZero() {
+		pet.CreateTime = timestamppb.New(projection.Metadata.CreatedAt)
+	}
+	if !projection.Metadata.UpdatedAt.IsZero() {
+		pet.UpdateTime = timestamppb.New(projection.Metadata.UpdatedAt)
+	}
+	return pet
+}
+
+func fromProjectionList(list []*pettypes.PetProjection) []*petsv1.Pet {
+	result := make([]*petsv1.Pet, 0, len(list))
+	for _, projection := range list {
+		if pet := fromProjection(projection); pet != nil {
+			result = append(result, pet)
+		}
+	}
+	return result
+}
+
+func fromBatchProblems(problems []pettypes.BatchItemProblem) []*petsv1.BatchItemProblem {
+	result := make([]*petsv1.BatchItemProblem, 0, len(problems))
+	for _, problem := range problems {
+		result = append(result, &petsv1.BatchItemProblem{Id: problem.ID, Error: problem.Error})
+	}
+	return result
+}
+
+func fromRowResult(result pettypes.BulkRowResult) *petsv1.ImportRowResult {
+	return &petsv1.ImportRowResult{
+		Row:     int32(result.Line),
+		Id:      result.ID,
+		Status:  rowStatuses[result.Status],
+		Error:   result.Error,
+		Warning: result.Warning,
+	}
+}
+
+func fromImportSummary(summary pettypes.BulkImportSummary) *petsv1.ImportSummary {
+	return &petsv1.ImportSummary{
+		Total:   int32(summary.Total),
+		Created: int32(summary.Created),
+		Updated: int32(summary.Updated),
+		Valid:   int32(summary.Valid),
+		Failed:  int32(summary.Failed),
+		DryRun:  summary.DryRun,
+	}
+}
@@ -0,0 +1,244 @@
+// Package grpc serves the pets use cases over gRPC (petstore.pets.v1.PetService).
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	petsv1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/pets/v1"
+	petsapp "github.com/Apurer/go-gin-api-server/internal/domains/pets/application"
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// Server adapts ports.Service to petsv1.PetServiceServer.
+type Server struct {
+	petsv1.UnimplementedPetServiceServer
+	service   ports.Service
+	workflows ports.WorkflowOrchestrator
+}
+
+// Option customizes the server.
+type Option func(*Server)
+
+// WithWorkflows creates pets through the orchestrator, like the HTTP API, instead of calling
+// the service directly.
+func WithWorkflows(workflows ports.WorkflowOrchestrator) Option {
+	return func(s *Server) {
+		s.workflows = workflows
+	}
+}
+
+// NewServer serves service.
+func NewServer(service ports.Service, opts ...Option) *Server {
+	s := &Server{service: service}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	return s
+}
+
+func (s *Server) AddPet(ctx context.Context, req *petsv1.AddPetRequest) (*petsv1.Pet, error) {
+	mutation, err := toMutationInput(req.GetPet())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	input := pettypes.AddPetInput{PetMutationInput: mutation, IdempotencyKey: req.GetIdempotencyKey()}
+	var saved *pettypes.PetProjection
+	if s.workflows != nil {
+		saved, err = s.workflows.CreatePet(ctx, input)
+	} else {
+		saved, err = s.service.AddPet(ctx, input)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromProjection(saved), nil
+}
+
+func (s *Server) UpdatePet(ctx context.Context, req *petsv1.UpdatePetRequest) (*petsv1.Pet, error) {
+	mutation, err := toMutationInput(req.GetPet())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	saved, err := s.service.UpdatePet(ctx, pettypes.UpdatePetInput{PetMutationInput: mutation})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromProjection(saved), nil
+}
+
+func (s *Server) GetPet(ctx context.Context, req *petsv1.GetPetRequest) (*petsv1.Pet, error) {
+	projection, err := s.service.GetByID(ctx, pettypes.PetIdentifier{ID: req.GetId()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromProjection(projection), nil
+}
+
+func (s *Server) FindPetsByStatus(ctx context.Context, req *petsv1.FindPetsByStatusRequest) (*petsv1.FindPetsResponse, error) {
+	statuses := make([]string, 0, len(req.GetStatuses()))
+	for _, status := range req.GetStatuses() {
+		value, err := toDomainStatus(status)
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+		statuses = append(statuses, value)
+	}
+	pets, err := s.service.FindByStatus(ctx, pettypes.FindPetsByStatusInput{Statuses: statuses})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &petsv1.FindPetsResponse{Pets: fromProjectionList(pets)}, nil
+}
+
+func (s *Server) FindPetsByTags(ctx context.Context, req *petsv1.FindPetsByTagsRequest) (*petsv1.FindPetsResponse, error) {
+	pets, err := s.service.FindByTags(ctx, pettypes.FindPetsByTagsInput{Tags: req.GetTags()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &petsv1.FindPetsResponse{Pets: fromProjectionList(pets)}, nil
+}
+
+func (s *Server) ListPets(ctx context.Context, _ *petsv1.ListPetsRequest) (*petsv1.ListPetsResponse, error) {
+	pets, err := s.service.List(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &petsv1.ListPetsResponse{Pets: fromProjectionList(pets)}, nil
+}
+
+func (s *Server) BatchGetPets(ctx context.Context, req *petsv1.BatchGetPetsRequest) (*petsv1.BatchGetPetsResponse, error) {
+	result, err := s.service.BatchGet(ctx, pettypes.BatchGetInput{IDs: req.GetIds()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &petsv1.BatchGetPetsResponse{Pets: fromProjectionList(result.Pets), MissingIds: result.Missing}, nil
+}
+
+func (s *Server) BatchUpdatePetStatus(ctx context.Context, req *petsv1.BatchUpdatePetStatusRequest) (*petsv1.BatchUpdatePetStatusResponse, error) {
+	status, err := toDomainStatus(req.GetStatus())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	input := pettypes.BatchStatusInput{IDs: req.GetIds(), Status: status, Atomic: req.GetAtomic()}
+	result, err := s.service.BatchUpdateStatus(ctx, input)
+	if errors.Is(err, petsapp.ErrBatchRejected) && result != nil {
+		return nil, batchRejected(err, result.Problems)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &petsv1.BatchUpdatePetStatusResponse{
+		Updated:  fromProjectionList(result.Updated),
+		Problems: fromBatchProblems(result.Problems),
+		Warnings: fromBatchProblems(result.Warnings),
+	}, nil
+}
+
+func (s *Server) DeletePet(ctx context.Context, req *petsv1.DeletePetRequest) (*petsv1.DeletePetResponse, error) {
+	if err := s.service.Delete(ctx, pettypes.PetIdentifier{ID: req.GetId()}); err != nil {
+		return nil, toStatus(err)
+	}
+	return &petsv1.DeletePetResponse{}, nil
+}
+
+func (s *Server) GroomPet(ctx context.Context, req *petsv1.GroomPetRequest) (*petsv1.Pet, error) {
+	groomed, err := s.service.GroomPet(ctx, pettypes.GroomPetInput{
+		ID:                  req.GetId(),
+		InitialHairLengthCm: req.GetInitialHairLengthCm(),
+		TrimByCm:            req.GetTrimByCm(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromProjection(groomed), nil
+}
+
+func (s *Server) UploadImage(ctx context.Context, req *petsv1.UploadImageRequest) (*petsv1.UploadImageResponse, error) {
+	result, err := s.service.UploadImage(ctx, pettypes.UploadImageInput{
+		ID:       req.GetId(),
+		Filename: req.GetFilename(),
+		Metadata: req.GetMetadata(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &petsv1.UploadImageResponse{Code: result.Code, Type: result.Type, Message: result.Message}, nil
+}
+
+// ImportPets reads the options, if sent first, and then imports the pets as they arrive. Row
+// results stream back while the client is still sending; the summary is the last message.
+func (s *Server) ImportPets(stream petsv1.PetService_ImportPetsServer) error {
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	input := pettypes.BulkImportInput{Mode: pettypes.BulkImportCreate}
+	if options := first.GetOptions(); options != nil {
+		mode, ok := importModes[options.GetMode()]
+		if !ok {
+			return invalidArgument(fmt.Errorf("unknown import mode %v", options.GetMode()))
+		}
+		input.Mode = mode
+		input.DryRun = options.GetDryRun()
+		first = nil
+	}
+	input.Rows = receiveRows(stream, first, errors.Is(err, io.EOF))
+	summary, err := s.service.ImportPets(stream.Context(), input, func(result pettypes.BulkRowResult) error {
+		return stream.Send(&petsv1.ImportPetsResponse{Result: &petsv1.ImportPetsResponse_Row{Row: fromRowResult(result)}})
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+	return stream.Send(&petsv1.ImportPetsResponse{Result: &petsv1.ImportPetsResponse_Summary{Summary: fromImportSummary(summary)}})
+}
+
+// receiveRows yields first, unless it is nil, and then every pet received until the client
+// closes its side. Messages that cannot become a row are reported as failed rows.
+func receiveRows(stream petsv1.PetService_ImportPetsServer, first *petsv1.ImportPetsRequest, done bool) iter.Seq[pettypes.BulkImportRow] {
+	return func(yield func(pettypes.BulkImportRow) bool) {
+		row := 0
+		next := first
+		for !done {
+			if next == nil {
+				var err error
+				if next, err = stream.Recv(); errors.Is(err, io.EOF) {
+					return
+				} else if err != nil {
+					yield(pettypes.BulkImportRow{Line: row + 1, Err: fmt.Errorf("receive pet: %w", err)})
+					return
+				}
+			}
+			row++
+			result := pettypes.BulkImportRow{Line: row}
+			switch {
+			case next.GetPet() != nil:
+				result.Mutation, result.Err = toMutationInput(next.GetPet())
+			case next.GetOptions() != nil:
+				result.Err = errors.New("options must be sent before the first pet")
+			default:
+				result.Err = errors.New("message carries no pet")
+			}
+			next = nil
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// ExportPets streams every pet; a failure part way through ends the stream with its status.
+func (s *Server) ExportPets(_ *petsv1.ExportPetsRequest, stream petsv1.PetService_ExportPetsServer) error {
+	err := s.service.ExportPets(stream.Context(), func(projection *pettypes.PetProjection) error {
+		return stream.Send(fromProjection(projection))
+	})
+	return toStatus(err)
+}
+
+var _ petsv1.PetServiceServer = (*Server)(nil)
@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	petsv1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/pets/v1"
+	petmemory "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	petsapp "github.com/Apurer/go-gin-api-server/internal/domains/pets/application"
+	"github.com/Apurer/go-gin-api-server/internal/platform/grpcserver"
+)
+
+func newPetClient(t *testing.T) petsv1.PetServiceClient {
+	t.Helper()
+	server := grpcserver.New(grpcserver.Config{}, grpcserver.WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))))
+	petsv1.RegisterPetServiceServer(server, NewServer(petsapp.NewService(petmemory.NewRepository())))
+	listener := bufconn.Listen(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- server.Serve(ctx, listener) }()
+	conn, err := grpclib.NewClient("passthrough:///bufnet",
+		grpclib.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpclib.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		cancel()
+		require.NoError(t, <-served)
+	})
+	return petsv1.NewPetServiceClient(conn)
+}
+
+func newPet(id int64, name string, status petsv1.PetStatus) *petsv1.PetMutation {
+	return &petsv1.PetMutation{
+		Id:        id,
+		Name:      proto.String(name),
+		PhotoUrls: &petsv1.PhotoUrls{Urls: []string{"https://example.com/" + name + ".jpg"}},
+		Status:    status,
+	}
+}
+
+func TestPetServer_MapsUseCasesAndErrors(t *testing.T) {
+	client := newPetClient(t)
+	ctx := context.Background()
+
+	created, err := client.AddPet(ctx, &petsv1.AddPetRequest{Pet: newPet(1, "Rex", petsv1.PetStatus_PET_STATUS_AVAILABLE)})
+	require.NoError(t, err)
+	require.Equal(t, "Rex", created.GetName())
+	require.Equal(t, petsv1.PetStatus_PET_STATUS_AVAILABLE, created.GetStatus())
+	require.NotNil(t, created.GetCreateTime())
+
+	// Unset fields are left alone on update.
+	updated, err := client.UpdatePet(ctx, &petsv1.UpdatePetRequest{Pet: &petsv1.PetMutation{Id: 1, Status: petsv1.PetStatus_PET_STATUS_SOLD}})
+	require.NoError(t, err)
+	require.Equal(t, "Rex", updated.GetName())
+	require.Equal(t, petsv1.PetStatus_PET_STATUS_SOLD, updated.GetStatus())
+
+	_, err = client.GetPet(ctx, &petsv1.GetPetRequest{Id: 404})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	notFound, ok := status.Convert(err).Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, "pets.not_found", notFound.GetReason())
+
+	_, err = client.AddPet(ctx, &petsv1.AddPetRequest{Pet: &petsv1.PetMutation{Id: 2, Name: proto.String("Nameless photos")}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.FindPetsByStatus(ctx, &petsv1.FindPetsByStatusRequest{Statuses: []petsv1.PetStatus{petsv1.PetStatus_PET_STATUS_UNSPECIFIED}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.BatchUpdatePetStatus(ctx, &petsv1.BatchUpdatePetStatusRequest{
+		Ids:    []int64{1, 404},
+		Status: petsv1.PetStatus_PET_STATUS_PENDING,
+		Atomic: true,
+	})
+	st := status.Convert(err)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Len(t, st.Details(), 2)
+	failure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+	require.True(t, ok)
+	require.Len(t, failure.GetViolations(), 1)
+	require.Equal(t, "404", failure.GetViolations()[0].GetSubject())
+	info, ok := st.Details()[1].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, "pets.batch_rejected", info.GetReason())
+
+	pet, err := client.GetPet(ctx, &petsv1.GetPetRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, petsv1.PetStatus_PET_STATUS_SOLD, pet.GetStatus(), "a rejected atomic batch writes nothing")
+}
+
+func TestPetServer_ImportAndExportStreams(t *testing.T) {
+	client := newPetClient(t)
+	ctx := context.Background()
+
+	stream, err := client.ImportPets(ctx)
+	require.NoError(t, err)
+	requests := []*petsv1.ImportPetsRequest{
+		{Payload: &petsv1.ImportPetsRequest_Options{Options: &petsv1.ImportPetsOptions{Mode: petsv1.ImportMode_IMPORT_MODE_UPSERT}}},
+		{Payload: &petsv1.ImportPetsRequest_Pet{Pet: newPet(1, "Rex", petsv1.PetStatus_PET_STATUS_AVAILABLE)}},
+		{Payload: &petsv1.ImportPetsRequest_Pet{Pet: &petsv1.PetMutation{Id: 2}}},
+		{Payload: &petsv1.ImportPetsRequest_Options{Options: &petsv1.ImportPetsOptions{}}},
+		{Payload: &petsv1.ImportPetsRequest_Pet{Pet: newPet(3, "Tom", petsv1.PetStatus_PET_STATUS_PENDING)}},
+	}
+	for _, req := range requests {
+		require.NoError(t, stream.Send(req))
+	}
+	require.NoError(t, stream.CloseSend())
+
+	// Failed rows are reported as soon as they are read, saved rows once their batch is written.
+	rows := map[int32]*petsv1.ImportRowResult{}
+	var summary *petsv1.ImportSummary
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if row := resp.GetRow(); row != nil {
+			rows[row.GetRow()] = row
+		} else {
+			summary = resp.GetSummary()
+		}
+	}
+	require.Len(t, rows, 4)
+	require.Equal(t, petsv1.ImportRowStatus_IMPORT_ROW_STATUS_CREATED, rows[1].GetStatus())
+	require.Equal(t, petsv1.ImportRowStatus_IMPORT_ROW_STATUS_FAILED, rows[2].GetStatus())
+	require.Equal(t, petsv1.ImportRowStatus_IMPORT_ROW_STATUS_FAILED, rows[3].GetStatus())
+	require.Contains(t, rows[3].GetError(), "options must be sent before the first pet")
+	require.Equal(t, int64(3), rows[4].GetId())
+	require.True(t, proto.Equal(&petsv1.ImportSummary{Total: 4, Created: 2, Failed: 2}, summary), "summary %v", summary)
+
+	export, err := client.ExportPets(ctx, &petsv1.ExportPetsRequest{})
+	require.NoError(t, err)
+	var names []string
+	for {
+		pet, err := export.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, pet.GetName())
+	}
+	require.ElementsMatch(t, []string{"Rex", "Tom"}, names)
+}
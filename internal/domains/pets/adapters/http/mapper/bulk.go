@@ -0,0 +1,329 @@
+package mapper
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"mime"
+	"strconv"
+	"strings"
+
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+)
+
+// BulkFormat names a bulk import/export encoding.
+type BulkFormat string
+
+const (
+	BulkNDJSON BulkFormat = "ndjson"
+	BulkCSV    BulkFormat = "csv"
+)
+
+// ContentType returns the media type used when streaming the format.
+func (f BulkFormat) ContentType() string {
+	if f == BulkCSV {
+		return "text/csv"
+	}
+	return "application/x-ndjson"
+}
+
+// ErrUnsupportedBulkFormat is returned for media types other than NDJSON and CSV.
+var ErrUnsupportedBulkFormat = errors.New("unsupported bulk format")
+
+// ParseBulkFormat accepts a format name ("ndjson", "csv") or a media type.
+func ParseBulkFormat(raw string) (BulkFormat, error) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	if mediaType, _, err := mime.ParseMediaType(value); err == nil {
+		value = mediaType
+	}
+	switch value {
+	case "ndjson", "application/x-ndjson", "application/ndjson", "application/jsonl":
+		return BulkNDJSON, nil
+	case "csv", "text/csv":
+		return BulkCSV, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedBulkFormat, raw)
+	}
+}
+
+// maxNDJSONLine bounds a single NDJSON record.
+const maxNDJSONLine = 1 << 20
+
+// csvColumns is the CSV header written on export; imports accept any subset in any order.
+// List cells are separated by "|"; tags are written as "id:name" (or just "name" without an id)
+// and externalAttributes holds a JSON object.
+var csvColumns = []string{
+	"id", "name", "photoUrls", "status", "categoryId", "categoryName", "tags",
+	"hairLengthCm", "externalProvider", "externalId", "externalAttributes",
+}
+
+const csvListSeparator = "|"
+
+// DecodeBulkRows lazily decodes r into import rows. Malformed rows are yielded with Err set so the
+// import reports them and keeps going; an unreadable stream yields one final failed row.
+func DecodeBulkRows(format BulkFormat, r io.Reader) iter.Seq[petstypes.BulkImportRow] {
+	if format == BulkCSV {
+		return decodeCSVRows(r)
+	}
+	return decodeNDJSONRows(r)
+}
+
+func decodeNDJSONRows(r io.Reader) iter.Seq[petstypes.BulkImportRow] {
+	return func(yield func(petstypes.BulkImportRow) bool) {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLine)
+		line := 0
+		for scanner.Scan() {
+			line++
+			raw := bytes.TrimSpace(scanner.Bytes())
+			if len(raw) == 0 {
+				continue
+			}
+			var model MutationPet
+			dec := json.NewDecoder(bytes.NewReader(raw))
+			dec.DisallowUnknownFields()
+			row := petstypes.BulkImportRow{Line: line}
+			if err := dec.Decode(&model); err != nil {
+				row.Err = fmt.Errorf("invalid JSON: %w", err)
+			} else {
+				row.Mutation = ToMutationInput(model)
+			}
+			if !yield(row) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			yield(petstypes.BulkImportRow{Line: line + 1, Err: fmt.Errorf("read input: %w", err)})
+		}
+	}
+}
+
+func decodeCSVRows(r io.Reader) iter.Seq[petstypes.BulkImportRow] {
+	return func(yield func(petstypes.BulkImportRow) bool) {
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		header, err := reader.Read()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				yield(petstypes.BulkImportRow{Line: 1, Err: fmt.Errorf("read CSV header: %w", err)})
+			}
+			return
+		}
+		known := map[string]bool{}
+		for _, column := range csvColumns {
+			known[column] = true
+		}
+		for i, column := range header {
+			header[i] = strings.TrimSpace(column)
+			if !known[header[i]] {
+				yield(petstypes.BulkImportRow{Line: 1, Err: fmt.Errorf("unknown CSV column %q", header[i])})
+				return
+			}
+		}
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			line, _ := reader.FieldPos(0)
+			row := petstypes.BulkImportRow{Line: line}
+			var parseErr *csv.ParseError
+			switch {
+			case errors.As(err, &parseErr):
+				row.Line = parseErr.Line
+				row.Err = err
+			case err != nil:
+				yield(petstypes.BulkImportRow{Line: line, Err: fmt.Errorf("read input: %w", err)})
+				return
+			case len(record) != len(header):
+				row.Err = fmt.Errorf("expected %d fields, got %d", len(header), len(record))
+			default:
+				row.Mutation, row.Err = csvRecordToMutation(header, record)
+			}
+			if !yield(row) {
+				return
+			}
+		}
+	}
+}
+
+func csvRecordToMutation(header, record []string) (petstypes.PetMutationInput, error) {
+	var model MutationPet
+	for i, column := range header {
+		cell := strings.TrimSpace(record[i])
+		if cell == "" {
+			continue
+		}
+		switch column {
+		case "id":
+			id, err := strconv.ParseInt(cell, 10, 64)
+			if err != nil {
+				return petstypes.PetMutationInput{}, fmt.Errorf("id: %w", err)
+			}
+			model.ID = id
+		case "name":
+			model.Name = &cell
+		case "photoUrls":
+			urls := splitCSVList(cell)
+			model.PhotoURLs = &urls
+		case "status":
+			model.Status = &cell
+		case "categoryId":
+			id, err := strconv.ParseInt(cell, 10, 64)
+			if err != nil {
+				return petstypes.PetMutationInput{}, fmt.Errorf("categoryId: %w", err)
+			}
+			if model.Category == nil {
+				model.Category = &Category{}
+			}
+			model.Category.ID = id
+		case "categoryName":
+			if model.Category == nil {
+				model.Category = &Category{}
+			}
+			model.Category.Name = cell
+		case "tags":
+			var tags []Tag
+			for _, entry := range splitCSVList(cell) {
+				tags = append(tags, parseCSVTag(entry))
+			}
+			model.Tags = &tags
+		case "hairLengthCm":
+			hair, err := strconv.ParseFloat(cell, 64)
+			if err != nil {
+				return petstypes.PetMutationInput{}, fmt.Errorf("hairLengthCm: %w", err)
+			}
+			model.HairLengthCm = &hair
+		case "externalProvider":
+			ensureExternalReference(&model).Provider = cell
+		case "externalId":
+			ensureExternalReference(&model).ID = cell
+		case "externalAttributes":
+			var attrs map[string]string
+			if err := json.Unmarshal([]byte(cell), &attrs); err != nil {
+				return petstypes.PetMutationInput{}, fmt.Errorf("externalAttributes: %w", err)
+			}
+			ensureExternalReference(&model).Attributes = attrs
+		}
+	}
+	return ToMutationInput(model), nil
+}
+
+func ensureExternalReference(model *MutationPet) *ExternalReference {
+	if model.ExternalReference == nil {
+		model.ExternalReference = &ExternalReference{}
+	}
+	return model.ExternalReference
+}
+
+func splitCSVList(cell string) []string {
+	var values []string
+	for _, value := range strings.Split(cell, csvListSeparator) {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func parseCSVTag(entry string) Tag {
+	if idPart, name, ok := strings.Cut(entry, ":"); ok {
+		if id, err := strconv.ParseInt(idPart, 10, 64); err == nil {
+			return Tag{ID: id, Name: name}
+		}
+	}
+	return Tag{Name: entry}
+}
+
+// BulkEncoder streams pets in a bulk format.
+type BulkEncoder interface {
+	Encode(pet Pet) error
+	// Flush writes buffered output and reports any earlier write error.
+	Flush() error
+}
+
+// NewBulkEncoder returns an encoder writing format to w.
+func NewBulkEncoder(format BulkFormat, w io.Writer) BulkEncoder {
+	if format == BulkCSV {
+		return &csvEncoder{w: csv.NewWriter(w)}
+	}
+	return &ndjsonEncoder{w: bufio.NewWriter(w)}
+}
+
+type ndjsonEncoder struct {
+	w *bufio.Writer
+}
+
+func (e *ndjsonEncoder) Encode(pet Pet) error {
+	return json.NewEncoder(e.w).Encode(pet)
+}
+
+func (e *ndjsonEncoder) Flush() error {
+	return e.w.Flush()
+}
+
+type csvEncoder struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func (e *csvEncoder) Encode(pet Pet) error {
+	if !e.wroteHeader {
+		if err := e.w.Write(csvColumns); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+	record := make([]string, len(csvColumns))
+	record[0] = strconv.FormatInt(pet.ID, 10)
+	record[1] = pet.Name
+	record[2] = strings.Join(pet.PhotoURLs, csvListSeparator)
+	record[3] = pet.Status
+	if pet.Category != nil {
+		if pet.Category.ID != 0 {
+			record[4] = strconv.FormatInt(pet.Category.ID, 10)
+		}
+		record[5] = pet.Category.Name
+	}
+	tags := make([]string, 0, len(pet.Tags))
+	for _, tag := range pet.Tags {
+		if tag.ID != 0 {
+			tags = append(tags, strconv.FormatInt(tag.ID, 10)+":"+tag.Name)
+		} else {
+			tags = append(tags, tag.Name)
+		}
+	}
+	record[6] = strings.Join(tags, csvListSeparator)
+	if pet.HairLengthCm != nil {
+		record[7] = strconv.FormatFloat(*pet.HairLengthCm, 'f', -1, 64)
+	}
+	if ref := pet.ExternalReference; ref != nil {
+		record[8] = ref.Provider
+		record[9] = ref.ID
+		if len(ref.Attributes) > 0 {
+			attrs, err := json.Marshal(ref.Attributes)
+			if err != nil {
+				return err
+			}
+			record[10] = string(attrs)
+		}
+	}
+	return e.w.Write(record)
+}
+
+func (e *csvEncoder) Flush() error {
+	if !e.wroteHeader {
+		if err := e.w.Write(csvColumns); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
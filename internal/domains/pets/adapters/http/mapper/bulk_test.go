@@ -0,0 +1,63 @@
+package mapper
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+)
+
+func TestBulkCodec_CSVRoundTrip(t *testing.T) {
+	pet := patchFixture()
+	pet.ExternalReference.Attributes = map[string]string{"color": "brown"}
+	var buf bytes.Buffer
+	enc := NewBulkEncoder(BulkCSV, &buf)
+	require.NoError(t, enc.Encode(pet))
+	require.NoError(t, enc.Flush())
+	require.True(t, strings.HasPrefix(buf.String(), strings.Join(csvColumns, ",")+"\n"))
+
+	rows := slices.Collect(DecodeBulkRows(BulkCSV, &buf))
+	require.Len(t, rows, 1)
+	require.NoError(t, rows[0].Err)
+	require.Equal(t, 2, rows[0].Line)
+	require.Equal(t, ToMutationInput(MutationPet{
+		ID:                pet.ID,
+		Name:              &pet.Name,
+		PhotoURLs:         &pet.PhotoURLs,
+		Status:            &pet.Status,
+		Category:          pet.Category,
+		Tags:              &pet.Tags,
+		HairLengthCm:      pet.HairLengthCm,
+		ExternalReference: pet.ExternalReference,
+	}), rows[0].Mutation)
+}
+
+func TestBulkCodec_ReportsMalformedRowsAndKeepsGoing(t *testing.T) {
+	ndjson := "{\"name\":\"Rex\",\"photoUrls\":[\"p\"]}\n\n{\"name\":\n{\"nickname\":\"x\"}\n{\"name\":\"Luna\",\"photoUrls\":[\"p\"]}\n"
+	rows := slices.Collect(DecodeBulkRows(BulkNDJSON, strings.NewReader(ndjson)))
+	require.Len(t, rows, 4)
+	require.Equal(t, []int{1, 3, 4, 5}, []int{rows[0].Line, rows[1].Line, rows[2].Line, rows[3].Line})
+	require.NoError(t, rows[0].Err)
+	require.Error(t, rows[1].Err)
+	require.ErrorContains(t, rows[2].Err, "nickname")
+	require.Equal(t, "Luna", *rows[3].Mutation.Name)
+
+	rows = slices.Collect(DecodeBulkRows(BulkCSV, strings.NewReader("name,photoUrls,id\nRex,a|b,x\nLuna,c,\n")))
+	require.Len(t, rows, 2)
+	require.ErrorContains(t, rows[0].Err, "id")
+	require.Equal(t, &[]string{"c"}, rows[1].Mutation.PhotoURLs)
+
+	rows = slices.Collect(DecodeBulkRows(BulkCSV, strings.NewReader("name,nickname\n")))
+	require.Equal(t, []petstypes.BulkImportRow{{Line: 1, Err: rows[0].Err}}, rows)
+	require.ErrorContains(t, rows[0].Err, "nickname")
+
+	format, err := ParseBulkFormat("text/csv; charset=utf-8")
+	require.NoError(t, err)
+	require.Equal(t, BulkCSV, format)
+	_, err = ParseBulkFormat("application/json")
+	require.ErrorIs(t, err, ErrUnsupportedBulkFormat)
+}
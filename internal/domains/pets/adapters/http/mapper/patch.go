@@ -0,0 +1,485 @@
+package mapper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// Patch media types accepted by PATCH /pet/{petId}.
+const (
+	MergePatchContentType = "application/merge-patch+json"
+	JSONPatchContentType  = "application/json-patch+json"
+)
+
+var (
+	// ErrUnsupportedPatch is returned for media types other than merge patch and JSON patch.
+	ErrUnsupportedPatch = errors.New("unsupported patch media type")
+	// ErrInvalidPatch signals a malformed patch document.
+	ErrInvalidPatch = errors.New("invalid patch document")
+	// ErrPatchConflict signals a JSON patch whose test failed or whose paths do not exist on the pet.
+	ErrPatchConflict = errors.New("patch does not apply to the current pet")
+	// ErrUnprocessablePatch signals a patch producing a document that is not a pet representation.
+	ErrUnprocessablePatch = errors.New("patched pet is not a valid representation")
+)
+
+func init() {
+	apierrors.Register(
+		apierrors.Definition{Err: ErrUnsupportedPatch, Code: "pets.unsupported_patch", Status: http.StatusUnsupportedMediaType, Title: "Unsupported Patch Media Type"},
+		apierrors.Definition{Err: ErrInvalidPatch, Code: "pets.invalid_patch", Status: http.StatusBadRequest, Title: "Invalid Patch Document"},
+		apierrors.Definition{Err: ErrPatchConflict, Code: "pets.patch_conflict", Status: http.StatusConflict, Title: "Patch Conflict"},
+		apierrors.Definition{Err: ErrUnprocessablePatch, Code: "pets.unprocessable_patch", Status: http.StatusUnprocessableEntity, Title: "Unprocessable Patch"},
+		apierrors.Definition{Err: ErrUnsupportedBulkFormat, Code: "pets.unsupported_bulk_format", Status: http.StatusUnsupportedMediaType, Title: "Unsupported Bulk Format"},
+	)
+}
+
+// PatchToMutationInput applies a merge patch (RFC 7396) or JSON patch (RFC 6902) to the current
+// representation and returns a mutation holding only the fields that changed. Removing or nulling
+// category, tags, or externalReference sets the matching Clear flag; other fields fall back to
+// their zero value so the domain methods decide whether the result is valid.
+func PatchToMutationInput(current Pet, contentType string, patch []byte) (petstypes.PetMutationInput, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return petstypes.PetMutationInput{}, fmt.Errorf("%w: %q", ErrUnsupportedPatch, contentType)
+	}
+	raw, err := json.Marshal(current)
+	if err != nil {
+		return petstypes.PetMutationInput{}, err
+	}
+	var before map[string]any
+	if err := json.Unmarshal(raw, &before); err != nil {
+		return petstypes.PetMutationInput{}, err
+	}
+	working, err := decodeJSON(raw)
+	if err != nil {
+		return petstypes.PetMutationInput{}, err
+	}
+	var after any
+	switch mediaType {
+	case MergePatchContentType:
+		doc, err := decodeJSON(patch)
+		if err != nil {
+			return petstypes.PetMutationInput{}, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+		}
+		after = mergePatch(working, doc)
+	case JSONPatchContentType:
+		after, err = applyJSONPatch(working, patch)
+		if err != nil {
+			return petstypes.PetMutationInput{}, err
+		}
+	default:
+		return petstypes.PetMutationInput{}, fmt.Errorf("%w: %q", ErrUnsupportedPatch, mediaType)
+	}
+	patched, ok := after.(map[string]any)
+	if !ok {
+		return petstypes.PetMutationInput{}, fmt.Errorf("%w: the pet must remain a JSON object", ErrUnprocessablePatch)
+	}
+	return diffMutation(current.ID, before, patched)
+}
+
+func decodeJSON(raw []byte) (any, error) {
+	var doc any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("trailing data after JSON document")
+	}
+	return doc, nil
+}
+
+func mergePatch(target, patch any) any {
+	patchObj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]any)
+	if !ok {
+		targetObj = map[string]any{}
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergePatch(targetObj[key], value)
+	}
+	return targetObj
+}
+
+type patchOperation struct {
+	Op    string           `json:"op"`
+	Path  *string          `json:"path"`
+	From  *string          `json:"from"`
+	Value *json.RawMessage `json:"value"`
+}
+
+func applyJSONPatch(doc any, patch []byte) (any, error) {
+	var ops []patchOperation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+	}
+	for i, op := range ops {
+		var err error
+		doc, err = applyOperation(doc, op)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s): %w", i, op.Op, err)
+		}
+	}
+	return doc, nil
+}
+
+func applyOperation(doc any, op patchOperation) (any, error) {
+	if op.Path == nil {
+		return nil, fmt.Errorf("%w: missing path", ErrInvalidPatch)
+	}
+	path, err := parsePointer(*op.Path)
+	if err != nil {
+		return nil, err
+	}
+	value := func() (any, error) {
+		if op.Value == nil {
+			return nil, fmt.Errorf("%w: missing value", ErrInvalidPatch)
+		}
+		decoded, err := decodeJSON(*op.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+		}
+		return decoded, nil
+	}
+	from := func() ([]string, error) {
+		if op.From == nil {
+			return nil, fmt.Errorf("%w: missing from", ErrInvalidPatch)
+		}
+		return parsePointer(*op.From)
+	}
+	switch op.Op {
+	case "add":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		return addAt(doc, path, v)
+	case "remove":
+		return removeAt(doc, path)
+	case "replace":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		if len(path) == 0 {
+			return v, nil
+		}
+		if doc, err = removeAt(doc, path); err != nil {
+			return nil, err
+		}
+		return addAt(doc, path, v)
+	case "move":
+		src, err := from()
+		if err != nil {
+			return nil, err
+		}
+		if len(path) > len(src) && reflect.DeepEqual(path[:len(src)], src) {
+			return nil, fmt.Errorf("%w: cannot move a value into itself", ErrInvalidPatch)
+		}
+		v, err := getAt(doc, src)
+		if err != nil {
+			return nil, err
+		}
+		if doc, err = removeAt(doc, src); err != nil {
+			return nil, err
+		}
+		return addAt(doc, path, v)
+	case "copy":
+		src, err := from()
+		if err != nil {
+			return nil, err
+		}
+		v, err := getAt(doc, src)
+		if err != nil {
+			return nil, err
+		}
+		return addAt(doc, path, deepCopy(v))
+	case "test":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		actual, err := getAt(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(actual, v) {
+			return nil, fmt.Errorf("%w: test failed at %q", ErrPatchConflict, *op.Path)
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown op %q", ErrInvalidPatch, op.Op)
+	}
+}
+
+// parsePointer splits an RFC 6901 JSON pointer into unescaped reference tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("%w: path %q must start with /", ErrInvalidPatch, pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+func getAt(doc any, path []string) (any, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]any:
+			child, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("%w: %q does not exist", ErrPatchConflict, token)
+			}
+			doc = child
+		case []any:
+			idx, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[idx]
+		default:
+			return nil, fmt.Errorf("%w: cannot descend into %q", ErrPatchConflict, token)
+		}
+	}
+	return doc, nil
+}
+
+// updateAt rewrites the container addressed by path[:len(path)-1] through leaf and stores the result.
+func updateAt(doc any, path []string, leaf func(container any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return leaf(doc, path[0])
+	}
+	child, err := getAt(doc, path[:1])
+	if err != nil {
+		return nil, err
+	}
+	updated, err := updateAt(child, path[1:], leaf)
+	if err != nil {
+		return nil, err
+	}
+	switch node := doc.(type) {
+	case map[string]any:
+		node[path[0]] = updated
+	case []any:
+		idx, _ := arrayIndex(path[0], len(node)-1)
+		node[idx] = updated
+	}
+	return doc, nil
+}
+
+func addAt(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return updateAt(doc, path, func(container any, token string) (any, error) {
+		switch node := container.(type) {
+		case map[string]any:
+			node[token] = value
+			return node, nil
+		case []any:
+			idx := len(node)
+			if token != "-" {
+				var err error
+				if idx, err = arrayIndex(token, len(node)); err != nil {
+					return nil, err
+				}
+			}
+			node = append(node, nil)
+			copy(node[idx+1:], node[idx:])
+			node[idx] = value
+			return node, nil
+		default:
+			return nil, fmt.Errorf("%w: cannot add %q to a scalar", ErrPatchConflict, token)
+		}
+	})
+}
+
+func removeAt(doc any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("%w: cannot remove the whole pet", ErrUnprocessablePatch)
+	}
+	return updateAt(doc, path, func(container any, token string) (any, error) {
+		switch node := container.(type) {
+		case map[string]any:
+			if _, ok := node[token]; !ok {
+				return nil, fmt.Errorf("%w: %q does not exist", ErrPatchConflict, token)
+			}
+			delete(node, token)
+			return node, nil
+		case []any:
+			idx, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:idx:idx], node[idx+1:]...), nil
+		default:
+			return nil, fmt.Errorf("%w: cannot remove %q from a scalar", ErrPatchConflict, token)
+		}
+	})
+}
+
+func arrayIndex(token string, max int) (int, error) {
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || (token != "0" && strings.HasPrefix(token, "0")) {
+		return 0, fmt.Errorf("%w: invalid array index %q", ErrInvalidPatch, token)
+	}
+	if idx > max {
+		return 0, fmt.Errorf("%w: array index %d out of range", ErrPatchConflict, idx)
+	}
+	return idx, nil
+}
+
+func deepCopy(v any) any {
+	switch node := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(node))
+		for k, child := range node {
+			out[k] = deepCopy(child)
+		}
+		return out
+	case []any:
+		out := make([]any, len(node))
+		for i, child := range node {
+			out[i] = deepCopy(child)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+var readOnlyPatchFields = map[string]bool{"id": true, "createdAt": true, "updatedAt": true}
+
+func diffMutation(id int64, before, after map[string]any) (petstypes.PetMutationInput, error) {
+	input := petstypes.PetMutationInput{ID: id}
+	for key := range after {
+		if !isMutablePatchField(key) && !readOnlyPatchFields[key] {
+			return input, fmt.Errorf("%w: unknown field %q", ErrUnprocessablePatch, key)
+		}
+	}
+	for key := range readOnlyPatchFields {
+		if !reflect.DeepEqual(before[key], after[key]) {
+			return input, fmt.Errorf("%w: %s is read-only", ErrUnprocessablePatch, key)
+		}
+	}
+	for _, key := range mutablePatchFields {
+		value, present := after[key]
+		if reflect.DeepEqual(before[key], value) || (!present && before[key] == nil) {
+			continue
+		}
+		if err := assignPatchedField(&input, key, value); err != nil {
+			return input, fmt.Errorf("%w: %s: %w", ErrUnprocessablePatch, key, err)
+		}
+	}
+	return input, nil
+}
+
+var mutablePatchFields = []string{"name", "photoUrls", "status", "hairLengthCm", "category", "tags", "externalReference"}
+
+func isMutablePatchField(key string) bool {
+	for _, field := range mutablePatchFields {
+		if field == key {
+			return true
+		}
+	}
+	return false
+}
+
+func assignPatchedField(input *petstypes.PetMutationInput, key string, value any) error {
+	switch key {
+	case "name":
+		var name string
+		if err := decodeField(value, &name); err != nil {
+			return err
+		}
+		input.Name = &name
+	case "photoUrls":
+		urls := []string{}
+		if err := decodeField(value, &urls); err != nil {
+			return err
+		}
+		input.PhotoURLs = &urls
+	case "status":
+		var status string
+		if err := decodeField(value, &status); err != nil {
+			return err
+		}
+		input.Status = &status
+	case "hairLengthCm":
+		var hair float64
+		if err := decodeField(value, &hair); err != nil {
+			return err
+		}
+		input.HairLengthCm = &hair
+	case "category":
+		if value == nil {
+			input.ClearCategory = true
+			return nil
+		}
+		var category Category
+		if err := decodeField(value, &category); err != nil {
+			return err
+		}
+		input.Category = &petstypes.CategoryInput{ID: category.ID, Name: category.Name}
+	case "tags":
+		if value == nil {
+			input.ClearTags = true
+			return nil
+		}
+		var tags []Tag
+		if err := decodeField(value, &tags); err != nil {
+			return err
+		}
+		converted := make([]petstypes.TagInput, 0, len(tags))
+		for _, tag := range tags {
+			converted = append(converted, petstypes.TagInput{ID: tag.ID, Name: tag.Name})
+		}
+		input.Tags = &converted
+	case "externalReference":
+		if value == nil {
+			input.ClearExternalReference = true
+			return nil
+		}
+		var ref ExternalReference
+		if err := decodeField(value, &ref); err != nil {
+			return err
+		}
+		input.ExternalReference = ToExternalReferenceInput(&ref)
+	}
+	return nil
+}
+
+// decodeField strictly decodes a patched value; null leaves target at its zero value.
+func decodeField(value any, target any) error {
+	if value == nil {
+		return nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	return dec.Decode(target)
+}
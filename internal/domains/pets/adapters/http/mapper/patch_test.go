@@ -0,0 +1,77 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+)
+
+func patchFixture() Pet {
+	hair := 4.5
+	return Pet{
+		ID:                7,
+		Name:              "Rex",
+		PhotoURLs:         []string{"https://example.com/rex.jpg"},
+		Category:          &Category{ID: 1, Name: "dogs"},
+		Tags:              []Tag{{ID: 1, Name: "friendly"}, {ID: 2, Name: "loud"}},
+		Status:            "available",
+		HairLengthCm:      &hair,
+		ExternalReference: &ExternalReference{Provider: "acme", ID: "a-7"},
+	}
+}
+
+func TestPatchToMutationInput_MergePatchClearsNulledFields(t *testing.T) {
+	input, err := PatchToMutationInput(patchFixture(), MergePatchContentType,
+		[]byte(`{"name":"Max","category":null,"tags":null,"externalReference":null,"hairLengthCm":null,"photoUrls":["https://example.com/rex.jpg"]}`))
+	require.NoError(t, err)
+	name := "Max"
+	zero := 0.0
+	require.Equal(t, petstypes.PetMutationInput{
+		ID:                     7,
+		Name:                   &name,
+		HairLengthCm:           &zero,
+		ClearCategory:          true,
+		ClearTags:              true,
+		ClearExternalReference: true,
+	}, input)
+
+	input, err = PatchToMutationInput(patchFixture(), MergePatchContentType+"; charset=utf-8", []byte(`{"category":{"name":"hounds"},"externalReference":{"attributes":{"color":"brown"}}}`))
+	require.NoError(t, err)
+	require.Equal(t, &petstypes.CategoryInput{ID: 1, Name: "hounds"}, input.Category, "nested objects are merged, not replaced")
+	require.Equal(t, &petstypes.ExternalReferenceInput{Provider: "acme", ID: "a-7", Attributes: map[string]string{"color": "brown"}}, input.ExternalReference)
+}
+
+func TestPatchToMutationInput_JSONPatchOperations(t *testing.T) {
+	input, err := PatchToMutationInput(patchFixture(), JSONPatchContentType, []byte(`[
+		{"op":"test","path":"/tags/1/name","value":"loud"},
+		{"op":"remove","path":"/tags/1"},
+		{"op":"add","path":"/tags/-","value":{"name":"calm"}},
+		{"op":"copy","from":"/name","path":"/externalReference/externalId"},
+		{"op":"replace","path":"/status","value":"sold"},
+		{"op":"remove","path":"/category"}
+	]`))
+	require.NoError(t, err)
+	status := "sold"
+	require.Equal(t, petstypes.PetMutationInput{
+		ID:                7,
+		Tags:              &[]petstypes.TagInput{{ID: 1, Name: "friendly"}, {Name: "calm"}},
+		Status:            &status,
+		ClearCategory:     true,
+		ExternalReference: &petstypes.ExternalReferenceInput{Provider: "acme", ID: "Rex"},
+	}, input)
+
+	_, err = PatchToMutationInput(patchFixture(), JSONPatchContentType, []byte(`[{"op":"test","path":"/name","value":"Max"}]`))
+	require.ErrorIs(t, err, ErrPatchConflict)
+	_, err = PatchToMutationInput(patchFixture(), JSONPatchContentType, []byte(`[{"op":"remove","path":"/tags/5"}]`))
+	require.ErrorIs(t, err, ErrPatchConflict)
+	_, err = PatchToMutationInput(patchFixture(), JSONPatchContentType, []byte(`[{"op":"replace","path":"/id","value":8}]`))
+	require.ErrorIs(t, err, ErrUnprocessablePatch)
+	_, err = PatchToMutationInput(patchFixture(), JSONPatchContentType, []byte(`[{"op":"add","path":"/nickname","value":"R"}]`))
+	require.ErrorIs(t, err, ErrUnprocessablePatch)
+	_, err = PatchToMutationInput(patchFixture(), JSONPatchContentType, []byte(`{"op":"add"}`))
+	require.ErrorIs(t, err, ErrInvalidPatch)
+	_, err = PatchToMutationInput(patchFixture(), "application/json", []byte(`{}`))
+	require.ErrorIs(t, err, ErrUnsupportedPatch)
+}
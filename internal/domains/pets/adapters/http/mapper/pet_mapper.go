@@ -10,21 +10,21 @@ import (
 
 // Category is the HTTP representation of a pet category.
 type Category struct {
-	ID   int64  `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
+	ID   int64  `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name,omitempty" xml:"name,omitempty"`
 }
 
 // Tag is the HTTP representation of a pet tag.
 type Tag struct {
-	ID   int64  `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
+	ID   int64  `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name,omitempty" xml:"name,omitempty"`
 }
 
 // ExternalReference mirrors the API payload describing a linked provider record.
 type ExternalReference struct {
-	Provider   string            `json:"provider,omitempty"`
-	ID         string            `json:"externalId,omitempty"`
-	Attributes map[string]string `json:"attributes,omitempty"`
+	Provider   string     `json:"provider,omitempty" xml:"provider,omitempty"`
+	ID         string     `json:"externalId,omitempty" xml:"externalId,omitempty"`
+	Attributes Attributes `json:"attributes,omitempty" xml:"attributes,omitempty"`
 }
 
 // GroomingOperation carries transient grooming data.
@@ -51,17 +51,18 @@ type MutationPet struct {
 }
 
 // Pet is the HTTP representation used for mapping between transport and domain responses.
+// XML names follow the contract: <Pet> with wrapped <photoUrls><photoUrl> and <tags><tag> lists.
 type Pet struct {
-	ID                int64              `json:"id,omitempty"`
-	Category          *Category          `json:"category,omitempty"`
-	Name              string             `json:"name"`
-	PhotoURLs         []string           `json:"photoUrls"`
-	Tags              []Tag              `json:"tags,omitempty"`
-	Status            string             `json:"status,omitempty"`
-	HairLengthCm      *float64           `json:"hairLengthCm,omitempty"`
-	ExternalReference *ExternalReference `json:"externalReference,omitempty"`
-	CreatedAt         time.Time          `json:"createdAt,omitempty"`
-	UpdatedAt         time.Time          `json:"updatedAt,omitempty"`
+	ID                int64              `json:"id,omitempty" xml:"id,omitempty"`
+	Category          *Category          `json:"category,omitempty" xml:"category,omitempty"`
+	Name              string             `json:"name" xml:"name"`
+	PhotoURLs         []string           `json:"photoUrls" xml:"photoUrls>photoUrl"`
+	Tags              []Tag              `json:"tags,omitempty" xml:"tags>tag,omitempty"`
+	Status            string             `json:"status,omitempty" xml:"status,omitempty"`
+	HairLengthCm      *float64           `json:"hairLengthCm,omitempty" xml:"hairLengthCm,omitempty"`
+	ExternalReference *ExternalReference `json:"externalReference,omitempty" xml:"externalReference,omitempty"`
+	CreatedAt         time.Time          `json:"createdAt,omitempty" xml:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt,omitempty" xml:"updatedAt"`
 }
 
 // ToDomainPet maps a transport Pet into the domain aggregate.
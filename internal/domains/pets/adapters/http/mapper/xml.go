@@ -0,0 +1,47 @@
+package mapper
+
+import (
+	"encoding/xml"
+	"sort"
+)
+
+// Attributes holds provider-specific key/value pairs. encoding/xml cannot handle maps, so
+// the XML form lists each pair as <attribute key="...">value</attribute>.
+type Attributes map[string]string
+
+type xmlAttribute struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// MarshalXML writes the attributes sorted by key so documents are stable.
+func (a Attributes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	keys := make([]string, 0, len(a))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]xmlAttribute, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, xmlAttribute{Key: key, Value: a[key]})
+	}
+	return e.EncodeElement(struct {
+		Items []xmlAttribute `xml:"attribute"`
+	}{Items: items}, start)
+}
+
+// UnmarshalXML reads the <attribute key="..."> list written by MarshalXML.
+func (a *Attributes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var doc struct {
+		Items []xmlAttribute `xml:"attribute"`
+	}
+	if err := d.DecodeElement(&doc, &start); err != nil {
+		return err
+	}
+	attrs := make(Attributes, len(doc.Items))
+	for _, item := range doc.Items {
+		attrs[item.Key] = item.Value
+	}
+	*a = attrs
+	return nil
+}
@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var _ ports.AppointmentRepository = (*AppointmentRepository)(nil)
+
+// AppointmentRepository is an in-memory grooming calendar used for demos/tests.
+type AppointmentRepository struct {
+	mu           sync.RWMutex
+	appointments map[int64]domain.Appointment
+	history      map[int64]domain.GroomingRecord
+	nextID       int64
+}
+
+// NewAppointmentRepository constructs an empty in-memory grooming calendar.
+func NewAppointmentRepository() *AppointmentRepository {
+	return &AppointmentRepository{
+		appointments: map[int64]domain.Appointment{},
+		history:      map[int64]domain.GroomingRecord{},
+	}
+}
+
+// Save inserts or updates an appointment, rejecting overlaps on the groomer's calendar and
+// updates to appointments that are no longer scheduled.
+func (r *AppointmentRepository) Save(_ context.Context, appointment *domain.Appointment) (*domain.Appointment, error) {
+	if appointment == nil {
+		return nil, errors.New("cannot save nil appointment")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if stored, ok := r.appointments[appointment.ID]; ok && stored.Status != domain.AppointmentScheduled {
+		return nil, domain.ErrAppointmentClosed
+	}
+	for _, other := range r.appointments {
+		if appointment.Conflicts(&other) {
+			return nil, ports.ErrGroomerUnavailable
+		}
+	}
+	if appointment.ID == 0 {
+		r.nextID++
+		appointment.ID = r.nextID
+	} else if appointment.ID > r.nextID {
+		r.nextID = appointment.ID
+	}
+	r.appointments[appointment.ID] = *cloneAppointment(*appointment)
+	return cloneAppointment(*appointment), nil
+}
+
+// GetByID fetches an appointment if present.
+func (r *AppointmentRepository) GetByID(_ context.Context, id int64) (*domain.Appointment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	appointment, ok := r.appointments[id]
+	if !ok {
+		return nil, ports.ErrAppointmentNotFound
+	}
+	return cloneAppointment(appointment), nil
+}
+
+// List returns the appointments matching filter ordered by start time.
+func (r *AppointmentRepository) List(_ context.Context, filter pettypes.AppointmentFilter) ([]*domain.Appointment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	list := make([]*domain.Appointment, 0, len(r.appointments))
+	for _, appointment := range r.appointments {
+		switch {
+		case filter.PetID != 0 && appointment.PetID != filter.PetID,
+			filter.Groomer != "" && appointment.Groomer != filter.Groomer,
+			!filter.From.IsZero() && !appointment.Slot.End.After(filter.From),
+			!filter.To.IsZero() && !appointment.Slot.Start.Before(filter.To):
+			continue
+		}
+		list = append(list, cloneAppointment(appointment))
+	}
+	slices.SortFunc(list, func(a, b *domain.Appointment) int {
+		if c := a.Slot.Start.Compare(b.Slot.Start); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return list, nil
+}
+
+// MarkReminded sets RemindedAt while the appointment is still scheduled for slotStart.
+func (r *AppointmentRepository) MarkReminded(_ context.Context, id int64, slotStart, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	appointment, ok := r.appointments[id]
+	if !ok || appointment.Status != domain.AppointmentScheduled || !appointment.Slot.Start.Equal(slotStart) {
+		return nil
+	}
+	remindedAt := at.UTC()
+	appointment.RemindedAt = &remindedAt
+	r.appointments[id] = appointment
+	return nil
+}
+
+// RecordGrooming stores the measurements of a completed appointment.
+func (r *AppointmentRepository) RecordGrooming(_ context.Context, record domain.GroomingRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.history[record.AppointmentID] = record
+	return nil
+}
+
+// GroomingHistory returns a pet's grooming records, oldest first.
+func (r *AppointmentRepository) GroomingHistory(_ context.Context, petID int64) ([]domain.GroomingRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var history []domain.GroomingRecord
+	for _, record := range r.history {
+		if record.PetID == petID {
+			history = append(history, record)
+		}
+	}
+	slices.SortFunc(history, func(a, b domain.GroomingRecord) int {
+		if c := a.GroomedAt.Compare(b.GroomedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.AppointmentID, b.AppointmentID)
+	})
+	return history, nil
+}
+
+func cloneAppointment(appointment domain.Appointment) *domain.Appointment {
+	if appointment.RemindedAt != nil {
+		remindedAt := *appointment.RemindedAt
+		appointment.RemindedAt = &remindedAt
+	}
+	if appointment.CompletedAt != nil {
+		completedAt := *appointment.CompletedAt
+		appointment.CompletedAt = &completedAt
+	}
+	return &appointment
+}
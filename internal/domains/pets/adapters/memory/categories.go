@@ -0,0 +1,140 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var _ ports.CategoryRepository = (*CategoryRepository)(nil)
+
+// CategoryRepository is an in-memory category store used for demos/tests.
+type CategoryRepository struct {
+	mu         sync.RWMutex
+	categories map[int64]domain.Category
+	nextID     int64
+}
+
+// NewCategoryRepository constructs an empty in-memory category store.
+func NewCategoryRepository() *CategoryRepository {
+	return &CategoryRepository{categories: map[int64]domain.Category{}}
+}
+
+// Create inserts a new category, rejecting IDs that are already used.
+func (r *CategoryRepository) Create(_ context.Context, category *domain.Category) (*domain.Category, error) {
+	if category == nil {
+		return nil, errors.New("cannot save nil category")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.categories[category.ID]; ok {
+		return nil, ports.ErrCategoryIDTaken
+	}
+	return r.save(category)
+}
+
+// Save inserts or replaces a category, keeping names unique.
+func (r *CategoryRepository) Save(_ context.Context, category *domain.Category) (*domain.Category, error) {
+	if category == nil {
+		return nil, errors.New("cannot save nil category")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.save(category)
+}
+
+func (r *CategoryRepository) save(category *domain.Category) (*domain.Category, error) {
+	for id, existing := range r.categories {
+		if id != category.ID && strings.EqualFold(existing.Name, category.Name) {
+			return nil, ports.ErrCategoryNameTaken
+		}
+	}
+	if category.ParentID != nil {
+		if _, ok := r.categories[*category.ParentID]; !ok {
+			return nil, ports.ErrCategoryNotFound
+		}
+	}
+	if category.ID == 0 {
+		r.nextID++
+		category.ID = r.nextID
+	} else if category.ID > r.nextID {
+		r.nextID = category.ID
+	}
+	r.categories[category.ID] = cloneCategory(*category)
+	return ptrCategory(r.categories[category.ID]), nil
+}
+
+// GetByID fetches a category if present.
+func (r *CategoryRepository) GetByID(_ context.Context, id int64) (*domain.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	category, ok := r.categories[id]
+	if !ok {
+		return nil, ports.ErrCategoryNotFound
+	}
+	return ptrCategory(category), nil
+}
+
+// FindByName matches the name case-insensitively.
+func (r *CategoryRepository) FindByName(_ context.Context, name string) (*domain.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	name = strings.TrimSpace(name)
+	for _, category := range r.categories {
+		if strings.EqualFold(category.Name, name) {
+			return ptrCategory(category), nil
+		}
+	}
+	return nil, ports.ErrCategoryNotFound
+}
+
+// List returns every category ordered by ID.
+func (r *CategoryRepository) List(_ context.Context) ([]*domain.Category, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]int64, 0, len(r.categories))
+	for id := range r.categories {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	list := make([]*domain.Category, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, ptrCategory(r.categories[id]))
+	}
+	return list, nil
+}
+
+// Delete removes a category without sub-categories. Pet references are checked by the
+// application service, since this store does not see pets.
+func (r *CategoryRepository) Delete(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.categories[id]; !ok {
+		return ports.ErrCategoryNotFound
+	}
+	for _, category := range r.categories {
+		if category.ParentID != nil && *category.ParentID == id {
+			return ports.ErrCategoryInUse
+		}
+	}
+	delete(r.categories, id)
+	return nil
+}
+
+func ptrCategory(category domain.Category) *domain.Category {
+	clone := cloneCategory(category)
+	return &clone
+}
+
+func cloneCategory(category domain.Category) domain.Category {
+	if category.ParentID != nil {
+		parent := *category.ParentID
+		category.ParentID = &parent
+	}
+	return category
+}
@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var (
+	_ ports.ImportReviewStore  = (*ImportReviewStore)(nil)
+	_ ports.WebhookReplayGuard = (*WebhookReplayGuard)(nil)
+)
+
+// ImportReviewStore keeps parked partner imports in memory.
+type ImportReviewStore struct {
+	mu     sync.RWMutex
+	items  []ports.ParkedImport
+	nextID int64
+	now    func() time.Time
+}
+
+// NewImportReviewStore constructs an empty review queue.
+func NewImportReviewStore() *ImportReviewStore {
+	return &ImportReviewStore{now: time.Now}
+}
+
+// Park appends the import to the review queue.
+func (s *ImportReviewStore) Park(_ context.Context, parked ports.ParkedImport) (*ports.ParkedImport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	parked.ID = s.nextID
+	parked.CreatedAt = s.now()
+	parked.Missing = append([]string{}, parked.Missing...)
+	s.items = append(s.items, parked)
+	stored := parked
+	return &stored, nil
+}
+
+// List returns parked imports in arrival order.
+func (s *ImportReviewStore) List(_ context.Context) ([]ports.ParkedImport, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]ports.ParkedImport{}, s.items...), nil
+}
+
+// WebhookReplayGuard tracks accepted webhook deliveries for a single process.
+type WebhookReplayGuard struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+	now  func() time.Time
+}
+
+// NewWebhookReplayGuard constructs an empty replay guard.
+func NewWebhookReplayGuard() *WebhookReplayGuard {
+	return &WebhookReplayGuard{seen: map[string]time.Time{}, now: time.Now}
+}
+
+// Claim records the delivery or reports it as a replay while it has not expired.
+func (g *WebhookReplayGuard) Claim(_ context.Context, provider, deliveryID string, expiresAt time.Time) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.now()
+	for key, expiry := range g.seen {
+		if !expiry.After(now) {
+			delete(g.seen, key)
+		}
+	}
+	key := provider + "\x00" + deliveryID
+	if _, ok := g.seen[key]; ok {
+		return ports.ErrReplayedDelivery
+	}
+	g.seen[key] = expiresAt
+	return nil
+}
+
+// Release forgets the delivery so a retry of it is accepted.
+func (g *WebhookReplayGuard) Release(_ context.Context, provider, deliveryID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.seen, provider+"\x00"+deliveryID)
+	return nil
+}
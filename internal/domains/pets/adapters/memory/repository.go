@@ -3,7 +3,7 @@ package memory
 import (
 	"context"
 	"errors"
-	"strings"
+	"slices"
 	"sync"
 	"time"
 
@@ -12,13 +12,22 @@ import (
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
 )
 
-var _ ports.Repository = (*Repository)(nil)
+var (
+	_ ports.Repository         = (*Repository)(nil)
+	_ ports.CatalogCounter     = (*Repository)(nil)
+	_ ports.PageLister         = (*Repository)(nil)
+	_ ports.CategoryReferences = (*Repository)(nil)
+	_ ports.TagReferences      = (*Repository)(nil)
+)
 
 // Repository is an in-memory implementation used for demos/tests.
 type Repository struct {
-	mu   sync.RWMutex
-	pets map[int64]*storedPet
-	now  func() time.Time
+	mu         sync.RWMutex
+	pets       map[int64]*storedPet
+	now        func() time.Time
+	nextID     int64
+	categories ports.CategoryRepository
+	tags       ports.TagRepository
 }
 
 type storedPet struct {
@@ -42,15 +51,108 @@ func (r *Repository) WithClock(now func() time.Time) {
 	}
 }
 
+// WithCategories makes reads return the current name of managed categories, like the
+// Postgres repository's join, instead of the name stored with the pet.
+func (r *Repository) WithCategories(categories ports.CategoryRepository) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.categories = categories
+}
+
+// WithTags links pet tags to the catalog by name, creating missing tags on save like the
+// Postgres pet_tags join, and makes reads return the current tag names.
+func (r *Repository) WithTags(tags ports.TagRepository) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tags = tags
+}
+
 // Save inserts or replaces a pet while maintaining metadata.
-func (r *Repository) Save(_ context.Context, pet *domain.Pet) (*types.PetProjection, error) {
+func (r *Repository) Save(ctx context.Context, pet *domain.Pet) (*types.PetProjection, error) {
 	if pet == nil {
 		return nil, errors.New("cannot save nil pet")
 	}
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	return r.saveLocked(ctx, pet)
+}
+
+// SaveMany stores every pet under a single lock so readers never observe a partial batch.
+func (r *Repository) SaveMany(ctx context.Context, pets []*domain.Pet) ([]*types.PetProjection, error) {
+	for _, pet := range pets {
+		if pet == nil {
+			return nil, errors.New("cannot save nil pet")
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := make([]*types.PetProjection, 0, len(pets))
+	for _, pet := range pets {
+		projection, err := r.saveLocked(ctx, pet)
+		if err != nil {
+			return nil, err
+		}
+		saved = append(saved, projection)
+	}
+	return saved, nil
+}
+
+// UpdateStatus changes only the status and update time of the listed pets under a single lock.
+func (r *Repository) UpdateStatus(_ context.Context, ids []int64, status domain.Status, requireAll bool) (*types.StatusUpdate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	update := &types.StatusUpdate{Previous: map[int64]domain.Status{}}
+	var found []*storedPet
+	for _, id := range ids {
+		entry, ok := r.pets[id]
+		if !ok {
+			update.Missing = append(update.Missing, id)
+			continue
+		}
+		found = append(found, entry)
+	}
+	write := !requireAll || len(update.Missing) == 0
+	for _, entry := range found {
+		if write && entry.pet.Status != status {
+			update.Previous[entry.pet.ID] = entry.pet.Status
+			entry.pet.Status = status
+			entry.updated = r.now()
+		}
+		update.Pets = append(update.Pets, r.projection(entry))
+	}
+	return update, nil
+}
+
+func (r *Repository) saveLocked(ctx context.Context, pet *domain.Pet) (*types.PetProjection, error) {
+	stored := clonePet(pet)
+	if r.tags != nil && len(stored.Tags) > 0 {
+		names := make([]string, 0, len(stored.Tags))
+		for _, tag := range stored.Tags {
+			names = append(names, tag.Name)
+		}
+		linked, err := r.tags.EnsureNames(ctx, names)
+		if err != nil {
+			return nil, err
+		}
+		stored.Tags = linked
+	}
 
+	// Mirror the unique Postgres index on the primary partner reference.
+	if ref := stored.ExternalRef; ref != nil && ref.ID != "" {
+		for id, entry := range r.pets {
+			if other := entry.pet.ExternalRef; id != pet.ID && other != nil && other.Provider == ref.Provider && other.ID == ref.ID {
+				return nil, ports.ErrExternalReferenceTaken
+			}
+		}
+	}
+	// Mirror the Postgres sequence: assign an identifier when the caller did not provide one.
+	if pet.ID == 0 {
+		r.nextID++
+		pet.ID = r.nextID
+	} else if pet.ID > r.nextID {
+		r.nextID = pet.ID
+	}
 	entry, ok := r.pets[pet.ID]
 	timestamp := r.now()
 	createdAt := timestamp
@@ -59,13 +161,14 @@ func (r *Repository) Save(_ context.Context, pet *domain.Pet) (*types.PetProject
 		createdAt = entry.created
 	}
 
-	stored := &storedPet{
-		pet:     clonePet(pet),
+	stored.ID = pet.ID
+	entry = &storedPet{
+		pet:     stored,
 		created: createdAt,
 		updated: updatedAt,
 	}
-	r.pets[pet.ID] = stored
-	return projectionCopy(stored), nil
+	r.pets[pet.ID] = entry
+	return r.projection(entry), nil
 }
 
 // GetByID fetches a pet if present.
@@ -76,7 +179,20 @@ func (r *Repository) GetByID(_ context.Context, id int64) (*types.PetProjection,
 	if !ok {
 		return nil, ports.ErrNotFound
 	}
-	return projectionCopy(entry), nil
+	return r.projection(entry), nil
+}
+
+// GetMany returns the stored pets among ids in request order.
+func (r *Repository) GetMany(_ context.Context, ids []int64) ([]*types.PetProjection, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	found := make([]*types.PetProjection, 0, len(ids))
+	for _, id := range ids {
+		if entry, ok := r.pets[id]; ok {
+			found = append(found, r.projection(entry))
+		}
+	}
+	return found, nil
 }
 
 // Delete removes a pet.
@@ -101,31 +217,37 @@ func (r *Repository) FindByStatus(_ context.Context, statuses []domain.Status) (
 	var list []*types.PetProjection
 	for _, entry := range r.pets {
 		if _, ok := set[entry.pet.Status]; ok {
-			list = append(list, projectionCopy(entry))
+			list = append(list, r.projection(entry))
 		}
 	}
 	return list, nil
 }
 
-// FindByTags returns pets with overlapping tags.
-func (r *Repository) FindByTags(_ context.Context, tags []string) ([]*types.PetProjection, error) {
+// FindByTags returns pets carrying any or all of the tags.
+func (r *Repository) FindByTags(_ context.Context, tags []string, match types.TagMatch) ([]*types.PetProjection, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	if len(tags) == 0 {
-		return nil, nil
-	}
 	lookup := map[string]struct{}{}
 	for _, t := range tags {
-		lookup[strings.ToLower(t)] = struct{}{}
+		if name := domain.NormalizeTagName(t); name != "" {
+			lookup[name] = struct{}{}
+		}
+	}
+	if len(lookup) == 0 {
+		return nil, nil
 	}
 	var list []*types.PetProjection
 	for _, entry := range r.pets {
-		for _, tag := range entry.pet.Tags {
-			if _, ok := lookup[strings.ToLower(tag.Name)]; ok {
-				list = append(list, projectionCopy(entry))
-				break
+		projection := r.projection(entry)
+		matched := map[string]struct{}{}
+		for _, tag := range projection.Pet.Tags {
+			if _, ok := lookup[tag.Name]; ok {
+				matched[tag.Name] = struct{}{}
 			}
 		}
+		if len(matched) > 0 && (match != types.TagMatchAll || len(matched) == len(lookup)) {
+			list = append(list, projection)
+		}
 	}
 	return list, nil
 }
@@ -136,13 +258,165 @@ func (r *Repository) List(_ context.Context) ([]*types.PetProjection, error) {
 	defer r.mu.RUnlock()
 	list := make([]*types.PetProjection, 0, len(r.pets))
 	for _, entry := range r.pets {
-		list = append(list, projectionCopy(entry))
+		list = append(list, r.projection(entry))
 	}
 	return list, nil
 }
 
-func projectionCopy(entry *storedPet) *types.PetProjection {
-	return types.NewPetProjection(clonePet(entry.pet), entry.created, entry.updated)
+// ListAfter returns up to limit pets with an ID greater than afterID, ordered by ID.
+func (r *Repository) ListAfter(_ context.Context, afterID int64, limit int) ([]*types.PetProjection, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]int64, 0, len(r.pets))
+	for id := range r.pets {
+		if id > afterID {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+	list := make([]*types.PetProjection, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, r.projection(r.pets[id]))
+	}
+	return list, nil
+}
+
+// FindByExternalReference returns the pet linked to the partner record.
+func (r *Repository) FindByExternalReference(_ context.Context, provider, externalID string) (*types.PetProjection, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, entry := range r.pets {
+		ref := entry.pet.ExternalReferenceFor(provider)
+		if ref != nil && ref.ID == externalID {
+			return r.projection(entry), nil
+		}
+	}
+	return nil, ports.ErrNotFound
+}
+
+// CountByCategory counts the pets referencing the category.
+func (r *Repository) CountByCategory(_ context.Context, categoryID int64) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var count int64
+	for _, entry := range r.pets {
+		if entry.pet.Category != nil && entry.pet.Category.ID == categoryID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// TouchByCategory bumps the update time of the pets filed under the category.
+func (r *Repository) TouchByCategory(_ context.Context, categoryID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, entry := range r.pets {
+		if entry.pet.Category != nil && entry.pet.Category.ID == categoryID {
+			entry.updated = r.now()
+		}
+	}
+	return nil
+}
+
+// CountByTag counts the pets carrying each tag.
+func (r *Repository) CountByTag(_ context.Context) (map[int64]int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	counts := map[int64]int64{}
+	for _, entry := range r.pets {
+		for _, tag := range entry.pet.Tags {
+			if tag.ID != 0 {
+				counts[tag.ID]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+// ReassignTag moves pets from one tag to another, keeping each tag once per pet.
+func (r *Repository) ReassignTag(_ context.Context, fromID, toID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, entry := range r.pets {
+		if !slices.ContainsFunc(entry.pet.Tags, func(tag domain.Tag) bool { return tag.ID == fromID }) {
+			continue
+		}
+		hasTarget := slices.ContainsFunc(entry.pet.Tags, func(tag domain.Tag) bool { return tag.ID == toID })
+		tags := make([]domain.Tag, 0, len(entry.pet.Tags))
+		for _, tag := range entry.pet.Tags {
+			if tag.ID == fromID {
+				if hasTarget {
+					continue
+				}
+				tag.ID = toID
+				hasTarget = true
+			}
+			tags = append(tags, tag)
+		}
+		entry.pet.Tags = tags
+		entry.updated = r.now()
+	}
+	return nil
+}
+
+// TouchByTag bumps the update time of the pets carrying the tag.
+func (r *Repository) TouchByTag(_ context.Context, tagID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, entry := range r.pets {
+		if slices.ContainsFunc(entry.pet.Tags, func(tag domain.Tag) bool { return tag.ID == tagID }) {
+			entry.updated = r.now()
+		}
+	}
+	return nil
+}
+
+// CountByStatusAndCategory aggregates the catalog by status and category name.
+func (r *Repository) CountByStatusAndCategory(_ context.Context) ([]ports.CatalogCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	type bucket struct {
+		status   domain.Status
+		category string
+	}
+	counts := map[bucket]int64{}
+	for _, entry := range r.pets {
+		pet := r.projection(entry).Pet
+		key := bucket{status: pet.Status}
+		if pet.Category != nil {
+			key.category = pet.Category.Name
+		}
+		counts[key]++
+	}
+	result := make([]ports.CatalogCount, 0, len(counts))
+	for key, count := range counts {
+		result = append(result, ports.CatalogCount{Status: key.status, Category: key.category, Count: count})
+	}
+	return result, nil
+}
+
+func (r *Repository) projection(entry *storedPet) *types.PetProjection {
+	pet := clonePet(entry.pet)
+	if r.categories != nil && pet.Category != nil && pet.Category.ID != 0 {
+		if category, err := r.categories.GetByID(context.Background(), pet.Category.ID); err == nil {
+			pet.Category.Name = category.Name
+		}
+	}
+	if r.tags != nil && len(pet.Tags) > 0 {
+		tags := make([]domain.Tag, 0, len(pet.Tags))
+		for _, tag := range pet.Tags {
+			// Tags removed from the catalog by a merge are skipped, like rows the join no longer finds.
+			if current, err := r.tags.GetByID(context.Background(), tag.ID); err == nil {
+				tags = append(tags, *current)
+			}
+		}
+		pet.Tags = tags
+	}
+	return types.NewPetProjection(pet, entry.created, entry.updated)
 }
 
 func clonePet(p *domain.Pet) *domain.Pet {
@@ -170,5 +444,6 @@ func clonePet(p *domain.Pet) *domain.Pet {
 		}
 		clone.ExternalRef = &ref
 	}
+	clone.PartnerRefs = p.SnapshotPartnerReferences()
 	return &clone
 }
@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var _ ports.TagRepository = (*TagRepository)(nil)
+
+// TagRepository is an in-memory tag catalog used for demos/tests.
+type TagRepository struct {
+	mu     sync.RWMutex
+	tags   map[int64]domain.Tag
+	byName map[string]int64
+	nextID int64
+}
+
+// NewTagRepository constructs an empty in-memory tag catalog.
+func NewTagRepository() *TagRepository {
+	return &TagRepository{tags: map[int64]domain.Tag{}, byName: map[string]int64{}}
+}
+
+// Save inserts or renames a tag, keeping names unique.
+func (r *TagRepository) Save(_ context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	if tag == nil {
+		return nil, errors.New("cannot save nil tag")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	name := domain.NormalizeTagName(tag.Name)
+	if id, ok := r.byName[name]; ok && id != tag.ID {
+		return nil, ports.ErrTagNameTaken
+	}
+	if tag.ID == 0 {
+		r.nextID++
+		tag.ID = r.nextID
+	} else if tag.ID > r.nextID {
+		r.nextID = tag.ID
+	}
+	if previous, ok := r.tags[tag.ID]; ok {
+		delete(r.byName, previous.Name)
+	}
+	r.tags[tag.ID] = domain.Tag{ID: tag.ID, Name: name}
+	r.byName[name] = tag.ID
+	saved := r.tags[tag.ID]
+	return &saved, nil
+}
+
+// GetByID fetches a tag if present.
+func (r *TagRepository) GetByID(_ context.Context, id int64) (*domain.Tag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	tag, ok := r.tags[id]
+	if !ok {
+		return nil, ports.ErrTagNotFound
+	}
+	return &tag, nil
+}
+
+// FindByName matches the normalized name.
+func (r *TagRepository) FindByName(_ context.Context, name string) (*domain.Tag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.byName[domain.NormalizeTagName(name)]
+	if !ok {
+		return nil, ports.ErrTagNotFound
+	}
+	tag := r.tags[id]
+	return &tag, nil
+}
+
+// List returns every tag ordered by ID.
+func (r *TagRepository) List(_ context.Context) ([]*domain.Tag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]int64, 0, len(r.tags))
+	for id := range r.tags {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	list := make([]*domain.Tag, 0, len(ids))
+	for _, id := range ids {
+		tag := r.tags[id]
+		list = append(list, &tag)
+	}
+	return list, nil
+}
+
+// Delete removes a tag. Pets are moved off it by the application service first.
+func (r *TagRepository) Delete(_ context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tag, ok := r.tags[id]
+	if !ok {
+		return ports.ErrTagNotFound
+	}
+	delete(r.tags, id)
+	delete(r.byName, tag.Name)
+	return nil
+}
+
+// EnsureNames returns the catalog tags for names, creating the missing ones.
+func (r *TagRepository) EnsureNames(_ context.Context, names []string) ([]domain.Tag, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	tags := make([]domain.Tag, 0, len(names))
+	for _, name := range names {
+		name = domain.NormalizeTagName(name)
+		if name == "" {
+			continue
+		}
+		id, ok := r.byName[name]
+		if !ok {
+			r.nextID++
+			id = r.nextID
+			r.tags[id] = domain.Tag{ID: id, Name: name}
+			r.byName[name] = id
+		}
+		tags = append(tags, r.tags[id])
+	}
+	return tags, nil
+}
@@ -0,0 +1,37 @@
+// Package notifications delivers messages to pet owners.
+package notifications
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var _ ports.GroomingReminderNotifier = (*LogNotifier)(nil)
+
+// LogNotifier writes reminders to the structured log. It stands in for an e-mail or SMS
+// provider until owners have contact details.
+type LogNotifier struct {
+	logger *slog.Logger
+}
+
+// NewLogNotifier logs reminders with logger, or the default logger when nil.
+func NewLogNotifier(logger *slog.Logger) *LogNotifier {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LogNotifier{logger: logger}
+}
+
+// NotifyGroomingReminder logs the upcoming appointment.
+func (n *LogNotifier) NotifyGroomingReminder(ctx context.Context, appointment *domain.Appointment) error {
+	n.logger.InfoContext(ctx, "grooming appointment reminder",
+		slog.Int64("appointment.id", appointment.ID),
+		slog.Int64("pet.id", appointment.PetID),
+		slog.String("appointment.groomer", appointment.Groomer),
+		slog.Time("appointment.starts_at", appointment.Slot.Start),
+	)
+	return nil
+}
@@ -0,0 +1,129 @@
+package observability
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// AppointmentService decorates grooming appointments with tracing and logging. It shares the
+// options of the pets Service decorator.
+type AppointmentService struct {
+	inner ports.AppointmentService
+	obs   *Service
+}
+
+// NewAppointmentService wires a decorator around the core appointment service.
+func NewAppointmentService(inner ports.AppointmentService, opts ...Option) ports.AppointmentService {
+	return &AppointmentService{inner: inner, obs: New(nil, opts...).(*Service)}
+}
+
+// ScheduleAppointment books a groomer for a pet.
+func (s *AppointmentService) ScheduleAppointment(ctx context.Context, input pettypes.ScheduleAppointmentInput) (*domain.Appointment, error) {
+	ctx, span := s.obs.startSpan(ctx, "AppointmentService.ScheduleAppointment",
+		attribute.Int64("pet.id", input.PetID),
+		attribute.String("appointment.groomer", input.Groomer),
+	)
+	defer span.End()
+
+	s.obs.logInfo(ctx, "scheduling grooming appointment", slog.Int64("pet.id", input.PetID), slog.String("appointment.groomer", input.Groomer))
+	result, err := s.inner.ScheduleAppointment(ctx, input)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to schedule grooming appointment",
+			slog.Int64("pet.id", input.PetID), slog.String("appointment.groomer", input.Groomer))
+	}
+	span.SetAttributes(attribute.Int64("appointment.id", result.ID))
+	s.obs.logInfo(ctx, "grooming appointment scheduled", slog.Int64("appointment.id", result.ID))
+	return result, nil
+}
+
+// RescheduleAppointment moves an appointment to another slot.
+func (s *AppointmentService) RescheduleAppointment(ctx context.Context, input pettypes.RescheduleAppointmentInput) (*domain.Appointment, error) {
+	ctx, span := s.obs.startSpan(ctx, "AppointmentService.RescheduleAppointment", attribute.Int64("appointment.id", input.ID))
+	defer span.End()
+
+	s.obs.logInfo(ctx, "rescheduling grooming appointment", slog.Int64("appointment.id", input.ID))
+	result, err := s.inner.RescheduleAppointment(ctx, input)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to reschedule grooming appointment", slog.Int64("appointment.id", input.ID))
+	}
+	s.obs.logInfo(ctx, "grooming appointment rescheduled", slog.Int64("appointment.id", input.ID))
+	return result, nil
+}
+
+// CancelAppointment frees the groomer's slot.
+func (s *AppointmentService) CancelAppointment(ctx context.Context, id int64) (*domain.Appointment, error) {
+	ctx, span := s.obs.startSpan(ctx, "AppointmentService.CancelAppointment", attribute.Int64("appointment.id", id))
+	defer span.End()
+
+	s.obs.logInfo(ctx, "cancelling grooming appointment", slog.Int64("appointment.id", id))
+	result, err := s.inner.CancelAppointment(ctx, id)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to cancel grooming appointment", slog.Int64("appointment.id", id))
+	}
+	s.obs.logInfo(ctx, "grooming appointment cancelled", slog.Int64("appointment.id", id))
+	return result, nil
+}
+
+// CompleteAppointment grooms the pet and closes the appointment.
+func (s *AppointmentService) CompleteAppointment(ctx context.Context, input pettypes.CompleteAppointmentInput) (*domain.Appointment, error) {
+	ctx, span := s.obs.startSpan(ctx, "AppointmentService.CompleteAppointment",
+		attribute.Int64("appointment.id", input.ID),
+		attribute.Float64("pet.groom.initial_length_cm", input.InitialHairLengthCm),
+	)
+	defer span.End()
+
+	s.obs.logInfo(ctx, "completing grooming appointment", slog.Int64("appointment.id", input.ID))
+	result, err := s.inner.CompleteAppointment(ctx, input)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to complete grooming appointment", slog.Int64("appointment.id", input.ID))
+	}
+	s.obs.logInfo(ctx, "grooming appointment completed", slog.Int64("appointment.id", input.ID), slog.Int64("pet.id", result.PetID))
+	return result, nil
+}
+
+// GetAppointment loads a single appointment.
+func (s *AppointmentService) GetAppointment(ctx context.Context, id int64) (*domain.Appointment, error) {
+	ctx, span := s.obs.startSpan(ctx, "AppointmentService.GetAppointment", attribute.Int64("appointment.id", id))
+	defer span.End()
+
+	result, err := s.inner.GetAppointment(ctx, id)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to get grooming appointment", slog.Int64("appointment.id", id))
+	}
+	return result, nil
+}
+
+// ListAppointments returns the appointments matching filter.
+func (s *AppointmentService) ListAppointments(ctx context.Context, filter pettypes.AppointmentFilter) ([]*domain.Appointment, error) {
+	ctx, span := s.obs.startSpan(ctx, "AppointmentService.ListAppointments",
+		attribute.Int64("pet.id", filter.PetID),
+		attribute.String("appointment.groomer", filter.Groomer),
+	)
+	defer span.End()
+
+	result, err := s.inner.ListAppointments(ctx, filter)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to list grooming appointments")
+	}
+	span.SetAttributes(attribute.Int("appointment.result.count", len(result)))
+	return result, nil
+}
+
+// GroomingHistory returns a pet's grooming records.
+func (s *AppointmentService) GroomingHistory(ctx context.Context, petID int64) ([]domain.GroomingRecord, error) {
+	ctx, span := s.obs.startSpan(ctx, "AppointmentService.GroomingHistory", attribute.Int64("pet.id", petID))
+	defer span.End()
+
+	result, err := s.inner.GroomingHistory(ctx, petID)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to load grooming history", slog.Int64("pet.id", petID))
+	}
+	span.SetAttributes(attribute.Int("grooming.result.count", len(result)))
+	return result, nil
+}
@@ -0,0 +1,125 @@
+package observability
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	platformobservability "github.com/Apurer/go-gin-api-server/internal/platform/observability"
+)
+
+// BusinessMetrics exports pets domain signals through OpenTelemetry counters.
+type BusinessMetrics struct {
+	idempotencyReplays   metric.Int64Counter
+	idempotencyConflicts metric.Int64Counter
+	syncSucceeded        metric.Int64Counter
+	syncSkipped          metric.Int64Counter
+	syncFailed           metric.Int64Counter
+}
+
+// NewBusinessMetrics creates the pets business counters on the given meter.
+func NewBusinessMetrics(m metric.Meter) ports.BusinessMetrics {
+	if m == nil {
+		return ports.NoopBusinessMetrics
+	}
+	replays, _ := m.Int64Counter("pets.idempotency.replays", metric.WithDescription("Requests answered from a stored idempotency key"))
+	conflicts, _ := m.Int64Counter("pets.idempotency.conflicts", metric.WithDescription("Requests rejected because an idempotency key was reused with a different payload"))
+	succeeded, _ := m.Int64Counter("pets.partner_sync.succeeded", metric.WithDescription("Pets synced to a partner"))
+	skipped, _ := m.Int64Counter("pets.partner_sync.skipped", metric.WithDescription("Partner syncs skipped because the payload hash was unchanged"))
+	failed, _ := m.Int64Counter("pets.partner_sync.failed", metric.WithDescription("Partner syncs that returned an error"))
+	return &BusinessMetrics{
+		idempotencyReplays:   replays,
+		idempotencyConflicts: conflicts,
+		syncSucceeded:        succeeded,
+		syncSkipped:          skipped,
+		syncFailed:           failed,
+	}
+}
+
+// IdempotencyReplayed counts a replayed idempotent request.
+func (b *BusinessMetrics) IdempotencyReplayed(ctx context.Context) {
+	addCounter(ctx, b.idempotencyReplays, 1)
+}
+
+// IdempotencyConflicted counts an idempotency key reused with a different payload.
+func (b *BusinessMetrics) IdempotencyConflicted(ctx context.Context) {
+	addCounter(ctx, b.idempotencyConflicts, 1)
+}
+
+// PartnerSyncSucceeded counts a successful partner sync.
+func (b *BusinessMetrics) PartnerSyncSucceeded(ctx context.Context, provider string) {
+	addCounter(ctx, b.syncSucceeded, 1, attribute.String("partner.provider", provider))
+}
+
+// PartnerSyncSkipped counts a partner sync short-circuited by an unchanged hash.
+func (b *BusinessMetrics) PartnerSyncSkipped(ctx context.Context, provider string) {
+	addCounter(ctx, b.syncSkipped, 1, attribute.String("partner.provider", provider))
+}
+
+// PartnerSyncFailed counts a failed partner sync.
+func (b *BusinessMetrics) PartnerSyncFailed(ctx context.Context, provider string) {
+	addCounter(ctx, b.syncFailed, 1, attribute.String("partner.provider", provider))
+}
+
+// RegisterCatalogGauges exposes the pet catalog broken down by status and category.
+// Repositories implementing ports.CatalogCounter are aggregated in storage; others fall back to List.
+func RegisterCatalogGauges(m metric.Meter, repo ports.Repository, interval time.Duration, logger *slog.Logger) error {
+	if m == nil || repo == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = defaultLogger()
+	}
+	cache := platformobservability.NewCachedObservation(interval, func(ctx context.Context) ([]ports.CatalogCount, error) {
+		return countCatalog(ctx, repo)
+	})
+	gauge, err := m.Int64ObservableGauge("pets.catalog.pets", metric.WithDescription("Pets in the catalog by status and category"))
+	if err != nil {
+		return err
+	}
+	_, err = m.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		counts, err := cache.Get(ctx)
+		if err != nil {
+			logger.WarnContext(ctx, "pet catalog gauge refresh failed", slog.String("error", err.Error()))
+		}
+		for _, count := range counts {
+			o.ObserveInt64(gauge, count.Count, metric.WithAttributes(
+				attribute.String("pet.status", string(count.Status)),
+				attribute.String("pet.category", count.Category),
+			))
+		}
+		return nil
+	}, gauge)
+	return err
+}
+
+func countCatalog(ctx context.Context, repo ports.Repository) ([]ports.CatalogCount, error) {
+	if counter, ok := repo.(ports.CatalogCounter); ok {
+		return counter.CountByStatusAndCategory(ctx)
+	}
+	pets, err := repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	index := map[ports.CatalogCount]int64{}
+	for _, projection := range pets {
+		if projection == nil || projection.Pet == nil {
+			continue
+		}
+		key := ports.CatalogCount{Status: projection.Pet.Status}
+		if projection.Pet.Category != nil {
+			key.Category = projection.Pet.Category.Name
+		}
+		index[key]++
+	}
+	result := make([]ports.CatalogCount, 0, len(index))
+	for key, count := range index {
+		key.Count = count
+		result = append(result, key)
+	}
+	return result, nil
+}
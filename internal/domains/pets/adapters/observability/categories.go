@@ -0,0 +1,91 @@
+package observability
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// CategoryService decorates category management with tracing and logging. It shares the
+// options of the pets Service decorator.
+type CategoryService struct {
+	inner ports.CategoryService
+	obs   *Service
+}
+
+// NewCategoryService wires a decorator around the core category service.
+func NewCategoryService(inner ports.CategoryService, opts ...Option) ports.CategoryService {
+	return &CategoryService{inner: inner, obs: New(nil, opts...).(*Service)}
+}
+
+// CreateCategory adds a category.
+func (s *CategoryService) CreateCategory(ctx context.Context, input pettypes.CategoryMutationInput) (*domain.Category, error) {
+	ctx, span := s.obs.startSpan(ctx, "CategoryService.CreateCategory")
+	defer span.End()
+
+	s.obs.logInfo(ctx, "creating category", slog.String("category.name", input.Name))
+	result, err := s.inner.CreateCategory(ctx, input)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to create category", slog.String("category.name", input.Name))
+	}
+	span.SetAttributes(attribute.Int64("category.id", result.ID))
+	s.obs.logInfo(ctx, "category created", slog.Int64("category.id", result.ID))
+	return result, nil
+}
+
+// UpdateCategory renames or moves a category.
+func (s *CategoryService) UpdateCategory(ctx context.Context, input pettypes.CategoryMutationInput) (*domain.Category, error) {
+	ctx, span := s.obs.startSpan(ctx, "CategoryService.UpdateCategory", attribute.Int64("category.id", input.ID))
+	defer span.End()
+
+	s.obs.logInfo(ctx, "updating category", slog.Int64("category.id", input.ID))
+	result, err := s.inner.UpdateCategory(ctx, input)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to update category", slog.Int64("category.id", input.ID))
+	}
+	s.obs.logInfo(ctx, "category updated", slog.Int64("category.id", input.ID))
+	return result, nil
+}
+
+// GetCategory loads a single category.
+func (s *CategoryService) GetCategory(ctx context.Context, id int64) (*domain.Category, error) {
+	ctx, span := s.obs.startSpan(ctx, "CategoryService.GetCategory", attribute.Int64("category.id", id))
+	defer span.End()
+
+	result, err := s.inner.GetCategory(ctx, id)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to get category", slog.Int64("category.id", id))
+	}
+	return result, nil
+}
+
+// ListCategories returns every category.
+func (s *CategoryService) ListCategories(ctx context.Context) ([]*domain.Category, error) {
+	ctx, span := s.obs.startSpan(ctx, "CategoryService.ListCategories")
+	defer span.End()
+
+	result, err := s.inner.ListCategories(ctx)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to list categories")
+	}
+	span.SetAttributes(attribute.Int("category.result.count", len(result)))
+	return result, nil
+}
+
+// DeleteCategory removes an unreferenced category.
+func (s *CategoryService) DeleteCategory(ctx context.Context, id int64) error {
+	ctx, span := s.obs.startSpan(ctx, "CategoryService.DeleteCategory", attribute.Int64("category.id", id))
+	defer span.End()
+
+	s.obs.logInfo(ctx, "deleting category", slog.Int64("category.id", id))
+	if err := s.inner.DeleteCategory(ctx, id); err != nil {
+		return s.obs.handleError(ctx, span, err, "failed to delete category", slog.Int64("category.id", id))
+	}
+	s.obs.logInfo(ctx, "category deleted", slog.Int64("category.id", id))
+	return nil
+}
@@ -88,6 +88,21 @@ func (s *Service) AddPet(ctx context.Context, input pettypes.AddPetInput) (*pett
 	return result, nil
 }
 
+// ReplayAddPet looks up an earlier AddPet with the same idempotency key with instrumentation.
+func (s *Service) ReplayAddPet(ctx context.Context, input pettypes.AddPetInput) (*pettypes.PetProjection, error) {
+	ctx, span := s.startSpan(ctx, "Service.ReplayAddPet")
+	defer span.End()
+
+	result, err := s.inner.ReplayAddPet(ctx, input)
+	if err != nil {
+		return nil, s.handleError(ctx, span, err, "failed to replay add pet")
+	}
+	if result != nil && result.Pet != nil {
+		s.logInfo(ctx, "add pet replayed", slog.Int64("pet.id", result.Pet.ID))
+	}
+	return result, nil
+}
+
 // UpdatePet overrides an existing pet with new state.
 func (s *Service) UpdatePet(ctx context.Context, input pettypes.UpdatePetInput) (*pettypes.PetProjection, error) {
 	ctx, span := s.startSpan(ctx, "Service.UpdatePet", attribute.Int64("pet.id", input.ID))
@@ -138,12 +153,15 @@ func (s *Service) FindByStatus(ctx context.Context, input pettypes.FindPetsBySta
 	return result, nil
 }
 
-// FindByTags searches pets matching any supplied tag name.
+// FindByTags searches pets matching any or all supplied tag names.
 func (s *Service) FindByTags(ctx context.Context, input pettypes.FindPetsByTagsInput) ([]*pettypes.PetProjection, error) {
-	ctx, span := s.startSpan(ctx, "Service.FindByTags", attribute.StringSlice("pet.tags.requested", input.Tags))
+	ctx, span := s.startSpan(ctx, "Service.FindByTags",
+		attribute.StringSlice("pet.tags.requested", input.Tags),
+		attribute.String("pet.tags.match", string(input.Match)),
+	)
 	defer span.End()
 
-	s.logInfo(ctx, "finding pets by tags", slog.Any("tags", input.Tags))
+	s.logInfo(ctx, "finding pets by tags", slog.Any("tags", input.Tags), slog.String("match", string(input.Match)))
 	result, err := s.inner.FindByTags(ctx, input)
 	if err != nil {
 		return nil, s.handleError(ctx, span, err, "failed to find pets by tags", slog.Any("tags", input.Tags))
@@ -169,6 +187,59 @@ func (s *Service) GetByID(ctx context.Context, input pettypes.PetIdentifier) (*p
 	return result, nil
 }
 
+// BatchGet loads many pets with instrumentation.
+func (s *Service) BatchGet(ctx context.Context, input pettypes.BatchGetInput) (*pettypes.BatchGetResult, error) {
+	ctx, span := s.startSpan(ctx, "Service.BatchGet", attribute.Int("pet.batch.requested", len(input.IDs)))
+	defer span.End()
+
+	s.logInfo(ctx, "loading pets in batch", slog.Int("requested", len(input.IDs)))
+	result, err := s.inner.BatchGet(ctx, input)
+	if err != nil {
+		return nil, s.handleError(ctx, span, err, "failed to load pets in batch", slog.Int("requested", len(input.IDs)))
+	}
+	span.SetAttributes(
+		attribute.Int("pet.result.count", len(result.Pets)),
+		attribute.Int("pet.batch.missing", len(result.Missing)),
+	)
+	s.logInfo(ctx, "pets loaded in batch", slog.Int("found", len(result.Pets)), slog.Int("missing", len(result.Missing)))
+	return result, nil
+}
+
+// BatchUpdateStatus changes the status of many pets with instrumentation.
+func (s *Service) BatchUpdateStatus(ctx context.Context, input pettypes.BatchStatusInput) (*pettypes.BatchStatusResult, error) {
+	ctx, span := s.startSpan(ctx, "Service.BatchUpdateStatus",
+		attribute.Int("pet.batch.requested", len(input.IDs)),
+		attribute.String("pet.status", input.Status),
+		attribute.Bool("pet.batch.atomic", input.Atomic),
+	)
+	defer span.End()
+
+	s.logInfo(ctx, "updating pet status in batch",
+		slog.Int("requested", len(input.IDs)),
+		slog.String("status", input.Status),
+		slog.Bool("atomic", input.Atomic),
+	)
+	result, err := s.inner.BatchUpdateStatus(ctx, input)
+	if result != nil {
+		span.SetAttributes(
+			attribute.Int("pet.batch.updated", len(result.Updated)),
+			attribute.Int("pet.batch.problems", len(result.Problems)),
+		)
+	}
+	if err != nil {
+		return result, s.handleError(ctx, span, err, "failed to update pet status in batch", slog.Int("requested", len(input.IDs)))
+	}
+	for _, projection := range result.Updated {
+		s.metrics.recordUpdated(ctx, projection.Pet.Status)
+	}
+	s.logInfo(ctx, "pet status updated in batch",
+		slog.Int("updated", len(result.Updated)),
+		slog.Int("problems", len(result.Problems)),
+		slog.Int("warnings", len(result.Warnings)),
+	)
+	return result, nil
+}
+
 // Delete removes a pet.
 func (s *Service) Delete(ctx context.Context, input pettypes.PetIdentifier) error {
 	ctx, span := s.startSpan(ctx, "Service.Delete", attribute.Int64("pet.id", input.ID))
@@ -238,6 +309,70 @@ func (s *Service) List(ctx context.Context) ([]*pettypes.PetProjection, error) {
 	return result, nil
 }
 
+// ImportFromPartner upserts a partner-originated pet with instrumentation.
+func (s *Service) ImportFromPartner(ctx context.Context, input pettypes.PartnerImportInput) (*pettypes.PartnerImportResult, error) {
+	ctx, span := s.startSpan(ctx, "Service.ImportFromPartner",
+		attribute.String("partner.provider", input.Provider),
+		attribute.String("partner.event_id", input.EventID),
+	)
+	defer span.End()
+
+	s.logInfo(ctx, "importing pet from partner", slog.String("partner.provider", input.Provider), slog.String("partner.event_id", input.EventID))
+	result, err := s.inner.ImportFromPartner(ctx, input)
+	if err != nil {
+		return nil, s.handleError(ctx, span, err, "failed to import pet from partner")
+	}
+	span.SetAttributes(attribute.String("partner.import.outcome", string(result.Outcome)))
+	s.logInfo(ctx, "imported pet from partner", slog.String("outcome", string(result.Outcome)))
+	return result, nil
+}
+
+// ImportPets runs a bulk import with instrumentation; created and updated rows feed the pet counters.
+func (s *Service) ImportPets(ctx context.Context, input pettypes.BulkImportInput, emit func(pettypes.BulkRowResult) error) (pettypes.BulkImportSummary, error) {
+	ctx, span := s.startSpan(ctx, "Service.ImportPets",
+		attribute.String("pet.bulk.mode", string(input.Mode)),
+		attribute.Bool("pet.bulk.dry_run", input.DryRun),
+	)
+	defer span.End()
+
+	s.logInfo(ctx, "importing pets", slog.String("mode", string(input.Mode)), slog.Bool("dry_run", input.DryRun))
+	summary, err := s.inner.ImportPets(ctx, input, emit)
+	span.SetAttributes(
+		attribute.Int("pet.bulk.total", summary.Total),
+		attribute.Int("pet.bulk.failed", summary.Failed),
+	)
+	s.metrics.recordBulkImported(ctx, summary)
+	if err != nil {
+		return summary, s.handleError(ctx, span, err, "failed to import pets", slog.Int("processed", summary.Total))
+	}
+	s.logInfo(ctx, "imported pets",
+		slog.Int("total", summary.Total),
+		slog.Int("created", summary.Created),
+		slog.Int("updated", summary.Updated),
+		slog.Int("valid", summary.Valid),
+		slog.Int("failed", summary.Failed),
+	)
+	return summary, nil
+}
+
+// ExportPets streams the catalog with instrumentation.
+func (s *Service) ExportPets(ctx context.Context, emit func(*pettypes.PetProjection) error) error {
+	ctx, span := s.startSpan(ctx, "Service.ExportPets")
+	defer span.End()
+
+	count := 0
+	err := s.inner.ExportPets(ctx, func(projection *pettypes.PetProjection) error {
+		count++
+		return emit(projection)
+	})
+	span.SetAttributes(attribute.Int("pet.result.count", count))
+	if err != nil {
+		return s.handleError(ctx, span, err, "failed to export pets", slog.Int("exported", count))
+	}
+	s.logInfo(ctx, "exported pets", slog.Int("count", count))
+	return nil
+}
+
 func (s *Service) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
 	tracer := s.tracer
 	if tracer == nil {
@@ -310,6 +445,16 @@ func (m serviceMetrics) recordUpdated(ctx context.Context, status domain.Status)
 	addCounter(ctx, m.petsUpdated, 1, attribute.String("pet.status", string(status)))
 }
 
+// recordBulkImported adds bulk rows to the created/updated counters, labelled by source instead of status.
+func (m serviceMetrics) recordBulkImported(ctx context.Context, summary pettypes.BulkImportSummary) {
+	if summary.Created > 0 {
+		addCounter(ctx, m.petsCreated, int64(summary.Created), attribute.String("pet.source", "bulk"))
+	}
+	if summary.Updated > 0 {
+		addCounter(ctx, m.petsUpdated, int64(summary.Updated), attribute.String("pet.source", "bulk"))
+	}
+}
+
 func (m serviceMetrics) recordDeleted(ctx context.Context) {
 	addCounter(ctx, m.petsDeleted, 1)
 }
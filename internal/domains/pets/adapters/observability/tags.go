@@ -0,0 +1,84 @@
+package observability
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// TagService decorates the tag catalog with tracing and logging. It shares the options of
+// the pets Service decorator.
+type TagService struct {
+	inner ports.TagService
+	obs   *Service
+}
+
+// NewTagService wires a decorator around the core tag service.
+func NewTagService(inner ports.TagService, opts ...Option) ports.TagService {
+	return &TagService{inner: inner, obs: New(nil, opts...).(*Service)}
+}
+
+// CreateTag adds a tag to the catalog.
+func (s *TagService) CreateTag(ctx context.Context, input pettypes.TagMutationInput) (*domain.Tag, error) {
+	ctx, span := s.obs.startSpan(ctx, "TagService.CreateTag")
+	defer span.End()
+
+	s.obs.logInfo(ctx, "creating tag", slog.String("tag.name", input.Name))
+	result, err := s.inner.CreateTag(ctx, input)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to create tag", slog.String("tag.name", input.Name))
+	}
+	span.SetAttributes(attribute.Int64("tag.id", result.ID))
+	s.obs.logInfo(ctx, "tag created", slog.Int64("tag.id", result.ID))
+	return result, nil
+}
+
+// RenameTag renames a tag.
+func (s *TagService) RenameTag(ctx context.Context, input pettypes.TagMutationInput) (*domain.Tag, error) {
+	ctx, span := s.obs.startSpan(ctx, "TagService.RenameTag", attribute.Int64("tag.id", input.ID))
+	defer span.End()
+
+	s.obs.logInfo(ctx, "renaming tag", slog.Int64("tag.id", input.ID), slog.String("tag.name", input.Name))
+	result, err := s.inner.RenameTag(ctx, input)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to rename tag", slog.Int64("tag.id", input.ID))
+	}
+	s.obs.logInfo(ctx, "tag renamed", slog.Int64("tag.id", input.ID))
+	return result, nil
+}
+
+// MergeTags moves the pets of one tag to another.
+func (s *TagService) MergeTags(ctx context.Context, input pettypes.MergeTagsInput) (*pettypes.TagUsage, error) {
+	attrs := []slog.Attr{slog.Int64("tag.source_id", input.SourceID), slog.Int64("tag.target_id", input.TargetID)}
+	ctx, span := s.obs.startSpan(ctx, "TagService.MergeTags",
+		attribute.Int64("tag.source_id", input.SourceID),
+		attribute.Int64("tag.target_id", input.TargetID),
+	)
+	defer span.End()
+
+	s.obs.logInfo(ctx, "merging tags", attrs...)
+	result, err := s.inner.MergeTags(ctx, input)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to merge tags", attrs...)
+	}
+	s.obs.logInfo(ctx, "tags merged", append(attrs, slog.Int64("tag.pet_count", result.PetCount))...)
+	return result, nil
+}
+
+// ListTags returns the catalog with usage counts.
+func (s *TagService) ListTags(ctx context.Context) ([]pettypes.TagUsage, error) {
+	ctx, span := s.obs.startSpan(ctx, "TagService.ListTags")
+	defer span.End()
+
+	result, err := s.inner.ListTags(ctx)
+	if err != nil {
+		return nil, s.obs.handleError(ctx, span, err, "failed to list tags")
+	}
+	span.SetAttributes(attribute.Int("tag.result.count", len(result)))
+	return result, nil
+}
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var _ ports.AppointmentRepository = (*AppointmentRepository)(nil)
+
+// AppointmentRepository persists grooming appointments and grooming history. Saves for one
+// groomer are serialized with a transaction-scoped advisory lock, so two overlapping bookings
+// cannot both pass the calendar check.
+type AppointmentRepository struct {
+	db *gorm.DB
+}
+
+// NewAppointmentRepository wires a PostgreSQL-backed grooming calendar.
+func NewAppointmentRepository(db *gorm.DB) *AppointmentRepository {
+	return &AppointmentRepository{db: db}
+}
+
+type appointmentRecord struct {
+	ID              int64      `gorm:"primaryKey;column:id"`
+	PetID           int64      `gorm:"column:pet_id"`
+	Groomer         string     `gorm:"column:groomer"`
+	StartsAt        time.Time  `gorm:"column:starts_at"`
+	EndsAt          time.Time  `gorm:"column:ends_at"`
+	RequestedTrimCm float64    `gorm:"column:requested_trim_cm"`
+	Status          string     `gorm:"column:status"`
+	RemindedAt      *time.Time `gorm:"column:reminded_at"`
+	CompletedAt     *time.Time `gorm:"column:completed_at"`
+	CreatedAt       time.Time  `gorm:"column:created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at"`
+}
+
+func (appointmentRecord) TableName() string { return "grooming_appointments" }
+
+type groomingRecord struct {
+	AppointmentID   int64     `gorm:"primaryKey;column:appointment_id"`
+	PetID           int64     `gorm:"column:pet_id"`
+	Groomer         string    `gorm:"column:groomer"`
+	InitialLengthCm float64   `gorm:"column:initial_length_cm"`
+	TrimByCm        float64   `gorm:"column:trim_by_cm"`
+	ResultLengthCm  float64   `gorm:"column:result_length_cm"`
+	GroomedAt       time.Time `gorm:"column:groomed_at"`
+}
+
+func (groomingRecord) TableName() string { return "grooming_records" }
+
+// Save inserts or updates an appointment after checking the groomer's calendar. Updating an
+// appointment that is no longer scheduled fails with domain.ErrAppointmentClosed.
+func (r *AppointmentRepository) Save(ctx context.Context, appointment *domain.Appointment) (*domain.Appointment, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	if appointment == nil {
+		return nil, errors.New("cannot save nil appointment")
+	}
+	record := toAppointmentRecord(appointment)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "grooming_appointments:"+record.Groomer).Error; err != nil {
+			return err
+		}
+		if record.Status == string(domain.AppointmentScheduled) {
+			var overlapping int64
+			if err := tx.Model(&appointmentRecord{}).
+				Where("groomer = ? AND status = ? AND id <> ? AND starts_at < ? AND ends_at > ?",
+					record.Groomer, domain.AppointmentScheduled, record.ID, record.EndsAt, record.StartsAt).
+				Count(&overlapping).Error; err != nil {
+				return err
+			}
+			if overlapping > 0 {
+				return ports.ErrGroomerUnavailable
+			}
+		}
+		if record.ID == 0 {
+			return tx.Omit("id").Create(&record).Error
+		}
+		// Only a row that is still scheduled may change, so a cancel or completion that
+		// committed after this appointment was read is not overwritten.
+		result := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "id"}},
+			DoUpdates: append(clause.AssignmentColumns([]string{
+				"starts_at", "ends_at", "requested_trim_cm", "status", "reminded_at", "completed_at",
+			}), clause.Assignment{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("NOW()")}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "grooming_appointments.status = ?", Vars: []any{string(domain.AppointmentScheduled)}},
+			}},
+		}).Create(&record)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrAppointmentClosed
+		}
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return nil, ports.ErrNotFound
+		}
+		return nil, err
+	}
+	appointment.ID = record.ID
+	return r.GetByID(ctx, record.ID)
+}
+
+// GetByID fetches an appointment by identifier.
+func (r *AppointmentRepository) GetByID(ctx context.Context, id int64) (*domain.Appointment, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var record appointmentRecord
+	if err := r.db.WithContext(ctx).First(&record, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ports.ErrAppointmentNotFound
+		}
+		return nil, err
+	}
+	return record.toDomain(), nil
+}
+
+// List returns the appointments matching filter ordered by start time.
+func (r *AppointmentRepository) List(ctx context.Context, filter pettypes.AppointmentFilter) ([]*domain.Appointment, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	query := r.db.WithContext(ctx).Order("starts_at, id")
+	if filter.PetID != 0 {
+		query = query.Where("pet_id = ?", filter.PetID)
+	}
+	if filter.Groomer != "" {
+		query = query.Where("groomer = ?", filter.Groomer)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("ends_at > ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("starts_at < ?", filter.To)
+	}
+	var records []appointmentRecord
+	if err := query.Find(&records).Error; err != nil {
+		return nil, err
+	}
+	list := make([]*domain.Appointment, 0, len(records))
+	for i := range records {
+		list = append(list, records[i].toDomain())
+	}
+	return list, nil
+}
+
+// MarkReminded sets reminded_at while the appointment is still scheduled for slotStart.
+func (r *AppointmentRepository) MarkReminded(ctx context.Context, id int64, slotStart, at time.Time) error {
+	if err := r.ensureDB(); err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Model(&appointmentRecord{}).
+		Where("id = ? AND status = ? AND starts_at = ?", id, domain.AppointmentScheduled, slotStart).
+		Updates(map[string]any{"reminded_at": at.UTC(), "updated_at": gorm.Expr("NOW()")}).Error
+}
+
+// RecordGrooming upserts the measurements of a completed appointment.
+func (r *AppointmentRepository) RecordGrooming(ctx context.Context, record domain.GroomingRecord) error {
+	if err := r.ensureDB(); err != nil {
+		return err
+	}
+	row := groomingRecord{
+		AppointmentID:   record.AppointmentID,
+		PetID:           record.PetID,
+		Groomer:         record.Groomer,
+		InitialLengthCm: record.InitialLengthCm,
+		TrimByCm:        record.TrimByCm,
+		ResultLengthCm:  record.ResultLengthCm,
+		GroomedAt:       record.GroomedAt,
+	}
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "appointment_id"}},
+		UpdateAll: true,
+	}).Create(&row).Error
+}
+
+// GroomingHistory returns a pet's grooming records, oldest first.
+func (r *AppointmentRepository) GroomingHistory(ctx context.Context, petID int64) ([]domain.GroomingRecord, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var rows []groomingRecord
+	if err := r.db.WithContext(ctx).Where("pet_id = ?", petID).Order("groomed_at, appointment_id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	history := make([]domain.GroomingRecord, 0, len(rows))
+	for _, row := range rows {
+		history = append(history, domain.GroomingRecord{
+			AppointmentID:   row.AppointmentID,
+			PetID:           row.PetID,
+			Groomer:         row.Groomer,
+			InitialLengthCm: row.InitialLengthCm,
+			TrimByCm:        row.TrimByCm,
+			ResultLengthCm:  row.ResultLengthCm,
+			GroomedAt:       row.GroomedAt.UTC(),
+		})
+	}
+	return history, nil
+}
+
+func (r *AppointmentRepository) ensureDB() error {
+	if r == nil || r.db == nil {
+		return errors.New("postgres appointment repository not configured")
+	}
+	return nil
+}
+
+func toAppointmentRecord(appointment *domain.Appointment) appointmentRecord {
+	return appointmentRecord{
+		ID:              appointment.ID,
+		PetID:           appointment.PetID,
+		Groomer:         appointment.Groomer,
+		StartsAt:        appointment.Slot.Start,
+		EndsAt:          appointment.Slot.End,
+		RequestedTrimCm: appointment.RequestedTrimCm,
+		Status:          string(appointment.Status),
+		RemindedAt:      appointment.RemindedAt,
+		CompletedAt:     appointment.CompletedAt,
+	}
+}
+
+func (r *appointmentRecord) toDomain() *domain.Appointment {
+	appointment := &domain.Appointment{
+		ID:              r.ID,
+		PetID:           r.PetID,
+		Groomer:         r.Groomer,
+		Slot:            domain.TimeSlot{Start: r.StartsAt.UTC(), End: r.EndsAt.UTC()},
+		RequestedTrimCm: r.RequestedTrimCm,
+		Status:          domain.AppointmentStatus(r.Status),
+	}
+	if r.RemindedAt != nil {
+		remindedAt := r.RemindedAt.UTC()
+		appointment.RemindedAt = &remindedAt
+	}
+	if r.CompletedAt != nil {
+		completedAt := r.CompletedAt.UTC()
+		appointment.CompletedAt = &completedAt
+	}
+	return appointment
+}
@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var _ ports.CategoryRepository = (*CategoryRepository)(nil)
+
+// Postgres error codes raised by the categories constraints.
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// CategoryRepository persists managed categories. Names are unique case-insensitively and
+// foreign keys from pets and sub-categories keep referenced categories from being deleted.
+type CategoryRepository struct {
+	db *gorm.DB
+}
+
+// NewCategoryRepository wires a PostgreSQL-backed category repository.
+func NewCategoryRepository(db *gorm.DB) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+type categoryRecord struct {
+	ID        int64     `gorm:"primaryKey;column:id"`
+	Name      string    `gorm:"column:name"`
+	ParentID  *int64    `gorm:"column:parent_id"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (categoryRecord) TableName() string { return "categories" }
+
+// Create inserts a new category. An explicit ID advances the id sequence past it, so later
+// categories without an ID do not collide with it.
+func (r *CategoryRepository) Create(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, errors.New("cannot save nil category")
+	}
+	record := categoryRecord{ID: category.ID, Name: category.Name, ParentID: category.ParentID}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if record.ID == 0 {
+			return tx.Omit("id").Create(&record).Error
+		}
+		result := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "id"}}, DoNothing: true}).Create(&record)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ports.ErrCategoryIDTaken
+		}
+		return advanceIDSequence(tx, "categories", record.ID)
+	})
+	if err != nil {
+		// A foreign key violation on insert means the parent does not exist.
+		return nil, translateCategoryError(err, ports.ErrCategoryNotFound)
+	}
+	category.ID = record.ID
+	return r.GetByID(ctx, record.ID)
+}
+
+// Save inserts or updates a category.
+func (r *CategoryRepository) Save(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	if category == nil {
+		return nil, errors.New("cannot save nil category")
+	}
+	record := categoryRecord{ID: category.ID, Name: category.Name, ParentID: category.ParentID}
+	query := r.db.WithContext(ctx)
+	if record.ID == 0 {
+		query = query.Omit("id")
+	} else {
+		query = query.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "id"}},
+			DoUpdates: append(clause.AssignmentColumns([]string{"name", "parent_id"}),
+				clause.Assignment{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("NOW()")}),
+		})
+	}
+	if err := query.Create(&record).Error; err != nil {
+		// A foreign key violation on save means the parent does not exist.
+		return nil, translateCategoryError(err, ports.ErrCategoryNotFound)
+	}
+	category.ID = record.ID
+	return r.GetByID(ctx, record.ID)
+}
+
+// GetByID fetches a category by identifier.
+func (r *CategoryRepository) GetByID(ctx context.Context, id int64) (*domain.Category, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// FindByName matches the name case-insensitively.
+func (r *CategoryRepository) FindByName(ctx context.Context, name string) (*domain.Category, error) {
+	return r.first(ctx, "lower(name) = lower(?)", strings.TrimSpace(name))
+}
+
+// List returns every category ordered by ID.
+func (r *CategoryRepository) List(ctx context.Context) ([]*domain.Category, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var records []categoryRecord
+	if err := r.db.WithContext(ctx).Order("id").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	list := make([]*domain.Category, 0, len(records))
+	for i := range records {
+		list = append(list, records[i].toDomain())
+	}
+	return list, nil
+}
+
+// Delete removes a category; the foreign keys reject it while pets or sub-categories reference it.
+func (r *CategoryRepository) Delete(ctx context.Context, id int64) error {
+	if err := r.ensureDB(); err != nil {
+		return err
+	}
+	result := r.db.WithContext(ctx).Delete(&categoryRecord{}, id)
+	if result.Error != nil {
+		return translateCategoryError(result.Error, ports.ErrCategoryInUse)
+	}
+	if result.RowsAffected == 0 {
+		return ports.ErrCategoryNotFound
+	}
+	return nil
+}
+
+func (r *CategoryRepository) first(ctx context.Context, query string, args ...any) (*domain.Category, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var record categoryRecord
+	if err := r.db.WithContext(ctx).Where(query, args...).First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ports.ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return record.toDomain(), nil
+}
+
+func (r *CategoryRepository) ensureDB() error {
+	if r == nil || r.db == nil {
+		return errors.New("postgres category repository not configured")
+	}
+	return nil
+}
+
+func (r *categoryRecord) toDomain() *domain.Category {
+	category := &domain.Category{ID: r.ID, Name: r.Name}
+	if r.ParentID != nil {
+		parent := *r.ParentID
+		category.ParentID = &parent
+	}
+	return category
+}
+
+// translateCategoryError maps a duplicate name to ErrCategoryNameTaken and a foreign key
+// violation to foreignKeyErr, which depends on the statement that raised it.
+func translateCategoryError(err, foreignKeyErr error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch pgErr.Code {
+	case uniqueViolation:
+		return ports.ErrCategoryNameTaken
+	case foreignKeyViolation:
+		return foreignKeyErr
+	default:
+		return err
+	}
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// EventChannel is the LISTEN/NOTIFY channel carrying pet catalog events between instances.
+const EventChannel = "pet_events"
+
+var _ ports.PetEventPublisher = (*EventNotifier)(nil)
+
+// eventNotification is the NOTIFY payload. The pet itself is not sent: payloads are capped at
+// 8000 bytes, so listeners load the current state by id.
+type eventNotification struct {
+	ID             uint64             `json:"id"`
+	Type           ports.PetEventType `json:"type"`
+	PetID          int64              `json:"petId"`
+	Status         domain.Status      `json:"status"`
+	PreviousStatus domain.Status      `json:"previousStatus,omitempty"`
+	Tags           []string           `json:"tags,omitempty"`
+	OccurredAt     time.Time          `json:"occurredAt"`
+}
+
+// EventNotifier publishes pet events with pg_notify so every instance's listener receives them,
+// including the one that made the change.
+type EventNotifier struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+// NewEventNotifier wires a notifier on db; logger records notifications that could not be sent.
+func NewEventNotifier(db *gorm.DB, logger *slog.Logger) *EventNotifier {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &EventNotifier{db: db, logger: logger}
+}
+
+// Publish numbers the event from the shared pet_event_ids sequence and notifies EventChannel.
+// Numbering and notifying share one transaction holding an advisory lock, so notifications are
+// committed, and therefore delivered, in id order and a Last-Event-ID resume never skips an
+// event that was numbered earlier but delivered later.
+func (n *EventNotifier) Publish(ctx context.Context, event ports.PetEvent) {
+	if err := n.notify(ctx, event); err != nil {
+		n.logger.Warn("pet event not published",
+			slog.String("type", string(event.Type)),
+			slog.Int64("pet_id", event.PetID),
+			slog.String("error", err.Error()))
+	}
+}
+
+func (n *EventNotifier) notify(ctx context.Context, event ports.PetEvent) error {
+	if n == nil || n.db == nil {
+		return errors.New("postgres event notifier not configured")
+	}
+	return n.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", EventChannel).Error; err != nil {
+			return fmt.Errorf("lock event ids: %w", err)
+		}
+		var id uint64
+		if err := tx.Raw("SELECT nextval('pet_event_ids')").Scan(&id).Error; err != nil {
+			return fmt.Errorf("allocate event id: %w", err)
+		}
+		payload, err := json.Marshal(eventNotification{
+			ID:             id,
+			Type:           event.Type,
+			PetID:          event.PetID,
+			Status:         event.Status,
+			PreviousStatus: event.PreviousStatus,
+			Tags:           event.Tags,
+			OccurredAt:     event.OccurredAt,
+		})
+		if err != nil {
+			return err
+		}
+		return tx.Exec("SELECT pg_notify(?, ?)", EventChannel, string(payload)).Error
+	})
+}
+
+// EventListener relays notifications from EventChannel to a local publisher, typically the
+// in-process event bus that serves SSE subscribers.
+type EventListener struct {
+	dsn    string
+	repo   ports.Repository
+	target ports.PetEventPublisher
+	logger *slog.Logger
+}
+
+// NewEventListener listens with its own connection to dsn and loads event pets from repo.
+func NewEventListener(dsn string, repo ports.Repository, target ports.PetEventPublisher, logger *slog.Logger) *EventListener {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &EventListener{dsn: dsn, repo: repo, target: target, logger: logger}
+}
+
+// Run relays events until ctx is cancelled. The connection is re-established automatically;
+// events sent while it was down are lost, which subscribers see as a gap in event ids.
+func (l *EventListener) Run(ctx context.Context) error {
+	listener := pq.NewListener(l.dsn, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			l.logger.Warn("pet event listener connection problem", slog.String("error", err.Error()))
+		}
+	})
+	defer listener.Close()
+	if err := listener.Listen(EventChannel); err != nil {
+		return fmt.Errorf("listen on %s: %w", EventChannel, err)
+	}
+	l.logger.Info("relaying pet events", slog.String("channel", EventChannel))
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-listener.Notify:
+			// A nil notification follows a reconnect.
+			if notification != nil {
+				l.relay(ctx, notification.Extra)
+			}
+		case <-time.After(90 * time.Second):
+			go func() { _ = listener.Ping() }()
+		}
+	}
+}
+
+func (l *EventListener) relay(ctx context.Context, payload string) {
+	var received eventNotification
+	if err := json.Unmarshal([]byte(payload), &received); err != nil {
+		l.logger.Warn("malformed pet event notification", slog.String("error", err.Error()))
+		return
+	}
+	event := ports.PetEvent{
+		ID:             received.ID,
+		Type:           received.Type,
+		PetID:          received.PetID,
+		Status:         received.Status,
+		PreviousStatus: received.PreviousStatus,
+		Tags:           received.Tags,
+		OccurredAt:     received.OccurredAt,
+	}
+	if event.Type != ports.PetDeleted {
+		// The pet may have changed again or been deleted since; the newest state is what clients want.
+		if projection, err := l.repo.GetByID(ctx, event.PetID); err == nil {
+			event.Pet = projection
+		}
+	}
+	l.target.Publish(ctx, event)
+}
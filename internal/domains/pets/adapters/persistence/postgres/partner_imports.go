@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var (
+	_ ports.ImportReviewStore  = (*ImportReviewStore)(nil)
+	_ ports.WebhookReplayGuard = (*WebhookReplayGuard)(nil)
+)
+
+// ImportReviewStore persists parked partner imports in PostgreSQL.
+type ImportReviewStore struct {
+	db *gorm.DB
+}
+
+// NewImportReviewStore wires a PostgreSQL-backed review queue.
+func NewImportReviewStore(db *gorm.DB) *ImportReviewStore {
+	return &ImportReviewStore{db: db}
+}
+
+type importReviewRecord struct {
+	ID         int64          `gorm:"primaryKey;column:id"`
+	Provider   string         `gorm:"column:provider;size:64;index"`
+	EventID    string         `gorm:"column:event_id;size:255"`
+	ExternalID string         `gorm:"column:external_id;size:255"`
+	Missing    pq.StringArray `gorm:"column:missing;type:text[]"`
+	Candidate  []byte         `gorm:"column:candidate;type:jsonb"`
+	CreatedAt  time.Time      `gorm:"column:created_at;index"`
+}
+
+func (importReviewRecord) TableName() string { return "partner_import_reviews" }
+
+// Park stores the candidate payload together with the fields it is missing.
+func (s *ImportReviewStore) Park(ctx context.Context, parked ports.ParkedImport) (*ports.ParkedImport, error) {
+	if s == nil || s.db == nil {
+		return nil, errors.New("postgres import review store not configured")
+	}
+	candidate, err := json.Marshal(parked.Candidate)
+	if err != nil {
+		return nil, err
+	}
+	record := importReviewRecord{
+		Provider:   parked.Provider,
+		EventID:    parked.EventID,
+		ExternalID: parked.ExternalID,
+		Missing:    pq.StringArray(parked.Missing),
+		Candidate:  candidate,
+	}
+	if err := s.db.WithContext(ctx).Create(&record).Error; err != nil {
+		return nil, err
+	}
+	return record.toPort()
+}
+
+// List returns parked imports in arrival order.
+func (s *ImportReviewStore) List(ctx context.Context) ([]ports.ParkedImport, error) {
+	if s == nil || s.db == nil {
+		return nil, errors.New("postgres import review store not configured")
+	}
+	var records []importReviewRecord
+	if err := s.db.WithContext(ctx).Order("id").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	result := make([]ports.ParkedImport, 0, len(records))
+	for i := range records {
+		parked, err := records[i].toPort()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *parked)
+	}
+	return result, nil
+}
+
+func (r importReviewRecord) toPort() (*ports.ParkedImport, error) {
+	var candidate pettypes.PartnerImportCandidate
+	if len(r.Candidate) > 0 {
+		if err := json.Unmarshal(r.Candidate, &candidate); err != nil {
+			return nil, err
+		}
+	}
+	return &ports.ParkedImport{
+		ID:         r.ID,
+		Provider:   r.Provider,
+		EventID:    r.EventID,
+		ExternalID: r.ExternalID,
+		Missing:    append([]string{}, r.Missing...),
+		Candidate:  candidate,
+		CreatedAt:  r.CreatedAt,
+	}, nil
+}
+
+// WebhookReplayGuard records accepted webhook deliveries in PostgreSQL so every API replica shares them.
+type WebhookReplayGuard struct {
+	db *gorm.DB
+}
+
+// NewWebhookReplayGuard wires a PostgreSQL-backed replay guard.
+func NewWebhookReplayGuard(db *gorm.DB) *WebhookReplayGuard {
+	return &WebhookReplayGuard{db: db}
+}
+
+type webhookDeliveryRecord struct {
+	Provider   string    `gorm:"primaryKey;column:provider;size:64"`
+	DeliveryID string    `gorm:"primaryKey;column:delivery_id;size:255"`
+	ExpiresAt  time.Time `gorm:"column:expires_at;index"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (webhookDeliveryRecord) TableName() string { return "partner_webhook_deliveries" }
+
+// Claim inserts the delivery; an existing unexpired row means the delivery is a replay.
+func (g *WebhookReplayGuard) Claim(ctx context.Context, provider, deliveryID string, expiresAt time.Time) error {
+	if g == nil || g.db == nil {
+		return errors.New("postgres webhook replay guard not configured")
+	}
+	db := g.db.WithContext(ctx)
+	if err := db.Where("expires_at <= ?", time.Now()).Delete(&webhookDeliveryRecord{}).Error; err != nil {
+		return err
+	}
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&webhookDeliveryRecord{Provider: provider, DeliveryID: deliveryID, ExpiresAt: expiresAt})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ports.ErrReplayedDelivery
+	}
+	return nil
+}
+
+// Release deletes the delivery row so a retry of the same delivery can claim it again.
+func (g *WebhookReplayGuard) Release(ctx context.Context, provider, deliveryID string) error {
+	if g == nil || g.db == nil {
+		return errors.New("postgres webhook replay guard not configured")
+	}
+	return g.db.WithContext(ctx).
+		Where("provider = ? AND delivery_id = ?", provider, deliveryID).
+		Delete(&webhookDeliveryRecord{}).Error
+}
@@ -2,10 +2,12 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	"strings"
+	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/lib/pq"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -15,7 +17,13 @@ import (
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
 )
 
-var _ ports.Repository = (*Repository)(nil)
+var (
+	_ ports.Repository         = (*Repository)(nil)
+	_ ports.CatalogCounter     = (*Repository)(nil)
+	_ ports.PageLister         = (*Repository)(nil)
+	_ ports.CategoryReferences = (*Repository)(nil)
+	_ ports.TagReferences      = (*Repository)(nil)
+)
 
 // Repository persists pets in PostgreSQL using GORM-mapped columns.
 type Repository struct {
@@ -29,24 +37,35 @@ func NewRepository(db *gorm.DB) *Repository {
 }
 
 type petRecord struct {
-	ID                 int64             `gorm:"primaryKey;column:id"`
-	CategoryID         *int64            `gorm:"column:category_id"`
-	CategoryName       string            `gorm:"column:category_name"`
-	Name               string            `gorm:"column:name"`
-	PhotoURLs          pq.StringArray    `gorm:"column:photo_urls;type:text[]"`
-	Status             string            `gorm:"column:status;type:varchar(32);index"`
-	HairLengthCm       float64           `gorm:"column:hair_length_cm"`
-	TagIDs             pq.Int64Array     `gorm:"column:tag_ids;type:bigint[]"`
-	TagNames           pq.StringArray    `gorm:"column:tag_names;type:text[]"`
-	ExternalProvider   string            `gorm:"column:external_provider"`
-	ExternalID         string            `gorm:"column:external_id"`
-	ExternalAttributes map[string]string `gorm:"column:external_attributes;type:jsonb;serializer:json"`
-	CreatedAt          time.Time         `gorm:"column:created_at"`
-	UpdatedAt          time.Time         `gorm:"column:updated_at"`
+	ID         int64  `gorm:"primaryKey;column:id"`
+	CategoryID *int64 `gorm:"column:category_id;index"`
+	// CategoryName is the name the pet was saved with; reads prefer CurrentCategoryName, joined
+	// from categories, so renaming a category never rewrites pets.
+	CategoryName        string             `gorm:"column:category_name"`
+	CurrentCategoryName *string            `gorm:"column:current_category_name;->;-:migration"`
+	Name                string             `gorm:"column:name"`
+	PhotoURLs           pq.StringArray     `gorm:"column:photo_urls;type:text[]"`
+	Status              string             `gorm:"column:status;type:varchar(32);index"`
+	HairLengthCm        float64            `gorm:"column:hair_length_cm"`
+	ExternalProvider    string             `gorm:"column:external_provider"`
+	ExternalID          string             `gorm:"column:external_id"`
+	ExternalAttributes  map[string]string  `gorm:"column:external_attributes;type:jsonb;serializer:json"`
+	PartnerReferences   []partnerRefRecord `gorm:"column:partner_references;type:jsonb;serializer:json"`
+	CreatedAt           time.Time          `gorm:"column:created_at"`
+	UpdatedAt           time.Time          `gorm:"column:updated_at"`
+	// Tags are loaded from pet_tags after the pet rows.
+	Tags []domain.Tag `gorm:"-"`
 }
 
 func (petRecord) TableName() string { return "pets" }
 
+// partnerRefRecord is the JSON shape of a secondary provider reference stored in partner_references.
+type partnerRefRecord struct {
+	Provider   string            `json:"provider"`
+	ID         string            `json:"id"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
 func newPetRecord(p *domain.Pet) petRecord {
 	rec := petRecord{
 		ID:           p.ID,
@@ -54,11 +73,11 @@ func newPetRecord(p *domain.Pet) petRecord {
 		Status:       string(p.Status),
 		HairLengthCm: p.HairLengthCm,
 		PhotoURLs:    copyStringArray(p.PhotoURLs),
-		TagIDs:       extractTagIDs(p.Tags),
-		TagNames:     extractTagNames(p.Tags),
 	}
 	if p.Category != nil {
-		rec.CategoryID = cloneInt64Ptr(p.Category.ID)
+		if p.Category.ID != 0 {
+			rec.CategoryID = cloneInt64Ptr(p.Category.ID)
+		}
 		rec.CategoryName = p.Category.Name
 	}
 	if p.ExternalRef != nil {
@@ -71,9 +90,24 @@ func newPetRecord(p *domain.Pet) petRecord {
 			}
 		}
 	}
+	for _, ref := range p.SnapshotPartnerReferences() {
+		rec.PartnerReferences = append(rec.PartnerReferences, partnerRefRecord{Provider: ref.Provider, ID: ref.ID, Attributes: ref.Attributes})
+	}
 	return rec
 }
 
+// externalRefIndex keeps one pet per primary partner reference; see the migrations.
+const externalRefIndex = "idx_pets_external_ref_unique"
+
+// translatePetError maps a duplicate primary partner reference to ErrExternalReferenceTaken.
+func translatePetError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == externalRefIndex {
+		return ports.ErrExternalReferenceTaken
+	}
+	return err
+}
+
 // Save inserts or updates a pet aggregate.
 func (r *Repository) Save(ctx context.Context, pet *domain.Pet) (*pettypes.PetProjection, error) {
 	if err := r.ensureDB(); err != nil {
@@ -83,32 +117,212 @@ func (r *Repository) Save(ctx context.Context, pet *domain.Pet) (*pettypes.PetPr
 		return nil, errors.New("cannot save nil pet")
 	}
 	record := newPetRecord(pet)
-	if err := r.db.WithContext(ctx).
-		Clauses(clause.OnConflict{
-			Columns: []clause.Column{{Name: "id"}},
-			DoUpdates: clause.Assignments(map[string]any{
-				"category_id":         record.CategoryID,
-				"category_name":       record.CategoryName,
-				"name":                record.Name,
-				"photo_urls":          record.PhotoURLs,
-				"status":              record.Status,
-				"hair_length_cm":      record.HairLengthCm,
-				"tag_ids":             record.TagIDs,
-				"tag_names":           record.TagNames,
-				"external_provider":   record.ExternalProvider,
-				"external_id":         record.ExternalID,
-				"external_attributes": record.ExternalAttributes,
-				"updated_at":          gorm.Expr("NOW()"),
-			}),
-		}).Create(&record).Error; err != nil {
-		return nil, err
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if record.ID == 0 {
+			// A plain insert, so an id the sequence hands out twice fails instead of overwriting.
+			if err := tx.Omit("id").Create(&record).Error; err != nil {
+				return err
+			}
+		} else {
+			if err := tx.Clauses(upsertOnID()).Create(&record).Error; err != nil {
+				return err
+			}
+			if err := advanceIDSequence(tx, "pets", record.ID); err != nil {
+				return err
+			}
+		}
+		return linkTags(tx, map[int64][]domain.Tag{record.ID: pet.Tags})
+	})
+	if err != nil {
+		return nil, translatePetError(err)
 	}
-	id := pet.ID
-	if id == 0 {
-		id = record.ID
+	if pet.ID == 0 {
 		pet.ID = record.ID
 	}
-	return r.GetByID(ctx, id)
+	return r.GetByID(ctx, pet.ID)
+}
+
+// SaveMany upserts pets in one transaction using multi-row inserts of saveBatchSize rows.
+// Pets without an identifier are inserted separately so the sequence assigns theirs, after the
+// sequence has been moved past the explicit ids.
+func (r *Repository) SaveMany(ctx context.Context, pets []*domain.Pet) ([]*pettypes.PetProjection, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	if len(pets) == 0 {
+		return nil, nil
+	}
+	var withID, withoutID []petRecord
+	var newPets []*domain.Pet
+	var maxID int64
+	for _, pet := range pets {
+		if pet == nil {
+			return nil, errors.New("cannot save nil pet")
+		}
+		if pet.ID == 0 {
+			withoutID = append(withoutID, newPetRecord(pet))
+			newPets = append(newPets, pet)
+		} else {
+			withID = append(withID, newPetRecord(pet))
+			maxID = max(maxID, pet.ID)
+		}
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(withID) > 0 {
+			if err := tx.Clauses(upsertOnID()).CreateInBatches(&withID, saveBatchSize).Error; err != nil {
+				return err
+			}
+			if err := advanceIDSequence(tx, "pets", maxID); err != nil {
+				return err
+			}
+		}
+		if len(withoutID) > 0 {
+			if err := tx.Omit("id").CreateInBatches(&withoutID, saveBatchSize).Error; err != nil {
+				return err
+			}
+		}
+		tags := make(map[int64][]domain.Tag, len(pets))
+		for _, pet := range pets {
+			if pet.ID != 0 {
+				tags[pet.ID] = pet.Tags
+			}
+		}
+		for i, record := range withoutID {
+			tags[record.ID] = newPets[i].Tags
+		}
+		return linkTags(tx, tags)
+	})
+	if err != nil {
+		return nil, translatePetError(err)
+	}
+	for i, pet := range newPets {
+		pet.ID = withoutID[i].ID
+	}
+	ids := make([]int64, 0, len(pets))
+	for _, pet := range pets {
+		ids = append(ids, pet.ID)
+	}
+	return r.GetMany(ctx, ids)
+}
+
+// UpdateStatus locks the rows with SELECT ... FOR UPDATE and rewrites only status and updated_at,
+// so concurrent edits to other columns survive a batch status change.
+func (r *Repository) UpdateStatus(ctx context.Context, ids []int64, status domain.Status, requireAll bool) (*pettypes.StatusUpdate, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	update := &pettypes.StatusUpdate{Previous: map[int64]domain.Status{}}
+	if len(ids) == 0 {
+		return update, nil
+	}
+	var found []int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var rows []struct {
+			ID     int64
+			Status domain.Status
+		}
+		// Lock in id order so overlapping batches cannot deadlock.
+		if err := tx.Model(&petRecord{}).Select("id, status").Where("id IN ?", ids).
+			Order("id").Clauses(clause.Locking{Strength: "UPDATE"}).Find(&rows).Error; err != nil {
+			return err
+		}
+		current := make(map[int64]domain.Status, len(rows))
+		for _, row := range rows {
+			current[row.ID] = row.Status
+		}
+		var changed []int64
+		for _, id := range ids {
+			previous, ok := current[id]
+			if !ok {
+				update.Missing = append(update.Missing, id)
+				continue
+			}
+			found = append(found, id)
+			if previous != status {
+				changed = append(changed, id)
+			}
+		}
+		if len(changed) == 0 || (requireAll && len(update.Missing) > 0) {
+			return nil
+		}
+		for _, id := range changed {
+			update.Previous[id] = current[id]
+		}
+		result := tx.Model(&petRecord{}).Where("id IN ?", changed).
+			Updates(map[string]any{"status": string(status), "updated_at": gorm.Expr("NOW()")})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(changed)) {
+			return fmt.Errorf("batch status update wrote %d of %d locked pets", result.RowsAffected, len(changed))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	update.Pets, err = r.GetMany(ctx, found)
+	if err != nil {
+		return nil, err
+	}
+	return update, nil
+}
+
+// GetMany loads the pets among ids with one query and returns them in request order.
+func (r *Repository) GetMany(ctx context.Context, ids []int64) ([]*pettypes.PetProjection, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var records []petRecord
+	if err := r.pets(ctx).Where("pets.id IN ?", ids).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	if err := r.loadTags(ctx, records); err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*petRecord, len(records))
+	for i := range records {
+		byID[records[i].ID] = &records[i]
+	}
+	found := make([]*pettypes.PetProjection, 0, len(records))
+	for _, id := range ids {
+		record, ok := byID[id]
+		if !ok {
+			continue
+		}
+		delete(byID, id)
+		projection, err := toProjection(record)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, projection)
+	}
+	return found, nil
+}
+
+// saveBatchSize bounds the rows per INSERT statement, keeping bind parameters well below the protocol limit.
+const saveBatchSize = 200
+
+// upsertOnID replaces every mutable column when the pet id already exists.
+func upsertOnID() clause.OnConflict {
+	return clause.OnConflict{
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: append(clause.AssignmentColumns([]string{
+			"category_id", "category_name", "name", "photo_urls", "status", "hair_length_cm",
+			"external_provider", "external_id", "external_attributes", "partner_references",
+		}), clause.Assignment{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("NOW()")}),
+	}
+}
+
+// advanceIDSequence moves the id sequence of table past id, so rows inserted later without an
+// id do not collide with one the client chose. The sequence never moves backwards.
+func advanceIDSequence(tx *gorm.DB, table string, id int64) error {
+	return tx.Exec(`SELECT setval(pg_get_serial_sequence(?, 'id'),
+		GREATEST(?, COALESCE(pg_sequence_last_value(pg_get_serial_sequence(?, 'id')::regclass), 0)))`,
+		table, id, table).Error
 }
 
 // GetByID fetches a pet by identifier.
@@ -116,14 +330,14 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*pettypes.PetProjec
 	if err := r.ensureDB(); err != nil {
 		return nil, err
 	}
-	var record petRecord
-	if err := r.db.WithContext(ctx).First(&record, "id = ?", id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ports.ErrNotFound
-		}
+	var records []petRecord
+	if err := r.pets(ctx).Where("pets.id = ?", id).Limit(1).Find(&records).Error; err != nil {
 		return nil, err
 	}
-	return toProjection(&record)
+	if len(records) == 0 {
+		return nil, ports.ErrNotFound
+	}
+	return r.first(ctx, records)
 }
 
 // Delete removes a pet by identifier.
@@ -154,33 +368,47 @@ func (r *Repository) FindByStatus(ctx context.Context, statuses []domain.Status)
 		args = append(args, string(s))
 	}
 	var records []petRecord
-	if err := r.db.WithContext(ctx).
-		Where("status IN ?", args).
+	if err := r.pets(ctx).
+		Where("pets.status IN ?", args).
 		Find(&records).Error; err != nil {
 		return nil, err
 	}
-	return recordsToProjections(records)
+	return r.project(ctx, records)
 }
 
-// FindByTags returns pets that contain any of the provided tag names (case insensitive).
-func (r *Repository) FindByTags(ctx context.Context, tags []string) ([]*pettypes.PetProjection, error) {
+// FindByTags resolves the names to tag IDs and filters pets through the (tag_id, pet_id)
+// index of pet_tags; TagMatchAll keeps pets linked to every one of the tags.
+func (r *Repository) FindByTags(ctx context.Context, tags []string, match pettypes.TagMatch) ([]*pettypes.PetProjection, error) {
 	if err := r.ensureDB(); err != nil {
 		return nil, err
 	}
-	if len(tags) == 0 {
+	names := make([]string, 0, len(tags))
+	seen := map[string]struct{}{}
+	for _, tag := range tags {
+		name := domain.NormalizeTagName(tag)
+		if _, dup := seen[name]; name == "" || dup {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
 		return nil, nil
 	}
-	lowered := make([]string, 0, len(tags))
-	for _, tag := range tags {
-		lowered = append(lowered, strings.ToLower(tag))
+	matching := r.db.WithContext(ctx).
+		Table("pet_tags").
+		Select("pet_tags.pet_id").
+		Joins("JOIN tags ON tags.id = pet_tags.tag_id").
+		Where("tags.name = ANY(?)", pq.Array(names)).
+		Group("pet_tags.pet_id")
+	if match == pettypes.TagMatchAll {
+		matching = matching.Having("COUNT(*) = ?", len(names))
 	}
 	var records []petRecord
-	if err := r.db.WithContext(ctx).
-		Where("EXISTS (SELECT 1 FROM unnest(tag_names) AS tag WHERE lower(tag) = ANY(?))", pq.Array(lowered)).
-		Find(&records).Error; err != nil {
+	if err := r.pets(ctx).Where("pets.id IN (?)", matching).Find(&records).Error; err != nil {
 		return nil, err
 	}
-	return recordsToProjections(records)
+	return r.project(ctx, records)
 }
 
 // List returns every persisted pet.
@@ -189,12 +417,257 @@ func (r *Repository) List(ctx context.Context) ([]*pettypes.PetProjection, error
 		return nil, err
 	}
 	var records []petRecord
-	if err := r.db.WithContext(ctx).Find(&records).Error; err != nil {
+	if err := r.pets(ctx).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return r.project(ctx, records)
+}
+
+// ListAfter returns up to limit pets with an ID greater than afterID, ordered by ID.
+func (r *Repository) ListAfter(ctx context.Context, afterID int64, limit int) ([]*pettypes.PetProjection, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	query := r.pets(ctx).Where("pets.id > ?", afterID).Order("pets.id")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var records []petRecord
+	if err := query.Find(&records).Error; err != nil {
+		return nil, err
+	}
+	return r.project(ctx, records)
+}
+
+// FindByExternalReference returns the pet linked to the partner record.
+func (r *Repository) FindByExternalReference(ctx context.Context, provider, externalID string) (*pettypes.PetProjection, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var records []petRecord
+	if err := r.pets(ctx).
+		Where("(pets.external_provider = ? AND pets.external_id = ?) OR pets.partner_references @> ?::jsonb",
+			provider, externalID, partnerReferenceContains(provider, externalID)).
+		Order("pets.id").
+		Limit(1).
+		Find(&records).Error; err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ports.ErrNotFound
+	}
+	return r.first(ctx, records)
+}
+
+// CountByStatusAndCategory aggregates the catalog in a single GROUP BY query.
+func (r *Repository) CountByStatusAndCategory(ctx context.Context) ([]ports.CatalogCount, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var rows []struct {
+		Status       string
+		CategoryName string
+		Count        int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&petRecord{}).
+		Joins("LEFT JOIN categories ON categories.id = pets.category_id").
+		Select("pets.status AS status, COALESCE(categories.name, pets.category_name) AS category_name, COUNT(*) AS count").
+		Group("pets.status, COALESCE(categories.name, pets.category_name)").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	result := make([]ports.CatalogCount, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, ports.CatalogCount{
+			Status:   domain.Status(row.Status),
+			Category: row.CategoryName,
+			Count:    row.Count,
+		})
+	}
+	return result, nil
+}
+
+// CountByCategory counts the pets referencing the category.
+func (r *Repository) CountByCategory(ctx context.Context, categoryID int64) (int64, error) {
+	if err := r.ensureDB(); err != nil {
+		return 0, err
+	}
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&petRecord{}).Where("category_id = ?", categoryID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// TouchByCategory bumps updated_at on the pets filed under the category.
+func (r *Repository) TouchByCategory(ctx context.Context, categoryID int64) error {
+	if err := r.ensureDB(); err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Model(&petRecord{}).
+		Where("category_id = ?", categoryID).
+		Update("updated_at", gorm.Expr("NOW()")).Error
+}
+
+// CountByTag counts the pets carrying each tag from the pet_tags index.
+func (r *Repository) CountByTag(ctx context.Context) (map[int64]int64, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var rows []struct {
+		TagID int64
+		Count int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&petTagRecord{}).
+		Select("tag_id, COUNT(*) AS count").
+		Group("tag_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.TagID] = row.Count
+	}
+	return counts, nil
+}
+
+// ReassignTag moves the pet_tags rows of fromID to toID in one transaction.
+func (r *Repository) ReassignTag(ctx context.Context, fromID, toID int64) error {
+	if err := r.ensureDB(); err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`INSERT INTO pet_tags (pet_id, tag_id, position)
+			SELECT pet_id, ?, position FROM pet_tags WHERE tag_id = ?
+			ON CONFLICT (pet_id, tag_id) DO NOTHING`, toID, fromID).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("tag_id = ?", fromID).Delete(&petTagRecord{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&petRecord{}).
+			Where("id IN (SELECT pet_id FROM pet_tags WHERE tag_id = ?)", toID).
+			Update("updated_at", gorm.Expr("NOW()")).Error
+	})
+}
+
+// TouchByTag bumps updated_at on the pets carrying the tag.
+func (r *Repository) TouchByTag(ctx context.Context, tagID int64) error {
+	if err := r.ensureDB(); err != nil {
+		return err
+	}
+	return r.db.WithContext(ctx).Model(&petRecord{}).
+		Where("id IN (SELECT pet_id FROM pet_tags WHERE tag_id = ?)", tagID).
+		Update("updated_at", gorm.Expr("NOW()")).Error
+}
+
+// linkTags replaces the pet_tags rows of every pet in tags, creating catalog entries for new names.
+func linkTags(tx *gorm.DB, tags map[int64][]domain.Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	petIDs := make([]int64, 0, len(tags))
+	var names []string
+	for petID, petTags := range tags {
+		petIDs = append(petIDs, petID)
+		for _, tag := range petTags {
+			names = append(names, tag.Name)
+		}
+	}
+	if err := tx.Where("pet_id = ANY(?)", pq.Array(petIDs)).Delete(&petTagRecord{}).Error; err != nil {
+		return err
+	}
+	catalog, err := ensureTags(tx, names)
+	if err != nil {
+		return err
+	}
+	ids := make(map[string]int64, len(catalog))
+	for _, tag := range catalog {
+		ids[tag.Name] = tag.ID
+	}
+	var rows []petTagRecord
+	for petID, petTags := range tags {
+		linked := map[int64]struct{}{}
+		for _, tag := range petTags {
+			id, ok := ids[domain.NormalizeTagName(tag.Name)]
+			if _, dup := linked[id]; !ok || dup {
+				continue
+			}
+			linked[id] = struct{}{}
+			rows = append(rows, petTagRecord{PetID: petID, TagID: id, Position: len(linked)})
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return tx.CreateInBatches(&rows, saveBatchSize).Error
+}
+
+// loadTags attaches the catalog tags of every record with one query over pet_tags.
+func (r *Repository) loadTags(ctx context.Context, records []petRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	petIDs := make([]int64, 0, len(records))
+	byID := make(map[int64]*petRecord, len(records))
+	for i := range records {
+		petIDs = append(petIDs, records[i].ID)
+		byID[records[i].ID] = &records[i]
+	}
+	var rows []struct {
+		PetID int64
+		TagID int64
+		Name  string
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&petTagRecord{}).
+		Select("pet_tags.pet_id, pet_tags.tag_id, tags.name").
+		Joins("JOIN tags ON tags.id = pet_tags.tag_id").
+		Where("pet_tags.pet_id = ANY(?)", pq.Array(petIDs)).
+		Order("pet_tags.pet_id, pet_tags.position").
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := byID[row.PetID]
+		record.Tags = append(record.Tags, domain.Tag{ID: row.TagID, Name: row.Name})
+	}
+	return nil
+}
+
+// project loads the tags of records and maps them to projections.
+func (r *Repository) project(ctx context.Context, records []petRecord) ([]*pettypes.PetProjection, error) {
+	if err := r.loadTags(ctx, records); err != nil {
 		return nil, err
 	}
 	return recordsToProjections(records)
 }
 
+// first projects the first of records, which must not be empty.
+func (r *Repository) first(ctx context.Context, records []petRecord) (*pettypes.PetProjection, error) {
+	list, err := r.project(ctx, records[:1])
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// pets selects pet rows together with the current name of their managed category.
+func (r *Repository) pets(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&petRecord{}).
+		Select("pets.*, categories.name AS current_category_name").
+		Joins("LEFT JOIN categories ON categories.id = pets.category_id")
+}
+
+// partnerReferenceContains builds the jsonb containment operand matching a secondary provider reference.
+func partnerReferenceContains(provider, externalID string) string {
+	encoded, _ := json.Marshal([]partnerRefRecord{{Provider: provider, ID: externalID}})
+	return string(encoded)
+}
+
 func recordsToProjections(records []petRecord) ([]*pettypes.PetProjection, error) {
 	list := make([]*pettypes.PetProjection, 0, len(records))
 	for i := range records {
@@ -233,21 +706,13 @@ func (r *petRecord) toDomain() *domain.Pet {
 		if r.CategoryID != nil {
 			cat.ID = *r.CategoryID
 		}
+		if r.CurrentCategoryName != nil {
+			cat.Name = *r.CurrentCategoryName
+		}
 		pet.Category = &cat
 	}
-	if n := max(len(r.TagIDs), len(r.TagNames)); n > 0 {
-		tags := make([]domain.Tag, 0, n)
-		for i := 0; i < n; i++ {
-			var tag domain.Tag
-			if i < len(r.TagIDs) {
-				tag.ID = r.TagIDs[i]
-			}
-			if i < len(r.TagNames) {
-				tag.Name = r.TagNames[i]
-			}
-			tags = append(tags, tag)
-		}
-		pet.Tags = tags
+	if len(r.Tags) > 0 {
+		pet.Tags = append([]domain.Tag{}, r.Tags...)
 	}
 	if r.ExternalProvider != "" || r.ExternalID != "" || len(r.ExternalAttributes) > 0 {
 		reference := domain.ExternalReference{
@@ -262,6 +727,9 @@ func (r *petRecord) toDomain() *domain.Pet {
 		}
 		pet.ExternalRef = &reference
 	}
+	for _, ref := range r.PartnerReferences {
+		pet.PartnerRefs = append(pet.PartnerRefs, domain.ExternalReference{Provider: ref.Provider, ID: ref.ID, Attributes: ref.Attributes})
+	}
 	return clonePet(pet)
 }
 
@@ -290,6 +758,7 @@ func clonePet(p *domain.Pet) *domain.Pet {
 		}
 		clone.ExternalRef = &ref
 	}
+	clone.PartnerRefs = p.SnapshotPartnerReferences()
 	return &clone
 }
 
@@ -300,31 +769,6 @@ func (r *Repository) ensureDB() error {
 	return nil
 }
 
-func extractTagNames(tags []domain.Tag) pq.StringArray {
-	if len(tags) == 0 {
-		return nil
-	}
-	arr := make(pq.StringArray, 0, len(tags))
-	for _, tag := range tags {
-		if tag.Name == "" {
-			continue
-		}
-		arr = append(arr, tag.Name)
-	}
-	return arr
-}
-
-func extractTagIDs(tags []domain.Tag) pq.Int64Array {
-	if len(tags) == 0 {
-		return nil
-	}
-	arr := make(pq.Int64Array, 0, len(tags))
-	for _, tag := range tags {
-		arr = append(arr, tag.ID)
-	}
-	return arr
-}
-
 func copyStringArray(values []string) pq.StringArray {
 	if len(values) == 0 {
 		return nil
@@ -337,10 +781,3 @@ func cloneInt64Ptr(v int64) *int64 {
 	value := v
 	return &value
 }
-
-func max(a, b int) int {
-	if a > b {
-		return a
-	}
-	return b
-}
@@ -23,6 +23,7 @@ import (
 	"gorm.io/gorm"
 
 	petspostgres "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/persistence/postgres"
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
 	"github.com/Apurer/go-gin-api-server/internal/platform/migrations"
@@ -75,6 +76,8 @@ func TestPostgresRepository_SaveAndGetByID(t *testing.T) {
 
 	repo := petspostgres.NewRepository(db)
 	ctx := context.Background()
+	_, err := petspostgres.NewCategoryRepository(db).Save(ctx, &domain.Category{ID: 1, Name: "Dogs"})
+	require.NoError(t, err)
 
 	// Create a pet
 	pet, err := domain.NewPet(1, "Buddy", []string{"http://example.com/buddy.jpg", "http://example.com/buddy2.jpg"})
@@ -113,6 +116,75 @@ func TestPostgresRepository_SaveAndGetByID(t *testing.T) {
 	assert.Equal(t, "brown", retrieved.Pet.ExternalRef.Attributes["color"])
 }
 
+func TestPostgresRepository_ExplicitIDsAdvanceTheSequence(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db, cleanup := setupPostgresContainer(t)
+	defer cleanup()
+
+	repo := petspostgres.NewRepository(db)
+	ctx := context.Background()
+
+	imported, err := domain.NewPet(500, "Imported", []string{"http://example.com/imported.jpg"})
+	require.NoError(t, err)
+	_, err = repo.SaveMany(ctx, []*domain.Pet{imported})
+	require.NoError(t, err)
+
+	fresh, err := domain.NewPet(0, "Fresh", []string{"http://example.com/fresh.jpg"})
+	require.NoError(t, err)
+	created, err := repo.Save(ctx, fresh)
+	require.NoError(t, err)
+	assert.Greater(t, created.Pet.ID, int64(500), "the sequence moves past imported ids")
+
+	kept, err := repo.GetByID(ctx, 500)
+	require.NoError(t, err)
+	assert.Equal(t, "Imported", kept.Pet.Name)
+}
+
+func TestPostgresRepository_UpdateStatusWritesOnlyStatus(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db, cleanup := setupPostgresContainer(t)
+	defer cleanup()
+
+	repo := petspostgres.NewRepository(db)
+	ctx := context.Background()
+
+	for _, id := range []int64{1, 2} {
+		pet, err := domain.NewPet(id, "Pet", []string{"http://example.com/pet.jpg"})
+		require.NoError(t, err)
+		_, err = repo.Save(ctx, pet)
+		require.NoError(t, err)
+	}
+	// A concurrent edit lands between the caller reading the pets and the batch write.
+	renamed, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	require.NoError(t, renamed.Pet.Rename("Renamed"))
+	_, err = repo.Save(ctx, renamed.Pet)
+	require.NoError(t, err)
+
+	update, err := repo.UpdateStatus(ctx, []int64{2, 1, 99}, domain.StatusSold, false)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{99}, update.Missing)
+	require.Len(t, update.Pets, 2)
+	assert.Equal(t, int64(2), update.Pets[0].Pet.ID)
+	assert.Equal(t, "Renamed", update.Pets[1].Pet.Name, "the batch must not overwrite other columns")
+	assert.Equal(t, domain.StatusSold, update.Pets[1].Pet.Status)
+	assert.Equal(t, domain.StatusAvailable, update.Previous[1])
+
+	update, err = repo.UpdateStatus(ctx, []int64{1, 99}, domain.StatusPending, true)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{99}, update.Missing)
+	assert.Empty(t, update.Previous)
+	kept, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusSold, kept.Pet.Status, "an unknown id rejects the whole batch")
+}
+
 func TestPostgresRepository_FindByStatus(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test")
@@ -180,10 +252,28 @@ func TestPostgresRepository_FindByTags(t *testing.T) {
 	require.NoError(t, err)
 
 	// Find by tag
-	result, err := repo.FindByTags(ctx, []string{"FRIENDLY"})
+	result, err := repo.FindByTags(ctx, []string{"FRIENDLY"}, pettypes.TagMatchAny)
 	require.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "Friendly Dog", result[0].Pet.Name)
+	assert.Equal(t, []string{"friendly", "trained"}, []string{result[0].Pet.Tags[0].Name, result[0].Pet.Tags[1].Name})
+
+	anyOf, err := repo.FindByTags(ctx, []string{"trained", "lazy"}, pettypes.TagMatchAny)
+	require.NoError(t, err)
+	assert.Len(t, anyOf, 2)
+
+	allOf, err := repo.FindByTags(ctx, []string{"trained", "lazy"}, pettypes.TagMatchAll)
+	require.NoError(t, err)
+	assert.Empty(t, allOf)
+
+	allOf, err = repo.FindByTags(ctx, []string{"friendly", "TRAINED"}, pettypes.TagMatchAll)
+	require.NoError(t, err)
+	assert.Len(t, allOf, 1)
+
+	tags := petspostgres.NewTagRepository(db)
+	friendly, err := tags.FindByName(ctx, "friendly")
+	require.NoError(t, err)
+	require.ErrorIs(t, tags.Delete(ctx, friendly.ID), ports.ErrTagInUse, "pets still carry the tag")
 }
 
 func TestPostgresRepository_Delete(t *testing.T) {
@@ -275,3 +365,50 @@ func TestPostgresRepository_Update(t *testing.T) {
 	assert.Equal(t, originalCreatedAt.Unix(), updated.Metadata.CreatedAt.Unix())
 	assert.True(t, updated.Metadata.UpdatedAt.After(originalCreatedAt))
 }
+
+func TestPostgresCategoryRepository_ReferentialIntegrity(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	db, cleanup := setupPostgresContainer(t)
+	defer cleanup()
+
+	repo := petspostgres.NewRepository(db)
+	categories := petspostgres.NewCategoryRepository(db)
+	ctx := context.Background()
+
+	dogs, err := categories.Save(ctx, &domain.Category{Name: "Dogs"})
+	require.NoError(t, err)
+	_, err = categories.Save(ctx, &domain.Category{Name: "dogs"})
+	require.ErrorIs(t, err, ports.ErrCategoryNameTaken)
+	missingParent := int64(999)
+	_, err = categories.Save(ctx, &domain.Category{Name: "Puppies", ParentID: &missingParent})
+	require.ErrorIs(t, err, ports.ErrCategoryNotFound)
+
+	_, err = categories.Create(ctx, &domain.Category{ID: 50, Name: "Cats"})
+	require.NoError(t, err)
+	_, err = categories.Create(ctx, &domain.Category{ID: 50, Name: "Birds"})
+	require.ErrorIs(t, err, ports.ErrCategoryIDTaken)
+	birds, err := categories.Create(ctx, &domain.Category{Name: "Birds"})
+	require.NoError(t, err)
+	assert.Greater(t, birds.ID, int64(50), "the id sequence moves past explicit IDs")
+
+	pet, err := domain.NewPet(1, "Buddy", []string{"http://example.com/buddy.jpg"})
+	require.NoError(t, err)
+	pet.UpdateCategory(&domain.Category{ID: dogs.ID, Name: dogs.Name})
+	_, err = repo.Save(ctx, pet)
+	require.NoError(t, err)
+
+	dogs.Name = "Hounds"
+	_, err = categories.Save(ctx, dogs)
+	require.NoError(t, err)
+	retrieved, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Hounds", retrieved.Pet.Category.Name, "reads join the current category name")
+
+	count, err := repo.CountByCategory(ctx, dogs.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	require.ErrorIs(t, categories.Delete(ctx, dogs.ID), ports.ErrCategoryInUse)
+}
@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var _ ports.TagRepository = (*TagRepository)(nil)
+
+// TagRepository persists the tag catalog. Pets link to it through the pet_tags join table.
+type TagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository wires a PostgreSQL-backed tag catalog.
+func NewTagRepository(db *gorm.DB) *TagRepository {
+	return &TagRepository{db: db}
+}
+
+type tagRecord struct {
+	ID        int64     `gorm:"primaryKey;column:id"`
+	Name      string    `gorm:"column:name"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (tagRecord) TableName() string { return "tags" }
+
+// petTagRecord links a pet to a catalog tag; Position keeps the order the tags were given in.
+type petTagRecord struct {
+	PetID    int64 `gorm:"primaryKey;column:pet_id"`
+	TagID    int64 `gorm:"primaryKey;column:tag_id"`
+	Position int   `gorm:"column:position"`
+}
+
+func (petTagRecord) TableName() string { return "pet_tags" }
+
+// Save inserts or renames a tag.
+func (r *TagRepository) Save(ctx context.Context, tag *domain.Tag) (*domain.Tag, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return nil, errors.New("cannot save nil tag")
+	}
+	record := tagRecord{ID: tag.ID, Name: domain.NormalizeTagName(tag.Name)}
+	query := r.db.WithContext(ctx)
+	if record.ID == 0 {
+		query = query.Omit("id")
+	} else {
+		query = query.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "id"}},
+			DoUpdates: append(clause.AssignmentColumns([]string{"name"}),
+				clause.Assignment{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("NOW()")}),
+		})
+	}
+	if err := query.Create(&record).Error; err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nil, ports.ErrTagNameTaken
+		}
+		return nil, err
+	}
+	tag.ID = record.ID
+	return &domain.Tag{ID: record.ID, Name: record.Name}, nil
+}
+
+// GetByID fetches a tag by identifier.
+func (r *TagRepository) GetByID(ctx context.Context, id int64) (*domain.Tag, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// FindByName matches the normalized name.
+func (r *TagRepository) FindByName(ctx context.Context, name string) (*domain.Tag, error) {
+	return r.first(ctx, "name = ?", domain.NormalizeTagName(name))
+}
+
+// List returns every tag ordered by ID.
+func (r *TagRepository) List(ctx context.Context) ([]*domain.Tag, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var records []tagRecord
+	if err := r.db.WithContext(ctx).Order("id").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	list := make([]*domain.Tag, 0, len(records))
+	for _, record := range records {
+		list = append(list, &domain.Tag{ID: record.ID, Name: record.Name})
+	}
+	return list, nil
+}
+
+// Delete removes a tag; the pet_tags foreign key rejects it while pets still carry it.
+func (r *TagRepository) Delete(ctx context.Context, id int64) error {
+	if err := r.ensureDB(); err != nil {
+		return err
+	}
+	result := r.db.WithContext(ctx).Delete(&tagRecord{}, id)
+	if result.Error != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(result.Error, &pgErr) && pgErr.Code == foreignKeyViolation {
+			return ports.ErrTagInUse
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ports.ErrTagNotFound
+	}
+	return nil
+}
+
+// EnsureNames returns the catalog tags for names, creating the missing ones.
+func (r *TagRepository) EnsureNames(ctx context.Context, names []string) ([]domain.Tag, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	return ensureTags(r.db.WithContext(ctx), names)
+}
+
+func (r *TagRepository) first(ctx context.Context, query string, args ...any) (*domain.Tag, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var record tagRecord
+	if err := r.db.WithContext(ctx).Where(query, args...).First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ports.ErrTagNotFound
+		}
+		return nil, err
+	}
+	return &domain.Tag{ID: record.ID, Name: record.Name}, nil
+}
+
+func (r *TagRepository) ensureDB() error {
+	if r == nil || r.db == nil {
+		return errors.New("postgres tag repository not configured")
+	}
+	return nil
+}
+
+// ensureTags inserts the names missing from the catalog and returns the tags in the order
+// of names, skipping blanks and repeats. It runs on tx so pet saves link tags atomically.
+func ensureTags(tx *gorm.DB, names []string) ([]domain.Tag, error) {
+	normalized := make([]string, 0, len(names))
+	seen := map[string]struct{}{}
+	for _, name := range names {
+		name = domain.NormalizeTagName(name)
+		if _, dup := seen[name]; name == "" || dup {
+			continue
+		}
+		seen[name] = struct{}{}
+		normalized = append(normalized, name)
+	}
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+	if err := tx.Exec(
+		"INSERT INTO tags (name, created_at, updated_at) SELECT unnest(?::text[]), NOW(), NOW() ON CONFLICT (name) DO NOTHING",
+		pq.Array(normalized),
+	).Error; err != nil {
+		return nil, err
+	}
+	var records []tagRecord
+	if err := tx.Where("name = ANY(?)", pq.Array(normalized)).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	ids := make(map[string]int64, len(records))
+	for _, record := range records {
+		ids[record.Name] = record.ID
+	}
+	tags := make([]domain.Tag, 0, len(normalized))
+	for _, name := range normalized {
+		tags = append(tags, domain.Tag{ID: ids[name], Name: name})
+	}
+	return tags, nil
+}
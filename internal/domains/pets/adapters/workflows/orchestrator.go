@@ -15,6 +15,8 @@ import (
 
 	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/correlation"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/failures"
 	petworkflows "github.com/Apurer/go-gin-api-server/internal/platform/temporal/workflows/pets"
 )
 
@@ -44,6 +46,7 @@ func (o *TemporalPetWorkflows) CreatePet(ctx context.Context, input petstypes.Ad
 	options := client.StartWorkflowOptions{
 		ID:        workflowID,
 		TaskQueue: o.taskQueue,
+		Memo:      correlation.Memo(ctx),
 	}
 	run, err := o.client.ExecuteWorkflow(
 		ctx,
@@ -57,7 +60,7 @@ func (o *TemporalPetWorkflows) CreatePet(ctx context.Context, input petstypes.Ad
 			existingRun := o.client.GetWorkflow(ctx, workflowID, alreadyStarted.RunId)
 			var projection petstypes.PetProjection
 			if err := existingRun.Get(ctx, &projection); err != nil {
-				return nil, err
+				return nil, failures.ToError(err)
 			}
 			return &projection, nil
 		}
@@ -65,7 +68,7 @@ func (o *TemporalPetWorkflows) CreatePet(ctx context.Context, input petstypes.Ad
 	}
 	var projection petstypes.PetProjection
 	if err := run.Get(ctx, &projection); err != nil {
-		return nil, err
+		return nil, failures.ToError(err)
 	}
 	return &projection, nil
 }
@@ -0,0 +1,126 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/correlation"
+	petworkflows "github.com/Apurer/go-gin-api-server/internal/platform/temporal/workflows/pets"
+)
+
+var (
+	_ ports.GroomingReminderScheduler = (*TemporalGroomingReminders)(nil)
+	_ ports.GroomingReminderScheduler = (*InlineGroomingReminders)(nil)
+)
+
+// TemporalGroomingReminders runs one reminder workflow per appointment, so reminders survive
+// restarts of the API and are sent by the worker.
+type TemporalGroomingReminders struct {
+	client    client.Client
+	taskQueue string
+}
+
+// NewTemporalGroomingReminders wires a Temporal client into the reminder scheduler.
+func NewTemporalGroomingReminders(c client.Client) *TemporalGroomingReminders {
+	return &TemporalGroomingReminders{client: c, taskQueue: petworkflows.PetCreationTaskQueue}
+}
+
+// ScheduleReminder starts the appointment's reminder workflow, or signals the running one to
+// wait for the new time instead.
+func (o *TemporalGroomingReminders) ScheduleReminder(ctx context.Context, appointmentID int64, remindAt time.Time) error {
+	if o == nil || o.client == nil {
+		return errors.New("temporal grooming reminders not configured")
+	}
+	options := client.StartWorkflowOptions{
+		ID:        groomingReminderWorkflowID(appointmentID),
+		TaskQueue: o.taskQueue,
+		Memo:      correlation.Memo(ctx),
+	}
+	_, err := o.client.SignalWithStartWorkflow(ctx, options.ID, petworkflows.GroomingReminderRescheduleSignal, remindAt,
+		options, petworkflows.GroomingReminderWorkflow,
+		petworkflows.GroomingReminderWorkflowInput{AppointmentID: appointmentID, RemindAt: remindAt},
+	)
+	return err
+}
+
+// CancelReminder cancels the appointment's reminder workflow if it is still waiting.
+func (o *TemporalGroomingReminders) CancelReminder(ctx context.Context, appointmentID int64) error {
+	if o == nil || o.client == nil {
+		return errors.New("temporal grooming reminders not configured")
+	}
+	err := o.client.CancelWorkflow(ctx, groomingReminderWorkflowID(appointmentID), "")
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		// The reminder was already sent, or none was ever scheduled.
+		return nil
+	}
+	return err
+}
+
+// InlineGroomingReminders keeps reminder timers in process when Temporal is disabled. Timers are
+// not durable: reminders pending when the process stops are lost.
+type InlineGroomingReminders struct {
+	sender ports.GroomingReminderSender
+	logger *slog.Logger
+	mu     sync.Mutex
+	timers map[int64]*time.Timer
+}
+
+// NewInlineGroomingReminders sends due reminders through sender, logging failed deliveries.
+func NewInlineGroomingReminders(sender ports.GroomingReminderSender, logger *slog.Logger) *InlineGroomingReminders {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &InlineGroomingReminders{sender: sender, logger: logger, timers: map[int64]*time.Timer{}}
+}
+
+// ScheduleReminder replaces the appointment's pending timer; a due time in the past fires at once.
+func (o *InlineGroomingReminders) ScheduleReminder(_ context.Context, appointmentID int64, remindAt time.Time) error {
+	if o == nil || o.sender == nil {
+		return errors.New("inline grooming reminders not configured")
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if timer, ok := o.timers[appointmentID]; ok {
+		timer.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(time.Until(remindAt), func() {
+		o.mu.Lock()
+		if o.timers[appointmentID] == timer {
+			delete(o.timers, appointmentID)
+		}
+		o.mu.Unlock()
+		if err := o.sender.SendGroomingReminder(context.Background(), appointmentID); err != nil {
+			o.logger.Error("grooming reminder failed", slog.Int64("appointment.id", appointmentID), slog.String("error", err.Error()))
+		}
+	})
+	o.timers[appointmentID] = timer
+	return nil
+}
+
+// CancelReminder stops the appointment's pending timer.
+func (o *InlineGroomingReminders) CancelReminder(_ context.Context, appointmentID int64) error {
+	if o == nil {
+		return errors.New("inline grooming reminders not configured")
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if timer, ok := o.timers[appointmentID]; ok {
+		timer.Stop()
+		delete(o.timers, appointmentID)
+	}
+	return nil
+}
+
+func groomingReminderWorkflowID(appointmentID int64) string {
+	return fmt.Sprintf("grooming-reminder-%d", appointmentID)
+}
@@ -0,0 +1,302 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	types "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// DefaultReminderLead is how long before an appointment its reminder goes out.
+const DefaultReminderLead = 24 * time.Hour
+
+// AppointmentService schedules grooming appointments on groomer calendars and grooms the pet
+// when an appointment is completed.
+type AppointmentService struct {
+	repo         ports.AppointmentRepository
+	pets         ports.Service
+	reminders    ports.GroomingReminderScheduler
+	reminderLead time.Duration
+	now          func() time.Time
+}
+
+// AppointmentOption customizes the appointment service wiring.
+type AppointmentOption func(*AppointmentService)
+
+// WithReminderScheduler arranges a reminder ahead of every scheduled appointment. Without it no
+// reminders are sent.
+func WithReminderScheduler(scheduler ports.GroomingReminderScheduler) AppointmentOption {
+	return func(s *AppointmentService) {
+		s.reminders = scheduler
+	}
+}
+
+// WithReminderLead changes how long before the appointment the reminder is due.
+func WithReminderLead(lead time.Duration) AppointmentOption {
+	return func(s *AppointmentService) {
+		if lead > 0 {
+			s.reminderLead = lead
+		}
+	}
+}
+
+// WithClock replaces the wall clock, e.g. to pin "now" in tests.
+func WithClock(now func() time.Time) AppointmentOption {
+	return func(s *AppointmentService) {
+		if now != nil {
+			s.now = now
+		}
+	}
+}
+
+// NewAppointmentService wires appointment scheduling. pets is the pets use case service, so
+// completing an appointment grooms the pet exactly like the groom endpoint does.
+func NewAppointmentService(repo ports.AppointmentRepository, pets ports.Service, opts ...AppointmentOption) *AppointmentService {
+	s := &AppointmentService{repo: repo, pets: pets, reminderLead: DefaultReminderLead, now: time.Now}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	return s
+}
+
+// ScheduleAppointment books the groomer for the pet when the slot is free and arranges the
+// reminder. If the reminder cannot be arranged the booking is cancelled again, so a failed call
+// never leaves a scheduled appointment behind.
+func (s *AppointmentService) ScheduleAppointment(ctx context.Context, input types.ScheduleAppointmentInput) (*domain.Appointment, error) {
+	slot, err := s.futureSlot(input.Start, input.End)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	appointment, err := domain.NewAppointment(0, input.PetID, input.Groomer, slot, input.RequestedTrimCm)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if _, err := s.pets.GetByID(ctx, types.PetIdentifier{ID: input.PetID}); err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrUnknownPet, input.PetID)
+		}
+		return nil, err
+	}
+	saved, err := s.repo.Save(ctx, appointment)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := s.scheduleReminder(ctx, saved); err != nil {
+		cancelled := *saved
+		if cancelErr := cancelled.Cancel(); cancelErr != nil {
+			return nil, errors.Join(err, cancelErr)
+		}
+		if _, saveErr := s.repo.Save(context.WithoutCancel(ctx), &cancelled); saveErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("release appointment %d: %w", saved.ID, saveErr))
+		}
+		return nil, err
+	}
+	return saved, nil
+}
+
+// RescheduleAppointment moves a scheduled appointment to another free slot with the same
+// groomer and moves its reminder along. If the reminder cannot be moved the appointment goes
+// back to its previous slot, where the existing reminder still applies.
+func (s *AppointmentService) RescheduleAppointment(ctx context.Context, input types.RescheduleAppointmentInput) (*domain.Appointment, error) {
+	appointment, err := s.repo.GetByID(ctx, input.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	slot, err := s.futureSlot(input.Start, input.End)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	previous := *appointment
+	if err := appointment.Reschedule(slot); err != nil {
+		return nil, mapError(err)
+	}
+	saved, err := s.repo.Save(ctx, appointment)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := s.scheduleReminder(ctx, saved); err != nil {
+		if _, saveErr := s.repo.Save(context.WithoutCancel(ctx), &previous); saveErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("restore appointment %d: %w", saved.ID, mapError(saveErr)))
+		}
+		return nil, err
+	}
+	return saved, nil
+}
+
+// CancelAppointment frees the groomer's slot and drops the pending reminder.
+func (s *AppointmentService) CancelAppointment(ctx context.Context, id int64) (*domain.Appointment, error) {
+	appointment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := appointment.Cancel(); err != nil {
+		return nil, mapError(err)
+	}
+	saved, err := s.repo.Save(ctx, appointment)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := s.cancelReminder(ctx, id); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// CompleteAppointment grooms the pet with the measured hair length, records the measurements
+// in the pet's grooming history, and closes the appointment. Each step can be repeated with the
+// same input, so a retry after a partial failure completes the appointment once.
+func (s *AppointmentService) CompleteAppointment(ctx context.Context, input types.CompleteAppointmentInput) (*domain.Appointment, error) {
+	appointment, err := s.repo.GetByID(ctx, input.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if appointment.Status != domain.AppointmentScheduled {
+		return nil, fmt.Errorf("%w: appointment %d is %s", domain.ErrAppointmentClosed, appointment.ID, appointment.Status)
+	}
+	trim := appointment.RequestedTrimCm
+	if input.TrimByCm != nil {
+		trim = *input.TrimByCm
+	}
+	groomed, err := s.pets.GroomPet(ctx, types.GroomPetInput{
+		ID:                  appointment.PetID,
+		InitialHairLengthCm: input.InitialHairLengthCm,
+		TrimByCm:            trim,
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	record := domain.GroomingRecord{
+		AppointmentID:   appointment.ID,
+		PetID:           appointment.PetID,
+		Groomer:         appointment.Groomer,
+		InitialLengthCm: input.InitialHairLengthCm,
+		TrimByCm:        trim,
+		ResultLengthCm:  groomed.Pet.HairLengthCm,
+		GroomedAt:       now.UTC(),
+	}
+	if err := s.repo.RecordGrooming(ctx, record); err != nil {
+		return nil, mapError(err)
+	}
+	if err := appointment.Complete(now); err != nil {
+		return nil, mapError(err)
+	}
+	saved, err := s.repo.Save(ctx, appointment)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := s.cancelReminder(ctx, appointment.ID); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// GetAppointment loads a single appointment.
+func (s *AppointmentService) GetAppointment(ctx context.Context, id int64) (*domain.Appointment, error) {
+	appointment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return appointment, nil
+}
+
+// ListAppointments returns the appointments matching filter ordered by start time.
+func (s *AppointmentService) ListAppointments(ctx context.Context, filter types.AppointmentFilter) ([]*domain.Appointment, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.To.After(filter.From) {
+		return nil, mapError(fmt.Errorf("%w: the listing window must end after it starts", domain.ErrInvalidTimeSlot))
+	}
+	appointments, err := s.repo.List(ctx, filter)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return appointments, nil
+}
+
+// GroomingHistory returns the measurements of every completed appointment of a pet.
+func (s *AppointmentService) GroomingHistory(ctx context.Context, petID int64) ([]domain.GroomingRecord, error) {
+	if _, err := s.pets.GetByID(ctx, types.PetIdentifier{ID: petID}); err != nil {
+		return nil, err
+	}
+	history, err := s.repo.GroomingHistory(ctx, petID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return history, nil
+}
+
+// futureSlot validates the slot and rejects slots that have already started.
+func (s *AppointmentService) futureSlot(start, end time.Time) (domain.TimeSlot, error) {
+	slot, err := domain.NewTimeSlot(start, end)
+	if err != nil {
+		return domain.TimeSlot{}, err
+	}
+	if !slot.Start.After(s.now()) {
+		return domain.TimeSlot{}, fmt.Errorf("%w: the slot must start in the future", domain.ErrInvalidTimeSlot)
+	}
+	return slot, nil
+}
+
+func (s *AppointmentService) scheduleReminder(ctx context.Context, appointment *domain.Appointment) error {
+	if s.reminders == nil {
+		return nil
+	}
+	remindAt := appointment.Slot.Start.Add(-s.reminderLead)
+	if err := s.reminders.ScheduleReminder(ctx, appointment.ID, remindAt); err != nil {
+		return fmt.Errorf("schedule reminder for appointment %d: %w", appointment.ID, err)
+	}
+	return nil
+}
+
+func (s *AppointmentService) cancelReminder(ctx context.Context, id int64) error {
+	if s.reminders == nil {
+		return nil
+	}
+	if err := s.reminders.CancelReminder(ctx, id); err != nil {
+		return fmt.Errorf("cancel reminder for appointment %d: %w", id, err)
+	}
+	return nil
+}
+
+// GroomingReminders sends due appointment reminders. Reminder schedulers call it when their
+// timer fires, so it skips appointments that were cancelled, completed, or already reminded.
+type GroomingReminders struct {
+	repo     ports.AppointmentRepository
+	notifier ports.GroomingReminderNotifier
+	now      func() time.Time
+}
+
+// NewGroomingReminders wires reminder delivery.
+func NewGroomingReminders(repo ports.AppointmentRepository, notifier ports.GroomingReminderNotifier) *GroomingReminders {
+	return &GroomingReminders{repo: repo, notifier: notifier, now: time.Now}
+}
+
+// SendGroomingReminder notifies about the appointment once per slot. Appointments that no longer
+// exist, e.g. because the pet was deleted, are skipped.
+func (r *GroomingReminders) SendGroomingReminder(ctx context.Context, appointmentID int64) error {
+	appointment, err := r.repo.GetByID(ctx, appointmentID)
+	if errors.Is(err, ports.ErrAppointmentNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if appointment.Status != domain.AppointmentScheduled || appointment.RemindedAt != nil {
+		return nil
+	}
+	if err := r.notifier.NotifyGroomingReminder(ctx, appointment); err != nil {
+		return err
+	}
+	// Only the reminded flag is written, so a reschedule racing with the reminder is kept.
+	return r.repo.MarkReminded(ctx, appointment.ID, appointment.Slot.Start, r.now())
+}
+
+var (
+	_ ports.AppointmentService     = (*AppointmentService)(nil)
+	_ ports.GroomingReminderSender = (*GroomingReminders)(nil)
+)
@@ -0,0 +1,256 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	petmemory "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+var appointmentNow = time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+
+type fakeReminderScheduler struct {
+	scheduled map[int64]time.Time
+	cancelled []int64
+	err       error
+}
+
+func (f *fakeReminderScheduler) ScheduleReminder(_ context.Context, id int64, remindAt time.Time) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.scheduled[id] = remindAt
+	return nil
+}
+
+func (f *fakeReminderScheduler) CancelReminder(_ context.Context, id int64) error {
+	delete(f.scheduled, id)
+	f.cancelled = append(f.cancelled, id)
+	return nil
+}
+
+type recordingNotifier struct {
+	notified []int64
+}
+
+func (n *recordingNotifier) NotifyGroomingReminder(_ context.Context, appointment *domain.Appointment) error {
+	n.notified = append(n.notified, appointment.ID)
+	return nil
+}
+
+type appointmentFixture struct {
+	svc       *AppointmentService
+	pets      *Service
+	repo      *petmemory.AppointmentRepository
+	reminders *fakeReminderScheduler
+}
+
+func newAppointmentFixture(t *testing.T, petIDs ...int64) appointmentFixture {
+	t.Helper()
+	pets := NewService(petmemory.NewRepository())
+	for _, id := range petIDs {
+		name := "Pet"
+		photos := []string{"http://example.com/pet.jpg"}
+		_, err := pets.AddPet(context.Background(), pettypes.AddPetInput{PetMutationInput: pettypes.PetMutationInput{
+			ID: id, Name: &name, PhotoURLs: &photos,
+		}})
+		require.NoError(t, err)
+	}
+	repo := petmemory.NewAppointmentRepository()
+	reminders := &fakeReminderScheduler{scheduled: map[int64]time.Time{}}
+	svc := NewAppointmentService(repo, pets,
+		WithReminderScheduler(reminders),
+		WithReminderLead(2*time.Hour),
+		WithClock(func() time.Time { return appointmentNow }),
+	)
+	return appointmentFixture{svc: svc, pets: pets, repo: repo, reminders: reminders}
+}
+
+func scheduleInput(petID int64, groomer string, startHour, endHour int) pettypes.ScheduleAppointmentInput {
+	day := appointmentNow.Add(24 * time.Hour).Truncate(24 * time.Hour)
+	return pettypes.ScheduleAppointmentInput{
+		PetID:           petID,
+		Groomer:         groomer,
+		Start:           day.Add(time.Duration(startHour) * time.Hour),
+		End:             day.Add(time.Duration(endHour) * time.Hour),
+		RequestedTrimCm: 1,
+	}
+}
+
+func TestAppointmentService_DetectsGroomerConflicts(t *testing.T) {
+	ctx := context.Background()
+	f := newAppointmentFixture(t, 1, 2)
+
+	booked, err := f.svc.ScheduleAppointment(ctx, scheduleInput(1, "alex", 9, 11))
+	require.NoError(t, err)
+	require.Equal(t, domain.AppointmentScheduled, booked.Status)
+	require.Equal(t, booked.Slot.Start.Add(-2*time.Hour), f.reminders.scheduled[booked.ID], "the reminder is due the lead time before the slot")
+
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(2, "alex", 10, 12))
+	require.ErrorIs(t, err, ports.ErrGroomerUnavailable)
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(2, "sam", 10, 12))
+	require.NoError(t, err, "another groomer is free at the same time")
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(2, "alex", 11, 12))
+	require.NoError(t, err, "back-to-back slots do not overlap")
+
+	_, err = f.svc.CancelAppointment(ctx, booked.ID)
+	require.NoError(t, err)
+	require.NotContains(t, f.reminders.scheduled, booked.ID)
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(2, "alex", 9, 11))
+	require.NoError(t, err, "cancelling frees the slot")
+	_, err = f.svc.CancelAppointment(ctx, booked.ID)
+	require.ErrorIs(t, err, domain.ErrAppointmentClosed)
+}
+
+func TestAppointmentService_Reschedule(t *testing.T) {
+	ctx := context.Background()
+	f := newAppointmentFixture(t, 1, 2)
+	first, err := f.svc.ScheduleAppointment(ctx, scheduleInput(1, "alex", 9, 10))
+	require.NoError(t, err)
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(2, "alex", 13, 14))
+	require.NoError(t, err)
+
+	conflict := scheduleInput(1, "alex", 13, 15)
+	_, err = f.svc.RescheduleAppointment(ctx, pettypes.RescheduleAppointmentInput{ID: first.ID, Start: conflict.Start, End: conflict.End})
+	require.ErrorIs(t, err, ports.ErrGroomerUnavailable)
+
+	later := scheduleInput(1, "alex", 15, 16)
+	moved, err := f.svc.RescheduleAppointment(ctx, pettypes.RescheduleAppointmentInput{ID: first.ID, Start: later.Start, End: later.End})
+	require.NoError(t, err)
+	require.Equal(t, later.Start, moved.Slot.Start)
+	require.Equal(t, later.Start.Add(-2*time.Hour), f.reminders.scheduled[first.ID], "the reminder moves with the slot")
+
+	_, err = f.svc.RescheduleAppointment(ctx, pettypes.RescheduleAppointmentInput{ID: 99, Start: later.Start, End: later.End})
+	require.ErrorIs(t, err, ports.ErrAppointmentNotFound)
+}
+
+func TestAppointmentService_UndoesBookingChangesWhenReminderFails(t *testing.T) {
+	ctx := context.Background()
+	f := newAppointmentFixture(t, 1, 2)
+	booked, err := f.svc.ScheduleAppointment(ctx, scheduleInput(1, "alex", 9, 10))
+	require.NoError(t, err)
+
+	f.reminders.err = errors.New("temporal unavailable")
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(2, "alex", 13, 14))
+	require.ErrorIs(t, err, f.reminders.err)
+	later := scheduleInput(1, "alex", 15, 16)
+	_, err = f.svc.RescheduleAppointment(ctx, pettypes.RescheduleAppointmentInput{ID: booked.ID, Start: later.Start, End: later.End})
+	require.ErrorIs(t, err, f.reminders.err)
+
+	appointments, err := f.svc.ListAppointments(ctx, pettypes.AppointmentFilter{Groomer: "alex"})
+	require.NoError(t, err)
+	require.Len(t, appointments, 2)
+	require.Equal(t, booked.Slot, appointments[0].Slot, "a failed reschedule keeps the previous slot")
+	require.Equal(t, domain.AppointmentScheduled, appointments[0].Status)
+	require.Equal(t, domain.AppointmentCancelled, appointments[1].Status, "a failed booking does not hold the slot")
+
+	f.reminders.err = nil
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(2, "alex", 13, 14))
+	require.NoError(t, err)
+}
+
+func TestAppointmentRepository_RejectsUpdatesToClosedAppointments(t *testing.T) {
+	ctx := context.Background()
+	f := newAppointmentFixture(t, 1)
+	booked, err := f.svc.ScheduleAppointment(ctx, scheduleInput(1, "alex", 9, 10))
+	require.NoError(t, err)
+	stale, err := f.repo.GetByID(ctx, booked.ID)
+	require.NoError(t, err)
+	_, err = f.svc.CancelAppointment(ctx, booked.ID)
+	require.NoError(t, err)
+
+	later := scheduleInput(1, "alex", 15, 16)
+	require.NoError(t, stale.Reschedule(domain.TimeSlot{Start: later.Start, End: later.End}))
+	_, err = f.repo.Save(ctx, stale)
+	require.ErrorIs(t, err, domain.ErrAppointmentClosed, "a stale read must not reopen a cancelled appointment")
+}
+
+func TestAppointmentService_RejectsInvalidBookings(t *testing.T) {
+	ctx := context.Background()
+	f := newAppointmentFixture(t, 1)
+
+	_, err := f.svc.ScheduleAppointment(ctx, scheduleInput(7, "alex", 9, 10))
+	require.ErrorIs(t, err, ErrUnknownPet)
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(1, " ", 9, 10))
+	require.ErrorIs(t, err, domain.ErrEmptyGroomer)
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(1, "alex", 10, 9))
+	require.ErrorIs(t, err, domain.ErrInvalidTimeSlot)
+	_, err = f.svc.ScheduleAppointment(ctx, pettypes.ScheduleAppointmentInput{
+		PetID: 1, Groomer: "alex", Start: appointmentNow.Add(-time.Hour), End: appointmentNow.Add(time.Hour),
+	})
+	require.ErrorIs(t, err, domain.ErrInvalidTimeSlot, "slots must start in the future")
+	_, err = f.svc.ScheduleAppointment(ctx, scheduleInput(1, "alex", 9, 10))
+	require.NoError(t, err)
+}
+
+func TestAppointmentService_CompleteGroomsPetAndRecordsHistory(t *testing.T) {
+	ctx := context.Background()
+	f := newAppointmentFixture(t, 1)
+	booked, err := f.svc.ScheduleAppointment(ctx, scheduleInput(1, "alex", 9, 10))
+	require.NoError(t, err)
+
+	completed, err := f.svc.CompleteAppointment(ctx, pettypes.CompleteAppointmentInput{ID: booked.ID, InitialHairLengthCm: 5})
+	require.NoError(t, err)
+	require.Equal(t, domain.AppointmentCompleted, completed.Status)
+	require.NotNil(t, completed.CompletedAt)
+	require.Contains(t, f.reminders.cancelled, booked.ID)
+
+	pet, err := f.pets.GetByID(ctx, pettypes.PetIdentifier{ID: 1})
+	require.NoError(t, err)
+	require.InDelta(t, 4, pet.Pet.HairLengthCm, 1e-9, "the requested trim applies by default")
+
+	history, err := f.svc.GroomingHistory(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []domain.GroomingRecord{{
+		AppointmentID:   booked.ID,
+		PetID:           1,
+		Groomer:         "alex",
+		InitialLengthCm: 5,
+		TrimByCm:        1,
+		ResultLengthCm:  4,
+		GroomedAt:       appointmentNow,
+	}}, history)
+
+	trim := 2.0
+	_, err = f.svc.CompleteAppointment(ctx, pettypes.CompleteAppointmentInput{ID: booked.ID, InitialHairLengthCm: 5, TrimByCm: &trim})
+	require.ErrorIs(t, err, domain.ErrAppointmentClosed)
+	_, err = f.svc.GroomingHistory(ctx, 42)
+	require.ErrorIs(t, err, ports.ErrNotFound)
+}
+
+func TestGroomingReminders_SendsOncePerSlot(t *testing.T) {
+	ctx := context.Background()
+	f := newAppointmentFixture(t, 1)
+	notifier := &recordingNotifier{}
+	sender := NewGroomingReminders(f.repo, notifier)
+
+	booked, err := f.svc.ScheduleAppointment(ctx, scheduleInput(1, "alex", 9, 10))
+	require.NoError(t, err)
+	cancelled, err := f.svc.ScheduleAppointment(ctx, scheduleInput(1, "alex", 11, 12))
+	require.NoError(t, err)
+	_, err = f.svc.CancelAppointment(ctx, cancelled.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, sender.SendGroomingReminder(ctx, booked.ID))
+	require.NoError(t, sender.SendGroomingReminder(ctx, booked.ID))
+	require.NoError(t, sender.SendGroomingReminder(ctx, cancelled.ID))
+	require.NoError(t, sender.SendGroomingReminder(ctx, 99))
+	require.Equal(t, []int64{booked.ID}, notifier.notified)
+
+	reminded, err := f.svc.GetAppointment(ctx, booked.ID)
+	require.NoError(t, err)
+	require.NotNil(t, reminded.RemindedAt)
+
+	later := scheduleInput(1, "alex", 14, 15)
+	_, err = f.svc.RescheduleAppointment(ctx, pettypes.RescheduleAppointmentInput{ID: booked.ID, Start: later.Start, End: later.End})
+	require.NoError(t, err)
+	require.NoError(t, sender.SendGroomingReminder(ctx, booked.ID))
+	require.Equal(t, []int64{booked.ID, booked.ID}, notifier.notified, "a rescheduled appointment is reminded again")
+}
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+// BatchGet loads many pets with a single repository call and reports the ids that do not exist.
+func (s *Service) BatchGet(ctx context.Context, input types.BatchGetInput) (*types.BatchGetResult, error) {
+	ids, err := normalizeBatchIDs(input.IDs)
+	if err != nil {
+		return nil, err
+	}
+	found, err := s.repo.GetMany(ctx, ids)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	result := &types.BatchGetResult{Pets: found}
+	present := make(map[int64]bool, len(found))
+	for _, projection := range found {
+		present[projection.Pet.ID] = true
+	}
+	for _, id := range ids {
+		if !present[id] {
+			result.Missing = append(result.Missing, id)
+		}
+	}
+	return result, nil
+}
+
+// BatchUpdateStatus moves the requested pets to input.Status with a single atomic write that
+// touches only their status, so concurrent edits to other fields are kept. Unknown ids are
+// reported as problems; in atomic mode any problem rejects the whole batch with ErrBatchRejected
+// and nothing is written. Only pets whose status actually changed are published and pushed to
+// partners.
+func (s *Service) BatchUpdateStatus(ctx context.Context, input types.BatchStatusInput) (*types.BatchStatusResult, error) {
+	ids, err := normalizeBatchIDs(input.IDs)
+	if err != nil {
+		return nil, err
+	}
+	status := domain.Status(input.Status)
+	if status == "" {
+		return nil, fmt.Errorf("%w: status is required", ErrInvalidInput)
+	}
+	if err := (&domain.Pet{}).UpdateStatus(status); err != nil {
+		return nil, mapError(err)
+	}
+	update, err := s.repo.UpdateStatus(ctx, ids, status, input.Atomic)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	result := &types.BatchStatusResult{}
+	for _, id := range update.Missing {
+		result.Problems = append(result.Problems, types.BatchItemProblem{ID: id, Error: "pet not found"})
+	}
+	if input.Atomic && len(result.Problems) > 0 {
+		return result, fmt.Errorf("%w: %d of %d items failed", ErrBatchRejected, len(result.Problems), len(ids))
+	}
+	for _, projection := range update.Pets {
+		result.Updated = append(result.Updated, projection)
+		before, changed := update.Previous[projection.Pet.ID]
+		if !changed {
+			continue
+		}
+		s.publishSaved(ctx, projection, &before)
+		if err := s.syncWithPartner(ctx, projection); err != nil {
+			result.Warnings = append(result.Warnings, types.BatchItemProblem{ID: projection.Pet.ID, Error: err.Error()})
+		}
+	}
+	return result, nil
+}
+
+// normalizeBatchIDs drops duplicate ids while keeping the first occurrence order.
+func normalizeBatchIDs(ids []int64) ([]int64, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: at least one id is required", ErrInvalidInput)
+	}
+	seen := make(map[int64]bool, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("%w: id %d is not a valid pet id", ErrInvalidInput, id)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	if len(unique) > types.MaxBatchSize {
+		return nil, fmt.Errorf("%w: at most %d ids are allowed per batch", ErrInvalidInput, types.MaxBatchSize)
+	}
+	return unique, nil
+}
@@ -0,0 +1,182 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	types "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// exportPageSize is the number of pets fetched per page while exporting.
+const exportPageSize = 500
+
+type pendingImport struct {
+	line    int
+	pet     *domain.Pet
+	existed bool
+	// previous is the stored status of an existing pet, announced when the import changes it.
+	previous domain.Status
+}
+
+// ImportPets validates every row through the same path as AddPet and writes valid rows in
+// batches. Each row result is passed to emit once its batch is settled, so callers can stream
+// results while the import is still running. Row problems never abort the import; repository
+// failures do, after the affected batch has been reported as failed.
+func (s *Service) ImportPets(ctx context.Context, input types.BulkImportInput, emit func(types.BulkRowResult) error) (types.BulkImportSummary, error) {
+	summary := types.BulkImportSummary{DryRun: input.DryRun}
+	if input.Rows == nil {
+		return summary, nil
+	}
+	mode := input.Mode
+	if mode == "" {
+		mode = types.BulkImportCreate
+	}
+	batchSize := input.BatchSize
+	if batchSize <= 0 {
+		batchSize = types.DefaultBulkBatchSize
+	}
+	report := func(result types.BulkRowResult) error {
+		summary.Add(result)
+		return emit(result)
+	}
+	fail := func(line int, id int64, err error) error {
+		return report(types.BulkRowResult{Line: line, ID: id, Status: types.BulkRowFailed, Error: err.Error()})
+	}
+
+	seenIDs := map[int64]int{}
+	pending := make([]pendingImport, 0, batchSize)
+	var runErr error
+	for row := range input.Rows {
+		if err := ctx.Err(); err != nil {
+			runErr = err
+			break
+		}
+		if row.Err != nil {
+			if runErr = fail(row.Line, row.Mutation.ID, row.Err); runErr != nil {
+				break
+			}
+			continue
+		}
+		pet, err := s.buildPetFromMutation(ctx, row.Mutation)
+		if err != nil {
+			if runErr = fail(row.Line, row.Mutation.ID, mapError(err)); runErr != nil {
+				break
+			}
+			continue
+		}
+		existed := false
+		var previous domain.Status
+		if pet.ID != 0 {
+			if line, dup := seenIDs[pet.ID]; dup {
+				if runErr = fail(row.Line, pet.ID, fmt.Errorf("%w: id %d already appears on line %d", ErrInvalidInput, pet.ID, line)); runErr != nil {
+					break
+				}
+				continue
+			}
+			seenIDs[pet.ID] = row.Line
+			stored, err := s.repo.GetByID(ctx, pet.ID)
+			switch {
+			case err == nil:
+				existed = true
+				previous = stored.Pet.Status
+			case !errors.Is(err, ports.ErrNotFound):
+				runErr = err
+			}
+			if runErr != nil {
+				break
+			}
+			if existed && mode == types.BulkImportCreate {
+				if runErr = fail(row.Line, pet.ID, fmt.Errorf("%w: pet %d already exists; use upsert mode to replace it", ErrInvalidInput, pet.ID)); runErr != nil {
+					break
+				}
+				continue
+			}
+		}
+		if input.DryRun {
+			if runErr = report(types.BulkRowResult{Line: row.Line, ID: pet.ID, Status: types.BulkRowValid}); runErr != nil {
+				break
+			}
+			continue
+		}
+		pending = append(pending, pendingImport{line: row.Line, pet: pet, existed: existed, previous: previous})
+		if len(pending) == batchSize {
+			if runErr = s.flushImport(ctx, pending, report); runErr != nil {
+				break
+			}
+			pending = pending[:0]
+		}
+	}
+	if runErr == nil && len(pending) > 0 {
+		runErr = s.flushImport(ctx, pending, report)
+	}
+	return summary, mapError(runErr)
+}
+
+func (s *Service) flushImport(ctx context.Context, batch []pendingImport, report func(types.BulkRowResult) error) error {
+	pets := make([]*domain.Pet, 0, len(batch))
+	for _, item := range batch {
+		pets = append(pets, item.pet)
+	}
+	saved, err := s.repo.SaveMany(ctx, pets)
+	if err != nil {
+		for _, item := range batch {
+			if reportErr := report(types.BulkRowResult{Line: item.line, ID: item.pet.ID, Status: types.BulkRowFailed, Error: err.Error()}); reportErr != nil {
+				return reportErr
+			}
+		}
+		return err
+	}
+	for i, item := range batch {
+		result := types.BulkRowResult{Line: item.line, ID: saved[i].Pet.ID, Status: types.BulkRowCreated}
+		if item.existed {
+			result.Status = types.BulkRowUpdated
+			s.publishSaved(ctx, saved[i], &item.previous)
+		} else {
+			s.publishSaved(ctx, saved[i], nil)
+		}
+		if err := s.syncWithPartner(ctx, saved[i]); err != nil {
+			result.Warning = err.Error()
+		}
+		if err := report(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportPets streams the catalog to emit in ID order, paging through the repository when it
+// supports it so large catalogs are never held in memory at once.
+func (s *Service) ExportPets(ctx context.Context, emit func(*types.PetProjection) error) error {
+	lister, ok := s.repo.(ports.PageLister)
+	if !ok {
+		all, err := s.repo.List(ctx)
+		if err != nil {
+			return mapError(err)
+		}
+		for _, projection := range all {
+			if err := emit(projection); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	var after int64
+	for {
+		page, err := lister.ListAfter(ctx, after, exportPageSize)
+		if err != nil {
+			return mapError(err)
+		}
+		for _, projection := range page {
+			if err := emit(projection); err != nil {
+				return err
+			}
+			after = projection.Pet.ID
+		}
+		if len(page) < exportPageSize {
+			return nil
+		}
+	}
+}
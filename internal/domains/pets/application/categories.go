@@ -0,0 +1,130 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	types "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// CategoryService manages the categories pets are filed under.
+type CategoryService struct {
+	repo ports.CategoryRepository
+	pets ports.CategoryReferences
+}
+
+// NewCategoryService wires category management. pets may be nil when the repository enforces
+// references itself, as the Postgres foreign keys do.
+func NewCategoryService(repo ports.CategoryRepository, pets ports.CategoryReferences) *CategoryService {
+	return &CategoryService{repo: repo, pets: pets}
+}
+
+// CreateCategory adds a category, optionally nested under an existing parent. An explicit ID
+// must not belong to an existing category; use UpdateCategory to change one.
+func (s *CategoryService) CreateCategory(ctx context.Context, input types.CategoryMutationInput) (*domain.Category, error) {
+	category, err := domain.NewCategory(input.ID, input.Name, input.ParentID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := s.checkParent(ctx, category); err != nil {
+		return nil, mapError(err)
+	}
+	created, err := s.repo.Create(ctx, category)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return created, nil
+}
+
+// UpdateCategory renames or moves an existing category. Pets pick up the new name on read and
+// their update time moves, so their ETags change.
+func (s *CategoryService) UpdateCategory(ctx context.Context, input types.CategoryMutationInput) (*domain.Category, error) {
+	category, err := s.repo.GetByID(ctx, input.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := category.Rename(input.Name); err != nil {
+		return nil, mapError(err)
+	}
+	if err := category.MoveUnder(input.ParentID); err != nil {
+		return nil, mapError(err)
+	}
+	if err := s.checkParent(ctx, category); err != nil {
+		return nil, mapError(err)
+	}
+	saved, err := s.repo.Save(ctx, category)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if s.pets != nil {
+		if err := s.pets.TouchByCategory(ctx, saved.ID); err != nil {
+			return nil, mapError(err)
+		}
+	}
+	return saved, nil
+}
+
+// GetCategory loads a single category.
+func (s *CategoryService) GetCategory(ctx context.Context, id int64) (*domain.Category, error) {
+	category, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return category, nil
+}
+
+// ListCategories returns every category ordered by ID.
+func (s *CategoryService) ListCategories(ctx context.Context) ([]*domain.Category, error) {
+	categories, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return categories, nil
+}
+
+// DeleteCategory removes a category that no pet or sub-category references.
+func (s *CategoryService) DeleteCategory(ctx context.Context, id int64) error {
+	if s.pets != nil {
+		if _, err := s.repo.GetByID(ctx, id); err != nil {
+			return mapError(err)
+		}
+		count, err := s.pets.CountByCategory(ctx, id)
+		if err != nil {
+			return mapError(err)
+		}
+		if count > 0 {
+			return fmt.Errorf("%w: %d pets are filed under category %d", ports.ErrCategoryInUse, count, id)
+		}
+	}
+	return mapError(s.repo.Delete(ctx, id))
+}
+
+// checkParent verifies the parent exists and that walking up from it never reaches the
+// category itself, which would turn the hierarchy into a cycle.
+func (s *CategoryService) checkParent(ctx context.Context, category *domain.Category) error {
+	seen := map[int64]bool{}
+	for parentID := category.ParentID; parentID != nil; {
+		if category.ID != 0 && *parentID == category.ID {
+			return domain.ErrCategoryCycle
+		}
+		if seen[*parentID] {
+			// The stored hierarchy already loops above this category; nesting under it is still a cycle.
+			return domain.ErrCategoryCycle
+		}
+		seen[*parentID] = true
+		parent, err := s.repo.GetByID(ctx, *parentID)
+		if errors.Is(err, ports.ErrCategoryNotFound) {
+			return fmt.Errorf("%w: %d", ErrUnknownParentCategory, *parentID)
+		}
+		if err != nil {
+			return err
+		}
+		parentID = parent.ParentID
+	}
+	return nil
+}
+
+var _ ports.CategoryService = (*CategoryService)(nil)
@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	petmemory "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+func newCategoryFixture() (*CategoryService, *Service) {
+	categories := petmemory.NewCategoryRepository()
+	pets := petmemory.NewRepository()
+	pets.WithCategories(categories)
+	clock := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	pets.WithClock(func() time.Time {
+		clock = clock.Add(time.Second)
+		return clock
+	})
+	return NewCategoryService(categories, pets), NewService(pets, WithCategories(categories))
+}
+
+func TestCategoryService_Hierarchy(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newCategoryFixture()
+
+	dogs, err := svc.CreateCategory(ctx, pettypes.CategoryMutationInput{Name: " Dogs "})
+	require.NoError(t, err)
+	require.Equal(t, "Dogs", dogs.Name)
+	puppies, err := svc.CreateCategory(ctx, pettypes.CategoryMutationInput{Name: "Puppies", ParentID: &dogs.ID})
+	require.NoError(t, err)
+	require.Equal(t, dogs.ID, *puppies.ParentID)
+
+	_, err = svc.CreateCategory(ctx, pettypes.CategoryMutationInput{Name: "DOGS"})
+	require.ErrorIs(t, err, ports.ErrCategoryNameTaken)
+	_, err = svc.CreateCategory(ctx, pettypes.CategoryMutationInput{Name: " "})
+	require.ErrorIs(t, err, domain.ErrEmptyCategoryName)
+	missing := int64(99)
+	_, err = svc.CreateCategory(ctx, pettypes.CategoryMutationInput{Name: "Kittens", ParentID: &missing})
+	require.ErrorIs(t, err, ErrUnknownParentCategory)
+
+	_, err = svc.UpdateCategory(ctx, pettypes.CategoryMutationInput{ID: dogs.ID, Name: "Dogs", ParentID: &puppies.ID})
+	require.ErrorIs(t, err, domain.ErrCategoryCycle)
+	_, err = svc.UpdateCategory(ctx, pettypes.CategoryMutationInput{ID: missing, Name: "Ghosts"})
+	require.ErrorIs(t, err, ports.ErrCategoryNotFound)
+
+	moved, err := svc.UpdateCategory(ctx, pettypes.CategoryMutationInput{ID: puppies.ID, Name: "Puppies"})
+	require.NoError(t, err)
+	require.Nil(t, moved.ParentID)
+
+	all, err := svc.ListCategories(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+}
+
+func TestCategoryService_CreateRejectsExistingIDs(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newCategoryFixture()
+
+	cats, err := svc.CreateCategory(ctx, pettypes.CategoryMutationInput{ID: 5, Name: "Cats"})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), cats.ID)
+
+	_, err = svc.CreateCategory(ctx, pettypes.CategoryMutationInput{ID: 5, Name: "Birds"})
+	require.ErrorIs(t, err, ports.ErrCategoryIDTaken)
+	unchanged, err := svc.GetCategory(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, "Cats", unchanged.Name, "create must not overwrite an existing category")
+
+	birds, err := svc.CreateCategory(ctx, pettypes.CategoryMutationInput{Name: "Birds"})
+	require.NoError(t, err)
+	require.Greater(t, birds.ID, cats.ID, "generated IDs continue after explicit ones")
+}
+
+func TestCategoryService_DeleteRejectsReferencedCategories(t *testing.T) {
+	ctx := context.Background()
+	svc, pets := newCategoryFixture()
+
+	dogs, err := svc.CreateCategory(ctx, pettypes.CategoryMutationInput{Name: "Dogs"})
+	require.NoError(t, err)
+	puppies, err := svc.CreateCategory(ctx, pettypes.CategoryMutationInput{Name: "Puppies", ParentID: &dogs.ID})
+	require.NoError(t, err)
+
+	name := "Rex"
+	photos := []string{"http://example.com/rex.jpg"}
+	_, err = pets.AddPet(ctx, pettypes.AddPetInput{PetMutationInput: pettypes.PetMutationInput{
+		ID: 1, Name: &name, PhotoURLs: &photos, Category: &pettypes.CategoryInput{ID: puppies.ID},
+	}})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, svc.DeleteCategory(ctx, dogs.ID), ports.ErrCategoryInUse, "sub-categories keep the parent")
+	require.ErrorIs(t, svc.DeleteCategory(ctx, puppies.ID), ports.ErrCategoryInUse, "pets keep the category")
+	require.ErrorIs(t, svc.DeleteCategory(ctx, 99), ports.ErrCategoryNotFound)
+
+	require.NoError(t, pets.Delete(ctx, pettypes.PetIdentifier{ID: 1}))
+	require.NoError(t, svc.DeleteCategory(ctx, puppies.ID))
+	require.NoError(t, svc.DeleteCategory(ctx, dogs.ID))
+}
+
+func TestPetMutation_ResolvesManagedCategories(t *testing.T) {
+	ctx := context.Background()
+	svc, pets := newCategoryFixture()
+
+	dogs, err := svc.CreateCategory(ctx, pettypes.CategoryMutationInput{Name: "Dogs"})
+	require.NoError(t, err)
+
+	name := "Rex"
+	photos := []string{"http://example.com/rex.jpg"}
+	proj, err := pets.AddPet(ctx, pettypes.AddPetInput{PetMutationInput: pettypes.PetMutationInput{
+		ID: 1, Name: &name, PhotoURLs: &photos, Category: &pettypes.CategoryInput{Name: "dogs"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, &domain.Category{ID: dogs.ID, Name: "Dogs"}, proj.Pet.Category, "names resolve to the managed category")
+
+	_, err = pets.UpdatePet(ctx, pettypes.UpdatePetInput{PetMutationInput: pettypes.PetMutationInput{
+		ID: 1, Category: &pettypes.CategoryInput{ID: 42, Name: "Dogs"},
+	}})
+	require.ErrorIs(t, err, ErrUnknownCategory)
+	_, err = pets.AddPet(ctx, pettypes.AddPetInput{PetMutationInput: pettypes.PetMutationInput{
+		ID: 2, Name: &name, PhotoURLs: &photos, Category: &pettypes.CategoryInput{Name: "Cats"},
+	}})
+	require.ErrorIs(t, err, ErrUnknownCategory)
+
+	before, err := pets.GetByID(ctx, pettypes.PetIdentifier{ID: 1})
+	require.NoError(t, err)
+	_, err = svc.UpdateCategory(ctx, pettypes.CategoryMutationInput{ID: dogs.ID, Name: "Hounds"})
+	require.NoError(t, err)
+	proj, err = pets.GetByID(ctx, pettypes.PetIdentifier{ID: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Hounds", proj.Pet.Category.Name, "reads pick up the renamed category")
+	require.True(t, proj.Metadata.UpdatedAt.After(before.Metadata.UpdatedAt), "a rename changes the pet's version")
+}
@@ -3,8 +3,11 @@ package application
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
 )
 
 var (
@@ -14,8 +17,59 @@ var (
 	ErrPartnerSync = errors.New("partner sync failed")
 	// ErrIdempotencyConflict indicates the same Idempotency-Key was reused with a different request.
 	ErrIdempotencyConflict = errors.New("idempotency key conflict")
+	// ErrBatchRejected indicates an atomic batch was not applied because at least one item failed.
+	ErrBatchRejected = errors.New("batch rejected")
+	// ErrUnknownCategory indicates a pet references a category that is not managed.
+	ErrUnknownCategory = errors.New("unknown category")
+	// ErrUnknownParentCategory indicates a category is nested under a parent that does not exist.
+	ErrUnknownParentCategory = errors.New("unknown parent category")
+	// ErrUnknownTag indicates a pet references a tag ID the catalog never assigned.
+	ErrUnknownTag = errors.New("unknown tag")
+	// ErrTagMergeIntoSelf indicates a merge named the same tag as source and target.
+	ErrTagMergeIntoSelf = errors.New("a tag cannot be merged into itself")
+	// ErrUnknownPet indicates a grooming appointment names a pet that does not exist.
+	ErrUnknownPet = errors.New("unknown pet")
 )
 
+// Domain errors come before ErrInvalidInput, which wraps them, so lookups report the precise code.
+func init() {
+	apierrors.Register(
+		apierrors.Definition{Err: domain.ErrEmptyName, Code: "pets.name_required", Status: http.StatusBadRequest, Title: "Pet Name Required", Field: "body.name"},
+		apierrors.Definition{Err: domain.ErrEmptyPhotos, Code: "pets.photo_urls_required", Status: http.StatusBadRequest, Title: "Pet Photo URLs Required", Field: "body.photoUrls"},
+		apierrors.Definition{Err: domain.ErrInvalidHair, Code: "pets.invalid_hair_length", Status: http.StatusBadRequest, Title: "Invalid Hair Length", Field: "body.hairLengthCm"},
+		apierrors.Definition{Err: domain.ErrInvalidGrooming, Code: "pets.invalid_grooming", Status: http.StatusBadRequest, Title: "Invalid Grooming Operation", Field: "body.trimByCm"},
+		apierrors.Definition{Err: domain.ErrInvalidStatus, Code: "pets.invalid_status", Status: http.StatusBadRequest, Title: "Invalid Pet Status", Field: "body.status"},
+		apierrors.Definition{Err: domain.ErrEmptyCategoryName, Code: "pets.category_name_required", Status: http.StatusBadRequest, Title: "Category Name Required", Field: "body.name"},
+		apierrors.Definition{Err: domain.ErrCategoryCycle, Code: "pets.category_cycle", Status: http.StatusUnprocessableEntity, Title: "Category Hierarchy Cycle", Field: "body.parentId"},
+		apierrors.Definition{Err: ErrUnknownCategory, Code: "pets.unknown_category", Status: http.StatusUnprocessableEntity, Title: "Unknown Category", Field: "body.category"},
+		apierrors.Definition{Err: ErrUnknownParentCategory, Code: "pets.unknown_parent_category", Status: http.StatusUnprocessableEntity, Title: "Unknown Parent Category", Field: "body.parentId"},
+		apierrors.Definition{Err: domain.ErrEmptyTagName, Code: "pets.tag_name_required", Status: http.StatusBadRequest, Title: "Tag Name Required", Field: "body.name"},
+		apierrors.Definition{Err: ErrUnknownTag, Code: "pets.unknown_tag", Status: http.StatusUnprocessableEntity, Title: "Unknown Tag", Field: "body.tags"},
+		apierrors.Definition{Err: ErrTagMergeIntoSelf, Code: "pets.tag_merge_into_self", Status: http.StatusUnprocessableEntity, Title: "Tag Merged Into Itself", Field: "body.targetId"},
+		apierrors.Definition{Err: domain.ErrEmptyGroomer, Code: "pets.groomer_required", Status: http.StatusBadRequest, Title: "Groomer Required", Field: "body.groomer"},
+		apierrors.Definition{Err: domain.ErrInvalidTimeSlot, Code: "pets.invalid_time_slot", Status: http.StatusBadRequest, Title: "Invalid Time Slot", Field: "body.startsAt"},
+		apierrors.Definition{Err: domain.ErrInvalidRequestedTrim, Code: "pets.invalid_requested_trim", Status: http.StatusBadRequest, Title: "Invalid Requested Trim", Field: "body.requestedTrimCm"},
+		apierrors.Definition{Err: ErrUnknownPet, Code: "pets.unknown_pet", Status: http.StatusUnprocessableEntity, Title: "Unknown Pet", Field: "body.petId"},
+		apierrors.Definition{Err: ErrInvalidInput, Code: "pets.invalid_input", Status: http.StatusBadRequest, Title: "Invalid Pet Input"},
+		apierrors.Definition{Err: ports.ErrNotFound, Code: "pets.not_found", Status: http.StatusNotFound, Title: "Pet Not Found"},
+		apierrors.Definition{Err: ports.ErrExternalReferenceTaken, Code: "pets.external_reference_taken", Status: http.StatusConflict, Title: "External Reference Taken", Field: "body.externalReference"},
+		apierrors.Definition{Err: ports.ErrCategoryNotFound, Code: "pets.category_not_found", Status: http.StatusNotFound, Title: "Category Not Found"},
+		apierrors.Definition{Err: ports.ErrCategoryIDTaken, Code: "pets.category_id_taken", Status: http.StatusConflict, Title: "Category ID Taken", Field: "body.id"},
+		apierrors.Definition{Err: ports.ErrCategoryNameTaken, Code: "pets.category_name_taken", Status: http.StatusConflict, Title: "Category Name Taken", Field: "body.name"},
+		apierrors.Definition{Err: ports.ErrCategoryInUse, Code: "pets.category_in_use", Status: http.StatusConflict, Title: "Category In Use"},
+		apierrors.Definition{Err: ports.ErrTagNotFound, Code: "pets.tag_not_found", Status: http.StatusNotFound, Title: "Tag Not Found"},
+		apierrors.Definition{Err: ports.ErrTagNameTaken, Code: "pets.tag_name_taken", Status: http.StatusConflict, Title: "Tag Name Taken", Field: "body.name"},
+		apierrors.Definition{Err: ports.ErrTagInUse, Code: "pets.tag_in_use", Status: http.StatusConflict, Title: "Tag In Use"},
+		apierrors.Definition{Err: ports.ErrAppointmentNotFound, Code: "pets.appointment_not_found", Status: http.StatusNotFound, Title: "Grooming Appointment Not Found"},
+		apierrors.Definition{Err: ports.ErrGroomerUnavailable, Code: "pets.groomer_unavailable", Status: http.StatusConflict, Title: "Groomer Unavailable", Field: "body.startsAt"},
+		apierrors.Definition{Err: domain.ErrAppointmentClosed, Code: "pets.appointment_closed", Status: http.StatusConflict, Title: "Grooming Appointment Closed"},
+		apierrors.Definition{Err: ErrIdempotencyConflict, Code: "pets.idempotency_conflict", Status: http.StatusConflict, Title: "Idempotency Key Conflict"},
+		apierrors.Definition{Err: ports.ErrReplayedDelivery, Code: "pets.partner_webhook_replayed", Status: http.StatusConflict, Title: "Partner Webhook Already Processed"},
+		apierrors.Definition{Err: ErrBatchRejected, Code: "pets.batch_rejected", Status: http.StatusUnprocessableEntity, Title: "Batch Rejected"},
+		apierrors.Definition{Err: ErrPartnerSync, Code: "pets.partner_sync_failed", Status: http.StatusBadGateway, Title: "Partner Sync Failed"},
+	)
+}
+
 func mapError(err error) error {
 	if err == nil {
 		return nil
@@ -24,7 +78,12 @@ func mapError(err error) error {
 		errors.Is(err, domain.ErrEmptyPhotos) ||
 		errors.Is(err, domain.ErrInvalidHair) ||
 		errors.Is(err, domain.ErrInvalidGrooming) ||
-		errors.Is(err, domain.ErrInvalidStatus) {
+		errors.Is(err, domain.ErrInvalidStatus) ||
+		errors.Is(err, domain.ErrEmptyCategoryName) ||
+		errors.Is(err, domain.ErrEmptyTagName) ||
+		errors.Is(err, domain.ErrEmptyGroomer) ||
+		errors.Is(err, domain.ErrInvalidTimeSlot) ||
+		errors.Is(err, domain.ErrInvalidRequestedTrim) {
 		return fmt.Errorf("%w: %w", ErrInvalidInput, err)
 	}
 	return err
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	types "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// publishSaved announces a persisted pet. previous is nil when the pet was created; a changed
+// status is reported as a status change rather than a plain update.
+func (s *Service) publishSaved(ctx context.Context, saved *types.PetProjection, previous *domain.Status) {
+	if saved == nil || saved.Pet == nil {
+		return
+	}
+	event := ports.PetEvent{
+		Type:       ports.PetUpdated,
+		PetID:      saved.Pet.ID,
+		Status:     saved.Pet.Status,
+		Tags:       tagNames(saved.Pet),
+		Pet:        saved,
+		OccurredAt: time.Now().UTC(),
+	}
+	switch {
+	case previous == nil:
+		event.Type = ports.PetCreated
+	case *previous != saved.Pet.Status:
+		event.Type = ports.PetStatusChanged
+		event.PreviousStatus = *previous
+	}
+	s.events.Publish(ctx, event)
+}
+
+// publishDeleted announces a removed pet with its last known status and tags for filtering.
+func (s *Service) publishDeleted(ctx context.Context, deleted *types.PetProjection) {
+	if deleted == nil || deleted.Pet == nil {
+		return
+	}
+	s.events.Publish(ctx, ports.PetEvent{
+		Type:       ports.PetDeleted,
+		PetID:      deleted.Pet.ID,
+		Status:     deleted.Pet.Status,
+		Tags:       tagNames(deleted.Pet),
+		OccurredAt: time.Now().UTC(),
+	})
+}
+
+func tagNames(pet *domain.Pet) []string {
+	if len(pet.Tags) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(pet.Tags))
+	for _, tag := range pet.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
@@ -0,0 +1,108 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	types "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// ImportFromPartner upserts a pet by its external reference from a partner-originated change.
+// When a concurrent delivery creates the pet first, the repository rejects the duplicate
+// reference and the change is applied to that pet as an update.
+// Candidates missing mandatory fields are parked for review when a review store is configured.
+// Imports are not synced back to the partner to avoid echoing its own changes.
+func (s *Service) ImportFromPartner(ctx context.Context, input types.PartnerImportInput) (*types.PartnerImportResult, error) {
+	candidate := input.Candidate
+	ref := candidate.ExternalReference
+	if ref == nil || strings.TrimSpace(ref.ID) == "" {
+		return nil, fmt.Errorf("%w: partner import requires an external reference", ErrInvalidInput)
+	}
+	if provider := strings.TrimSpace(input.Provider); provider != "" {
+		ref.Provider = provider
+	}
+	if missing := candidate.MissingFields(); len(missing) > 0 {
+		if s.importReviews == nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidInput, candidate.Validate())
+		}
+		if _, err := s.importReviews.Park(ctx, ports.ParkedImport{
+			Provider:   ref.Provider,
+			EventID:    input.EventID,
+			ExternalID: ref.ID,
+			Missing:    missing,
+			Candidate:  candidate,
+		}); err != nil {
+			return nil, err
+		}
+		return &types.PartnerImportResult{Outcome: types.PartnerImportParked, Missing: missing}, nil
+	}
+	incoming, err := candidate.ToDomainPet()
+	if err != nil {
+		return nil, mapError(err)
+	}
+	existing, err := s.repo.FindByExternalReference(ctx, ref.Provider, ref.ID)
+	if errors.Is(err, ports.ErrNotFound) {
+		saved, saveErr := s.repo.Save(ctx, incoming)
+		switch {
+		case saveErr == nil:
+			s.publishSaved(ctx, saved, nil)
+			return &types.PartnerImportResult{Outcome: types.PartnerImportCreated, Projection: saved}, nil
+		case errors.Is(saveErr, ports.ErrExternalReferenceTaken):
+			// A concurrent delivery created the pet first; apply this one to it as an update.
+			existing, err = s.repo.FindByExternalReference(ctx, ref.Provider, ref.ID)
+		default:
+			return nil, mapError(saveErr)
+		}
+	}
+	if err != nil {
+		return nil, mapError(err)
+	}
+	previous := existing.Pet.Status
+	if err := mergePartnerChanges(existing.Pet, incoming, candidate); err != nil {
+		return nil, mapError(err)
+	}
+	saved, err := s.repo.Save(ctx, existing.Pet)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	s.publishSaved(ctx, saved, &previous)
+	return &types.PartnerImportResult{Outcome: types.PartnerImportUpdated, Projection: saved}, nil
+}
+
+// mergePartnerChanges applies the fields a partner owns while keeping local-only state
+// such as category, hair length, and sync bookkeeping attributes.
+func mergePartnerChanges(target, incoming *domain.Pet, candidate types.PartnerImportCandidate) error {
+	if err := target.Rename(incoming.Name); err != nil {
+		return err
+	}
+	if err := target.ReplacePhotos(incoming.PhotoURLs); err != nil {
+		return err
+	}
+	if candidate.Availability != "" {
+		if err := target.UpdateStatus(incoming.Status); err != nil {
+			return err
+		}
+	}
+	if len(candidate.Labels) > 0 {
+		target.ReplaceTags(incoming.Tags)
+	}
+	attributes := map[string]string{}
+	if existing := target.ExternalReferenceFor(incoming.ExternalRef.Provider); existing != nil {
+		for k, v := range existing.Attributes {
+			attributes[k] = v
+		}
+	}
+	for k, v := range incoming.ExternalRef.Attributes {
+		attributes[k] = v
+	}
+	target.LinkExternalReference(domain.ExternalReference{
+		Provider:   incoming.ExternalRef.Provider,
+		ID:         incoming.ExternalRef.ID,
+		Attributes: cloneAttributes(attributes),
+	})
+	return nil
+}
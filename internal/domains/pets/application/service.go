@@ -16,6 +16,11 @@ type Service struct {
 	repo             ports.Repository
 	partnerSync      ports.PartnerSync
 	idempotencyStore ports.IdempotencyStore
+	metrics          ports.BusinessMetrics
+	importReviews    ports.ImportReviewStore
+	events           ports.PetEventPublisher
+	categories       ports.CategoryRepository
+	tags             ports.TagRepository
 }
 
 // Option customizes the service wiring.
@@ -35,6 +40,43 @@ func WithIdempotencyStore(store ports.IdempotencyStore) Option {
 	}
 }
 
+// WithBusinessMetrics records idempotency and partner sync outcomes.
+func WithBusinessMetrics(metrics ports.BusinessMetrics) Option {
+	return func(s *Service) {
+		s.metrics = metrics
+	}
+}
+
+// WithImportReviewStore parks incomplete partner imports instead of rejecting them.
+func WithImportReviewStore(store ports.ImportReviewStore) Option {
+	return func(s *Service) {
+		s.importReviews = store
+	}
+}
+
+// WithEventPublisher announces every committed catalog change, e.g. to stream it to clients.
+func WithEventPublisher(publisher ports.PetEventPublisher) Option {
+	return func(s *Service) {
+		s.events = publisher
+	}
+}
+
+// WithCategories requires pet categories to reference managed categories. Without it the
+// category on a pet is free-form.
+func WithCategories(categories ports.CategoryRepository) Option {
+	return func(s *Service) {
+		s.categories = categories
+	}
+}
+
+// WithTags rejects tag IDs that are not in the catalog and replaces the supplied name of a
+// known ID with the catalog's. Tags given only by name are added to the catalog on save.
+func WithTags(tags ports.TagRepository) Option {
+	return func(s *Service) {
+		s.tags = tags
+	}
+}
+
 // NewService wires the pets service with its dependencies.
 func NewService(repo ports.Repository, opts ...Option) *Service {
 	svc := &Service{repo: repo}
@@ -43,6 +85,12 @@ func NewService(repo ports.Repository, opts ...Option) *Service {
 			opt(svc)
 		}
 	}
+	if svc.metrics == nil {
+		svc.metrics = ports.NoopBusinessMetrics
+	}
+	if svc.events == nil {
+		svc.events = ports.NoopPetEvents
+	}
 	return svc
 }
 
@@ -60,11 +108,11 @@ func (s *Service) AddPet(ctx context.Context, input types.AddPetInput) (*types.P
 			return existing, mapError(err)
 		}
 	}
-	pet, err := buildPetFromMutation(input.PetMutationInput)
+	pet, err := s.buildPetFromMutation(ctx, input.PetMutationInput)
 	if err != nil {
 		return nil, mapError(err)
 	}
-	saved, err := s.saveAndSync(ctx, pet)
+	saved, err := s.saveAndSync(ctx, pet, nil)
 	if idempotencyKey != "" && s.idempotencyStore != nil && saved != nil && saved.Pet != nil {
 		if _, err := s.idempotencyStore.Save(ctx, ports.IdempotencyRecord{
 			Key:         idempotencyKey,
@@ -72,6 +120,7 @@ func (s *Service) AddPet(ctx context.Context, input types.AddPetInput) (*types.P
 			PetID:       saved.Pet.ID,
 		}); err != nil {
 			if errors.Is(err, ports.ErrIdempotencyConflict) {
+				s.metrics.IdempotencyConflicted(ctx)
 				return saved, mapError(fmt.Errorf("%w: %w", ErrIdempotencyConflict, err))
 			}
 			return saved, mapError(err)
@@ -83,16 +132,35 @@ func (s *Service) AddPet(ctx context.Context, input types.AddPetInput) (*types.P
 	return saved, nil
 }
 
+// ReplayAddPet answers a repeated AddPet from the idempotency store without creating anything,
+// so callers that hand creation to a workflow can short-circuit retries first.
+func (s *Service) ReplayAddPet(ctx context.Context, input types.AddPetInput) (*types.PetProjection, error) {
+	idempotencyKey := strings.TrimSpace(input.IdempotencyKey)
+	if idempotencyKey == "" || s.idempotencyStore == nil {
+		return nil, nil
+	}
+	fingerprint, err := FingerprintAddPet(input)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	existing, err := s.replayIdempotentPet(ctx, idempotencyKey, fingerprint)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return existing, nil
+}
+
 // UpdatePet overrides an existing pet with new state.
 func (s *Service) UpdatePet(ctx context.Context, input types.UpdatePetInput) (*types.PetProjection, error) {
 	projection, err := s.repo.GetByID(ctx, input.ID)
 	if err != nil {
 		return nil, mapError(err)
 	}
-	if err := applyPartialMutation(projection.Pet, input.PetMutationInput); err != nil {
+	previous := projection.Pet.Status
+	if err := s.applyPartialMutation(ctx, projection.Pet, input.PetMutationInput); err != nil {
 		return nil, mapError(err)
 	}
-	return s.saveAndSync(ctx, projection.Pet)
+	return s.saveAndSync(ctx, projection.Pet, &previous)
 }
 
 // UpdatePetWithForm handles the simplified form flow.
@@ -102,6 +170,7 @@ func (s *Service) UpdatePetWithForm(ctx context.Context, input types.UpdatePetWi
 		return nil, mapError(err)
 	}
 	existing := projection.Pet
+	previous := existing.Status
 	if input.Name != nil {
 		if err := existing.Rename(*input.Name); err != nil {
 			return nil, mapError(err)
@@ -112,7 +181,7 @@ func (s *Service) UpdatePetWithForm(ctx context.Context, input types.UpdatePetWi
 			return nil, mapError(err)
 		}
 	}
-	return s.saveAndSync(ctx, existing)
+	return s.saveAndSync(ctx, existing, &previous)
 }
 
 // FindByStatus searches pets matching any of the provided statuses.
@@ -131,9 +200,17 @@ func (s *Service) FindByStatus(ctx context.Context, input types.FindPetsByStatus
 	return result, nil
 }
 
-// FindByTags searches pets matching any supplied tag name.
+// FindByTags searches pets carrying any, or with TagMatchAll every, supplied tag name.
 func (s *Service) FindByTags(ctx context.Context, input types.FindPetsByTagsInput) ([]*types.PetProjection, error) {
-	result, err := s.repo.FindByTags(ctx, input.Tags)
+	match := input.Match
+	switch match {
+	case "":
+		match = types.TagMatchAny
+	case types.TagMatchAny, types.TagMatchAll:
+	default:
+		return nil, mapError(fmt.Errorf("%w: tag match must be %q or %q", ErrInvalidInput, types.TagMatchAny, types.TagMatchAll))
+	}
+	result, err := s.repo.FindByTags(ctx, input.Tags, match)
 	if err != nil {
 		return nil, mapError(err)
 	}
@@ -151,9 +228,15 @@ func (s *Service) GetByID(ctx context.Context, input types.PetIdentifier) (*type
 
 // Delete removes a pet.
 func (s *Service) Delete(ctx context.Context, input types.PetIdentifier) error {
+	// Subscribers filter by status and tag, so the last state is loaded before it is gone.
+	var deleted *types.PetProjection
+	if s.events != ports.NoopPetEvents {
+		deleted, _ = s.repo.GetByID(ctx, input.ID)
+	}
 	if err := s.repo.Delete(ctx, input.ID); err != nil {
 		return mapError(err)
 	}
+	s.publishDeleted(ctx, deleted)
 	return nil
 }
 
@@ -163,11 +246,12 @@ func (s *Service) GroomPet(ctx context.Context, input types.GroomPetInput) (*typ
 	if err != nil {
 		return nil, mapError(err)
 	}
+	previous := projection.Pet.Status
 	op := domain.GroomingOperation{InitialLengthCm: input.InitialHairLengthCm, TrimByCm: input.TrimByCm}
 	if err := projection.Pet.Groom(op); err != nil {
 		return nil, mapError(err)
 	}
-	return s.saveAndSync(ctx, projection.Pet)
+	return s.saveAndSync(ctx, projection.Pet, &previous)
 }
 
 // UploadImage stores metadata about an uploaded asset. For demo it simply tracks message.
@@ -191,11 +275,14 @@ func (s *Service) List(ctx context.Context) ([]*types.PetProjection, error) {
 	return result, nil
 }
 
-func (s *Service) saveAndSync(ctx context.Context, pet *domain.Pet) (*types.PetProjection, error) {
+// saveAndSync persists pet, announces the change, and pushes it to partners. previous is the
+// status before the mutation, nil for new pets.
+func (s *Service) saveAndSync(ctx context.Context, pet *domain.Pet, previous *domain.Status) (*types.PetProjection, error) {
 	saved, err := s.repo.Save(ctx, pet)
 	if err != nil {
 		return nil, mapError(err)
 	}
+	s.publishSaved(ctx, saved, previous)
 	if err := s.syncWithPartner(ctx, saved); err != nil {
 		return saved, err
 	}
@@ -206,7 +293,17 @@ func (s *Service) syncWithPartner(ctx context.Context, saved *types.PetProjectio
 	if s.partnerSync == nil || saved == nil || saved.Pet == nil {
 		return nil
 	}
-	if err := s.partnerSync.Sync(ctx, saved.Pet); err != nil {
+	// Each provider is attempted even when another fails so one outage does not block the rest.
+	var errs []error
+	for _, target := range ports.SyncTargets(s.partnerSync, saved.Pet) {
+		if err := target.Sync(ctx, saved.Pet); err != nil {
+			s.metrics.PartnerSyncFailed(ctx, target.Provider())
+			errs = append(errs, fmt.Errorf("%s: %w", target.Provider(), err))
+			continue
+		}
+		s.metrics.PartnerSyncSucceeded(ctx, target.Provider())
+	}
+	if err := errors.Join(errs...); err != nil {
 		return fmt.Errorf("%w: %w", ErrPartnerSync, err)
 	}
 	return nil
@@ -224,12 +321,14 @@ func (s *Service) replayIdempotentPet(ctx context.Context, key, fingerprint stri
 		return nil, nil
 	}
 	if record.RequestHash != fingerprint {
+		s.metrics.IdempotencyConflicted(ctx)
 		return nil, fmt.Errorf("%w: request payload does not match stored idempotency key", ErrIdempotencyConflict)
 	}
+	s.metrics.IdempotencyReplayed(ctx)
 	return s.repo.GetByID(ctx, record.PetID)
 }
 
-func buildPetFromMutation(input types.PetMutationInput) (*domain.Pet, error) {
+func (s *Service) buildPetFromMutation(ctx context.Context, input types.PetMutationInput) (*domain.Pet, error) {
 	if input.Name == nil {
 		return nil, domain.ErrEmptyName
 	}
@@ -254,13 +353,18 @@ func buildPetFromMutation(input types.PetMutationInput) (*domain.Pet, error) {
 	partial.Name = nil
 	partial.PhotoURLs = nil
 	partial.Status = nil
-	if err := applyPartialMutation(pet, partial); err != nil {
+	if err := s.applyPartialMutation(ctx, pet, partial); err != nil {
 		return nil, err
 	}
 	return pet, nil
 }
 
-func applyPartialMutation(target *domain.Pet, input types.PetMutationInput) error {
+func (s *Service) applyPartialMutation(ctx context.Context, target *domain.Pet, input types.PetMutationInput) error {
+	if (input.ClearCategory && input.Category != nil) ||
+		(input.ClearTags && input.Tags != nil) ||
+		(input.ClearExternalReference && input.ExternalReference != nil) {
+		return fmt.Errorf("%w: a field cannot be cleared and set in the same mutation", ErrInvalidInput)
+	}
 	if input.Name != nil {
 		if err := target.Rename(*input.Name); err != nil {
 			return err
@@ -272,13 +376,22 @@ func applyPartialMutation(target *domain.Pet, input types.PetMutationInput) erro
 		}
 	}
 	if input.Category != nil {
-		cat := domain.Category{ID: input.Category.ID, Name: input.Category.Name}
-		target.UpdateCategory(&cat)
+		cat, err := s.resolveCategory(ctx, *input.Category)
+		if err != nil {
+			return err
+		}
+		target.UpdateCategory(cat)
+	}
+	if input.ClearCategory {
+		target.UpdateCategory(nil)
+	}
+	if input.ClearTags {
+		target.ReplaceTags(nil)
 	}
 	if input.Tags != nil {
-		tags := make([]domain.Tag, 0, len(*input.Tags))
-		for _, t := range *input.Tags {
-			tags = append(tags, domain.Tag{ID: t.ID, Name: t.Name})
+		tags, err := s.resolveTags(ctx, *input.Tags)
+		if err != nil {
+			return err
 		}
 		target.ReplaceTags(tags)
 	}
@@ -292,6 +405,9 @@ func applyPartialMutation(target *domain.Pet, input types.PetMutationInput) erro
 			return err
 		}
 	}
+	if input.ClearExternalReference {
+		target.UpdateExternalReference(nil)
+	}
 	if input.ExternalReference != nil {
 		if input.ExternalReference.Provider == "" && input.ExternalReference.ID == "" && len(input.ExternalReference.Attributes) == 0 {
 			target.UpdateExternalReference(nil)
@@ -306,6 +422,58 @@ func applyPartialMutation(target *domain.Pet, input types.PetMutationInput) erro
 	return nil
 }
 
+// resolveCategory looks the category up by ID, or by name when no ID is given, so pets only
+// reference managed categories. An input without ID or name clears the category.
+func (s *Service) resolveCategory(ctx context.Context, input types.CategoryInput) (*domain.Category, error) {
+	if s.categories == nil {
+		return &domain.Category{ID: input.ID, Name: input.Name}, nil
+	}
+	name := strings.TrimSpace(input.Name)
+	var (
+		category *domain.Category
+		err      error
+	)
+	switch {
+	case input.ID != 0:
+		category, err = s.categories.GetByID(ctx, input.ID)
+	case name != "":
+		category, err = s.categories.FindByName(ctx, name)
+	default:
+		return nil, nil
+	}
+	if errors.Is(err, ports.ErrCategoryNotFound) {
+		if input.ID != 0 {
+			return nil, fmt.Errorf("%w: %d", ErrUnknownCategory, input.ID)
+		}
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCategory, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Category{ID: category.ID, Name: category.Name}, nil
+}
+
+// resolveTags maps tag IDs to their catalog names so pets never carry IDs the catalog did not
+// assign. Tags without an ID pass through by name.
+func (s *Service) resolveTags(ctx context.Context, inputs []types.TagInput) ([]domain.Tag, error) {
+	tags := make([]domain.Tag, 0, len(inputs))
+	for _, input := range inputs {
+		if s.tags == nil || input.ID == 0 {
+			tags = append(tags, domain.Tag{ID: input.ID, Name: input.Name})
+			continue
+		}
+		tag, err := s.tags.GetByID(ctx, input.ID)
+		if errors.Is(err, ports.ErrTagNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrUnknownTag, input.ID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, *tag)
+	}
+	return tags, nil
+}
+
 func cloneAttributes(attrs map[string]string) map[string]string {
 	if len(attrs) == 0 {
 		return nil
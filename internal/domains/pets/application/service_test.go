@@ -3,6 +3,7 @@ package application
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -176,6 +177,52 @@ func TestAddPet_IdempotencyConflict(t *testing.T) {
 	require.ErrorIs(t, err, ErrIdempotencyConflict)
 }
 
+// recordingMetrics counts the idempotency signals a use case emits.
+type recordingMetrics struct {
+	ports.BusinessMetrics
+	replayed   int
+	conflicted int
+}
+
+func (m *recordingMetrics) IdempotencyReplayed(context.Context)   { m.replayed++ }
+func (m *recordingMetrics) IdempotencyConflicted(context.Context) { m.conflicted++ }
+
+func TestAddPet_CountsEachIdempotencyOutcomeOnce(t *testing.T) {
+	metrics := &recordingMetrics{BusinessMetrics: ports.NoopBusinessMetrics}
+	svc := NewService(petmemory.NewRepository(), WithIdempotencyStore(petmemory.NewIdempotencyStore()), WithBusinessMetrics(metrics))
+
+	name := "Rex"
+	photos := []string{"http://example.com/rex.jpg"}
+	input := pettypes.AddPetInput{
+		PetMutationInput: pettypes.PetMutationInput{ID: 23, Name: &name, PhotoURLs: &photos},
+		IdempotencyKey:   "metrics-key",
+	}
+	replayed, err := svc.ReplayAddPet(context.Background(), input)
+	require.NoError(t, err)
+	require.Nil(t, replayed, "an unused key has nothing to replay")
+	_, err = svc.AddPet(context.Background(), input)
+	require.NoError(t, err)
+	require.Zero(t, metrics.replayed)
+
+	replayed, err = svc.ReplayAddPet(context.Background(), input)
+	require.NoError(t, err)
+	require.Equal(t, int64(23), replayed.Pet.ID)
+	require.Equal(t, 1, metrics.replayed)
+	_, err = svc.AddPet(context.Background(), input)
+	require.NoError(t, err)
+	require.Equal(t, 2, metrics.replayed)
+
+	otherName := "Buddy"
+	input.Name = &otherName
+	_, err = svc.ReplayAddPet(context.Background(), input)
+	require.ErrorIs(t, err, ErrIdempotencyConflict)
+	require.Equal(t, 1, metrics.conflicted)
+	_, err = svc.AddPet(context.Background(), input)
+	require.ErrorIs(t, err, ErrIdempotencyConflict)
+	require.Equal(t, 2, metrics.conflicted)
+	require.Equal(t, 2, metrics.replayed)
+}
+
 type stubPartnerSync struct {
 	called    bool
 	callCount int
@@ -347,3 +394,250 @@ func TestDelete_Flows(t *testing.T) {
 	err = svc.Delete(context.Background(), pettypes.PetIdentifier{ID: 999})
 	require.ErrorIs(t, err, ports.ErrNotFound)
 }
+
+func TestImportFromPartner_UpsertsByExternalReference(t *testing.T) {
+	repo := petmemory.NewRepository()
+	reviews := petmemory.NewImportReviewStore()
+	sync := &stubPartnerSync{}
+	svc := NewService(repo, WithPartnerSync(sync), WithImportReviewStore(reviews))
+
+	candidate := pettypes.PartnerImportCandidate{
+		Title:        "Rex",
+		Photos:       []string{"http://example.com/rex.jpg"},
+		Availability: "AVAILABLE",
+		ExternalReference: &pettypes.ExternalReferenceInput{
+			Provider: "partner",
+			ID:       "ext-1",
+		},
+	}
+	created, err := svc.ImportFromPartner(context.Background(), pettypes.PartnerImportInput{Provider: "acme", EventID: "evt-1", Candidate: candidate})
+	require.NoError(t, err)
+	require.Equal(t, pettypes.PartnerImportCreated, created.Outcome)
+	require.NotZero(t, created.Projection.Pet.ID)
+	require.Equal(t, "acme", created.Projection.Pet.ExternalRef.Provider)
+
+	candidate.Title = "Rex II"
+	candidate.Availability = "sold"
+	candidate.ExternalReference = &pettypes.ExternalReferenceInput{Provider: "partner", ID: "ext-1"}
+	updated, err := svc.ImportFromPartner(context.Background(), pettypes.PartnerImportInput{Provider: "acme", EventID: "evt-2", Candidate: candidate})
+	require.NoError(t, err)
+	require.Equal(t, pettypes.PartnerImportUpdated, updated.Outcome)
+	require.Equal(t, created.Projection.Pet.ID, updated.Projection.Pet.ID)
+	require.Equal(t, "Rex II", updated.Projection.Pet.Name)
+	require.Equal(t, domain.StatusSold, updated.Projection.Pet.Status)
+	require.Zero(t, sync.callCount, "partner imports are not echoed back to the partner")
+}
+
+// lookupRaceRepository misses the first FindByExternalReference, as when a concurrent delivery
+// saves the pet between this delivery's lookup and its insert.
+type lookupRaceRepository struct {
+	ports.Repository
+	missed bool
+}
+
+func (r *lookupRaceRepository) FindByExternalReference(ctx context.Context, provider, externalID string) (*pettypes.PetProjection, error) {
+	if !r.missed {
+		r.missed = true
+		return nil, ports.ErrNotFound
+	}
+	return r.Repository.FindByExternalReference(ctx, provider, externalID)
+}
+
+func TestImportFromPartner_ConcurrentCreateBecomesUpdate(t *testing.T) {
+	memory := petmemory.NewRepository()
+	ctx := context.Background()
+	candidate := pettypes.PartnerImportCandidate{
+		Title:             "Rex",
+		Photos:            []string{"http://example.com/rex.jpg"},
+		Availability:      "AVAILABLE",
+		ExternalReference: &pettypes.ExternalReferenceInput{Provider: "partner", ID: "ext-1"},
+	}
+	first, err := NewService(memory).ImportFromPartner(ctx, pettypes.PartnerImportInput{Provider: "acme", EventID: "evt-1", Candidate: candidate})
+	require.NoError(t, err)
+
+	candidate.Title = "Rex II"
+	candidate.ExternalReference = &pettypes.ExternalReferenceInput{Provider: "partner", ID: "ext-1"}
+	racing := &lookupRaceRepository{Repository: memory}
+	second, err := NewService(racing).ImportFromPartner(ctx, pettypes.PartnerImportInput{Provider: "acme", EventID: "evt-2", Candidate: candidate})
+	require.NoError(t, err)
+	require.True(t, racing.missed)
+	require.Equal(t, pettypes.PartnerImportUpdated, second.Outcome)
+	require.Equal(t, first.Projection.Pet.ID, second.Projection.Pet.ID)
+	require.Equal(t, "Rex II", second.Projection.Pet.Name)
+	all, err := memory.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1, "the duplicate delivery must not create a second pet")
+}
+
+func TestImportFromPartner_ParksIncompleteCandidates(t *testing.T) {
+	repo := petmemory.NewRepository()
+	reviews := petmemory.NewImportReviewStore()
+	svc := NewService(repo, WithImportReviewStore(reviews))
+
+	result, err := svc.ImportFromPartner(context.Background(), pettypes.PartnerImportInput{
+		Provider: "acme",
+		EventID:  "evt-3",
+		Candidate: pettypes.PartnerImportCandidate{
+			Title:             "Nameless photos",
+			ExternalReference: &pettypes.ExternalReferenceInput{Provider: "partner", ID: "ext-2"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, pettypes.PartnerImportParked, result.Outcome)
+	require.Equal(t, []string{"photos"}, result.Missing)
+
+	parked, err := reviews.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, parked, 1)
+	require.Equal(t, "evt-3", parked[0].EventID)
+	all, err := repo.List(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, all)
+
+	_, err = NewService(repo).ImportFromPartner(context.Background(), pettypes.PartnerImportInput{
+		Provider:  "acme",
+		Candidate: pettypes.PartnerImportCandidate{ExternalReference: &pettypes.ExternalReferenceInput{ID: "ext-3"}},
+	})
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestImportPets_ReportsRowsAndHonoursModes(t *testing.T) {
+	repo := petmemory.NewRepository()
+	svc := NewService(repo)
+	ctx := context.Background()
+	existing := "Old"
+	photos := []string{"http://example.com/p.jpg"}
+	_, err := svc.AddPet(ctx, pettypes.AddPetInput{PetMutationInput: pettypes.PetMutationInput{ID: 5, Name: &existing, PhotoURLs: &photos}})
+	require.NoError(t, err)
+
+	name := func(v string) *string { return &v }
+	rows := []pettypes.BulkImportRow{
+		{Line: 1, Mutation: pettypes.PetMutationInput{Name: name("Rex"), PhotoURLs: &photos}},
+		{Line: 2, Mutation: pettypes.PetMutationInput{ID: 5, Name: name("New"), PhotoURLs: &photos}},
+		{Line: 3, Mutation: pettypes.PetMutationInput{Name: name("No photos")}},
+		{Line: 4, Err: errors.New("invalid JSON")},
+		{Line: 5, Mutation: pettypes.PetMutationInput{ID: 5, Name: name("Again"), PhotoURLs: &photos}},
+	}
+	run := func(mode pettypes.BulkImportMode, dryRun bool) ([]pettypes.BulkRowResult, pettypes.BulkImportSummary) {
+		var results []pettypes.BulkRowResult
+		summary, err := svc.ImportPets(ctx, pettypes.BulkImportInput{Rows: slices.Values(rows), Mode: mode, DryRun: dryRun, BatchSize: 1},
+			func(result pettypes.BulkRowResult) error {
+				results = append(results, result)
+				return nil
+			})
+		require.NoError(t, err)
+		return results, summary
+	}
+
+	results, summary := run(pettypes.BulkImportUpsert, true)
+	require.Equal(t, pettypes.BulkImportSummary{Total: 5, Valid: 2, Failed: 3, DryRun: true}, summary)
+	require.Contains(t, results[4].Error, "already appears on line 2")
+	all, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1, "dry runs must not write")
+
+	results, summary = run(pettypes.BulkImportCreate, false)
+	require.Equal(t, pettypes.BulkRowCreated, results[0].Status)
+	require.Equal(t, pettypes.BulkRowFailed, results[1].Status)
+	require.Contains(t, results[1].Error, "already exists")
+	require.Equal(t, 1, summary.Created)
+
+	results, summary = run(pettypes.BulkImportUpsert, false)
+	require.Equal(t, pettypes.BulkRowUpdated, results[1].Status)
+	require.Equal(t, 1, summary.Updated)
+	updated, err := repo.GetByID(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, "New", updated.Pet.Name)
+
+	var exported []int64
+	require.NoError(t, svc.ExportPets(ctx, func(p *pettypes.PetProjection) error {
+		exported = append(exported, p.Pet.ID)
+		return nil
+	}))
+	require.Equal(t, []int64{5, 6, 7}, exported)
+}
+
+func TestBatchUpdateStatus_AtomicRejectsAndBestEffortApplies(t *testing.T) {
+	repo := petmemory.NewRepository()
+	syncer := &stubPartnerSync{}
+	svc := NewService(repo, WithPartnerSync(syncer))
+	ctx := context.Background()
+	photos := []string{"http://example.com/p.jpg"}
+	for _, id := range []int64{1, 2} {
+		name := "Pet"
+		_, err := svc.AddPet(ctx, pettypes.AddPetInput{PetMutationInput: pettypes.PetMutationInput{ID: id, Name: &name, PhotoURLs: &photos}})
+		require.NoError(t, err)
+	}
+	syncer.callCount = 0
+
+	got, err := svc.BatchGet(ctx, pettypes.BatchGetInput{IDs: []int64{2, 9, 1, 2}})
+	require.NoError(t, err)
+	require.Equal(t, []int64{9}, got.Missing)
+	require.Equal(t, int64(2), got.Pets[0].Pet.ID)
+	require.Equal(t, int64(1), got.Pets[1].Pet.ID)
+
+	input := pettypes.BatchStatusInput{IDs: []int64{1, 9, 2}, Status: string(domain.StatusSold), Atomic: true}
+	result, err := svc.BatchUpdateStatus(ctx, input)
+	require.ErrorIs(t, err, ErrBatchRejected)
+	require.Equal(t, []pettypes.BatchItemProblem{{ID: 9, Error: "pet not found"}}, result.Problems)
+	unchanged, err := repo.GetByID(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusAvailable, unchanged.Pet.Status, "rejected atomic batches must not write")
+	require.Zero(t, syncer.callCount)
+
+	input.Atomic = false
+	result, err = svc.BatchUpdateStatus(ctx, input)
+	require.NoError(t, err)
+	require.Len(t, result.Updated, 2)
+	require.Equal(t, domain.StatusSold, result.Updated[0].Pet.Status)
+	require.Len(t, result.Problems, 1)
+	require.Equal(t, 2, syncer.callCount)
+
+	result, err = svc.BatchUpdateStatus(ctx, input)
+	require.NoError(t, err)
+	require.Len(t, result.Updated, 2)
+	require.Equal(t, 2, syncer.callCount, "pets already in the target status are not re-synced")
+
+	_, err = svc.BatchUpdateStatus(ctx, pettypes.BatchStatusInput{IDs: []int64{1}, Status: "lost"})
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestMutations_PublishCatalogEvents(t *testing.T) {
+	recorder := &recordingPublisher{}
+	svc := NewService(petmemory.NewRepository(), WithEventPublisher(recorder))
+	ctx := context.Background()
+
+	name := "Rex"
+	photos := []string{"http://example.com/rex.jpg"}
+	tags := []pettypes.TagInput{{Name: "dog"}}
+	_, err := svc.AddPet(ctx, pettypes.AddPetInput{
+		PetMutationInput: pettypes.PetMutationInput{ID: 40, Name: &name, PhotoURLs: &photos, Tags: &tags},
+	})
+	require.NoError(t, err)
+	renamed := "Rexy"
+	_, err = svc.UpdatePetWithForm(ctx, pettypes.UpdatePetWithFormInput{ID: 40, Name: &renamed})
+	require.NoError(t, err)
+	sold := string(domain.StatusSold)
+	_, err = svc.UpdatePetWithForm(ctx, pettypes.UpdatePetWithFormInput{ID: 40, Status: &sold})
+	require.NoError(t, err)
+	require.NoError(t, svc.Delete(ctx, pettypes.PetIdentifier{ID: 40}))
+
+	require.Len(t, recorder.events, 4)
+	require.Equal(t, ports.PetCreated, recorder.events[0].Type)
+	require.Equal(t, []string{"dog"}, recorder.events[0].Tags)
+	require.Equal(t, ports.PetUpdated, recorder.events[1].Type)
+	require.Equal(t, ports.PetStatusChanged, recorder.events[2].Type)
+	require.Equal(t, domain.StatusAvailable, recorder.events[2].PreviousStatus)
+	require.Equal(t, domain.StatusSold, recorder.events[2].Status)
+	require.Equal(t, ports.PetDeleted, recorder.events[3].Type)
+	require.Equal(t, domain.StatusSold, recorder.events[3].Status)
+	require.Nil(t, recorder.events[3].Pet)
+}
+
+type recordingPublisher struct {
+	events []ports.PetEvent
+}
+
+func (r *recordingPublisher) Publish(_ context.Context, event ports.PetEvent) {
+	r.events = append(r.events, event)
+}
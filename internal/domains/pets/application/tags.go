@@ -0,0 +1,101 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	types "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+// TagService manages the tag catalog pets link to.
+type TagService struct {
+	repo ports.TagRepository
+	pets ports.TagReferences
+}
+
+// NewTagService wires the tag catalog with the pet repository that links pets to tags.
+func NewTagService(repo ports.TagRepository, pets ports.TagReferences) *TagService {
+	return &TagService{repo: repo, pets: pets}
+}
+
+// CreateTag adds a tag to the catalog, which assigns its ID.
+func (s *TagService) CreateTag(ctx context.Context, input types.TagMutationInput) (*domain.Tag, error) {
+	tag, err := domain.NewTag(0, input.Name)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	saved, err := s.repo.Save(ctx, tag)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return saved, nil
+}
+
+// RenameTag renames a tag; pets carrying it report the new name on their next read and their
+// update time moves, so their ETags change. Renaming onto another tag's name is rejected, since
+// that is a merge.
+func (s *TagService) RenameTag(ctx context.Context, input types.TagMutationInput) (*domain.Tag, error) {
+	tag, err := s.repo.GetByID(ctx, input.ID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := tag.Rename(input.Name); err != nil {
+		return nil, mapError(err)
+	}
+	saved, err := s.repo.Save(ctx, tag)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := s.pets.TouchByTag(ctx, saved.ID); err != nil {
+		return nil, mapError(err)
+	}
+	return saved, nil
+}
+
+// MergeTags moves the pets of the source tag to the target and removes the source. Pets are
+// moved before the source is deleted, so retrying an interrupted merge completes it; a pet
+// tagged with the source while the merge runs fails it with ErrTagInUse until it is retried.
+func (s *TagService) MergeTags(ctx context.Context, input types.MergeTagsInput) (*types.TagUsage, error) {
+	if input.SourceID == input.TargetID {
+		return nil, fmt.Errorf("%w: %d", ErrTagMergeIntoSelf, input.SourceID)
+	}
+	if _, err := s.repo.GetByID(ctx, input.SourceID); err != nil {
+		return nil, mapError(err)
+	}
+	target, err := s.repo.GetByID(ctx, input.TargetID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	if err := s.pets.ReassignTag(ctx, input.SourceID, input.TargetID); err != nil {
+		return nil, mapError(err)
+	}
+	if err := s.repo.Delete(ctx, input.SourceID); err != nil {
+		return nil, mapError(err)
+	}
+	counts, err := s.pets.CountByTag(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &types.TagUsage{Tag: target, PetCount: counts[target.ID]}, nil
+}
+
+// ListTags returns the catalog ordered by ID with the number of pets carrying each tag.
+func (s *TagService) ListTags(ctx context.Context) ([]types.TagUsage, error) {
+	tags, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	counts, err := s.pets.CountByTag(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	usage := make([]types.TagUsage, 0, len(tags))
+	for _, tag := range tags {
+		usage = append(usage, types.TagUsage{Tag: tag, PetCount: counts[tag.ID]})
+	}
+	return usage, nil
+}
+
+var _ ports.TagService = (*TagService)(nil)
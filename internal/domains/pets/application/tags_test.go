@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	petmemory "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+)
+
+func newTagFixture() (*TagService, *Service) {
+	tags := petmemory.NewTagRepository()
+	pets := petmemory.NewRepository()
+	pets.WithTags(tags)
+	clock := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	pets.WithClock(func() time.Time {
+		clock = clock.Add(time.Second)
+		return clock
+	})
+	return NewTagService(tags, pets), NewService(pets, WithTags(tags))
+}
+
+func addTaggedPet(t *testing.T, svc *Service, id int64, tags ...pettypes.TagInput) {
+	t.Helper()
+	name := "Pet"
+	photos := []string{"http://example.com/pet.jpg"}
+	_, err := svc.AddPet(context.Background(), pettypes.AddPetInput{PetMutationInput: pettypes.PetMutationInput{
+		ID: id, Name: &name, PhotoURLs: &photos, Tags: &tags,
+	}})
+	require.NoError(t, err)
+}
+
+func TestTagService_CreateAndRename(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTagFixture()
+
+	friendly, err := svc.CreateTag(ctx, pettypes.TagMutationInput{ID: 42, Name: " Friendly "})
+	require.NoError(t, err)
+	require.Equal(t, &domain.Tag{ID: 1, Name: "friendly"}, friendly, "the catalog assigns IDs and normalizes names")
+	trained, err := svc.CreateTag(ctx, pettypes.TagMutationInput{Name: "Trained"})
+	require.NoError(t, err)
+
+	_, err = svc.CreateTag(ctx, pettypes.TagMutationInput{Name: "FRIENDLY"})
+	require.ErrorIs(t, err, ports.ErrTagNameTaken)
+	_, err = svc.CreateTag(ctx, pettypes.TagMutationInput{Name: " "})
+	require.ErrorIs(t, err, domain.ErrEmptyTagName)
+
+	_, err = svc.RenameTag(ctx, pettypes.TagMutationInput{ID: trained.ID, Name: "friendly"})
+	require.ErrorIs(t, err, ports.ErrTagNameTaken)
+	_, err = svc.RenameTag(ctx, pettypes.TagMutationInput{ID: 99, Name: "ghost"})
+	require.ErrorIs(t, err, ports.ErrTagNotFound)
+	renamed, err := svc.RenameTag(ctx, pettypes.TagMutationInput{ID: trained.ID, Name: "Obedient"})
+	require.NoError(t, err)
+	require.Equal(t, "obedient", renamed.Name)
+}
+
+func TestTagService_MergeMovesPets(t *testing.T) {
+	ctx := context.Background()
+	svc, pets := newTagFixture()
+
+	addTaggedPet(t, pets, 1, pettypes.TagInput{Name: "Friendly"}, pettypes.TagInput{Name: "Nice"})
+	addTaggedPet(t, pets, 2, pettypes.TagInput{Name: "nice"})
+	addTaggedPet(t, pets, 3, pettypes.TagInput{Name: "lazy"})
+
+	usage, err := svc.ListTags(ctx)
+	require.NoError(t, err)
+	require.Len(t, usage, 3)
+	require.Equal(t, pettypes.TagUsage{Tag: &domain.Tag{ID: 2, Name: "nice"}, PetCount: 2}, usage[1], "pets create missing tags")
+
+	_, err = svc.MergeTags(ctx, pettypes.MergeTagsInput{SourceID: 1, TargetID: 1})
+	require.ErrorIs(t, err, ErrTagMergeIntoSelf)
+	_, err = svc.MergeTags(ctx, pettypes.MergeTagsInput{SourceID: 1, TargetID: 99})
+	require.ErrorIs(t, err, ports.ErrTagNotFound)
+
+	merged, err := svc.MergeTags(ctx, pettypes.MergeTagsInput{SourceID: 2, TargetID: 1})
+	require.NoError(t, err)
+	require.Equal(t, &pettypes.TagUsage{Tag: &domain.Tag{ID: 1, Name: "friendly"}, PetCount: 2}, merged)
+
+	proj, err := pets.GetByID(ctx, pettypes.PetIdentifier{ID: 1})
+	require.NoError(t, err)
+	require.Equal(t, []domain.Tag{{ID: 1, Name: "friendly"}}, proj.Pet.Tags, "a pet carrying both tags keeps one")
+
+	usage, err = svc.ListTags(ctx)
+	require.NoError(t, err)
+	require.Len(t, usage, 2)
+}
+
+func TestPetMutation_ResolvesTagIDs(t *testing.T) {
+	ctx := context.Background()
+	svc, pets := newTagFixture()
+
+	friendly, err := svc.CreateTag(ctx, pettypes.TagMutationInput{Name: "friendly"})
+	require.NoError(t, err)
+
+	name := "Rex"
+	photos := []string{"http://example.com/rex.jpg"}
+	tags := []pettypes.TagInput{{ID: 99}}
+	_, err = pets.AddPet(ctx, pettypes.AddPetInput{PetMutationInput: pettypes.PetMutationInput{
+		ID: 1, Name: &name, PhotoURLs: &photos, Tags: &tags,
+	}})
+	require.ErrorIs(t, err, ErrUnknownTag)
+
+	addTaggedPet(t, pets, 1, pettypes.TagInput{ID: friendly.ID}, pettypes.TagInput{Name: "Trained"})
+	before, err := pets.GetByID(ctx, pettypes.PetIdentifier{ID: 1})
+	require.NoError(t, err)
+	_, err = svc.RenameTag(ctx, pettypes.TagMutationInput{ID: friendly.ID, Name: "gentle"})
+	require.NoError(t, err)
+	proj, err := pets.GetByID(ctx, pettypes.PetIdentifier{ID: 1})
+	require.NoError(t, err)
+	require.Equal(t, []domain.Tag{{ID: friendly.ID, Name: "gentle"}, {ID: 2, Name: "trained"}}, proj.Pet.Tags)
+	require.True(t, proj.Metadata.UpdatedAt.After(before.Metadata.UpdatedAt), "a rename changes the pet's version")
+}
+
+func TestFindByTags_MatchModes(t *testing.T) {
+	ctx := context.Background()
+	_, pets := newTagFixture()
+
+	addTaggedPet(t, pets, 1, pettypes.TagInput{Name: "friendly"}, pettypes.TagInput{Name: "trained"})
+	addTaggedPet(t, pets, 2, pettypes.TagInput{Name: "friendly"})
+
+	anyOf, err := pets.FindByTags(ctx, pettypes.FindPetsByTagsInput{Tags: []string{"Friendly", "trained"}})
+	require.NoError(t, err)
+	require.Len(t, anyOf, 2)
+
+	allOf, err := pets.FindByTags(ctx, pettypes.FindPetsByTagsInput{Tags: []string{"Friendly", "trained", ""}, Match: pettypes.TagMatchAll})
+	require.NoError(t, err)
+	require.Len(t, allOf, 1)
+	require.Equal(t, int64(1), allOf[0].Pet.ID)
+
+	_, err = pets.FindByTags(ctx, pettypes.FindPetsByTagsInput{Tags: []string{"friendly"}, Match: "most"})
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
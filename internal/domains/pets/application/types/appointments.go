@@ -0,0 +1,36 @@
+package types
+
+import "time"
+
+// ScheduleAppointmentInput books a groomer for a pet.
+type ScheduleAppointmentInput struct {
+	PetID           int64
+	Groomer         string
+	Start           time.Time
+	End             time.Time
+	RequestedTrimCm float64
+}
+
+// RescheduleAppointmentInput moves an appointment to another slot with the same groomer.
+type RescheduleAppointmentInput struct {
+	ID    int64
+	Start time.Time
+	End   time.Time
+}
+
+// CompleteAppointmentInput carries the measurement taken at the appointment. TrimByCm defaults
+// to the trim requested when the appointment was booked.
+type CompleteAppointmentInput struct {
+	ID                  int64
+	InitialHairLengthCm float64
+	TrimByCm            *float64
+}
+
+// AppointmentFilter narrows appointment listings; zero fields match everything. From and To
+// select appointments overlapping that window.
+type AppointmentFilter struct {
+	PetID   int64
+	Groomer string
+	From    time.Time
+	To      time.Time
+}
@@ -0,0 +1,51 @@
+package types
+
+import "github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+
+// MaxBatchSize caps the number of ids accepted by a single batch request.
+const MaxBatchSize = 1000
+
+// BatchGetInput lists the pets to load in one round trip.
+type BatchGetInput struct {
+	IDs []int64
+}
+
+// BatchGetResult holds the pets that were found, in request order, and the ids that were not.
+type BatchGetResult struct {
+	Pets    []*PetProjection
+	Missing []int64
+}
+
+// BatchStatusInput moves many pets to the same lifecycle status. Atomic requests are rejected as a
+// whole when any item fails; otherwise the valid items are written and the rest reported.
+type BatchStatusInput struct {
+	IDs    []int64
+	Status string
+	Atomic bool
+}
+
+// BatchItemProblem explains why a single item of a batch was not (fully) applied.
+type BatchItemProblem struct {
+	ID    int64  `json:"id"`
+	Error string `json:"error"`
+}
+
+// BatchStatusResult reports the outcome of a batch status change.
+type BatchStatusResult struct {
+	// Updated holds every pet that now has the requested status, including those that already had it.
+	Updated []*PetProjection
+	// Problems lists items that were not written.
+	Problems []BatchItemProblem
+	// Warnings lists partner sync failures for items that were written.
+	Warnings []BatchItemProblem
+}
+
+// StatusUpdate is what a repository reports after moving a batch of pets to one status.
+type StatusUpdate struct {
+	// Pets holds every pet found among the ids, in request order, as stored after the update.
+	Pets []*PetProjection
+	// Previous maps the ids whose status changed to the status they had before.
+	Previous map[int64]domain.Status
+	// Missing lists the ids that do not exist.
+	Missing []int64
+}
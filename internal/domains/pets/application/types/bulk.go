@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// BulkImportMode decides what happens to rows whose id already exists.
+type BulkImportMode string
+
+const (
+	// BulkImportCreate rejects rows that target an existing pet.
+	BulkImportCreate BulkImportMode = "create"
+	// BulkImportUpsert replaces existing pets and creates the rest.
+	BulkImportUpsert BulkImportMode = "upsert"
+)
+
+// DefaultBulkBatchSize is the number of rows written per repository batch.
+const DefaultBulkBatchSize = 500
+
+// ParseBulkImportMode accepts "create" (the default when empty) or "upsert".
+func ParseBulkImportMode(raw string) (BulkImportMode, error) {
+	switch BulkImportMode(strings.ToLower(strings.TrimSpace(raw))) {
+	case "", BulkImportCreate:
+		return BulkImportCreate, nil
+	case BulkImportUpsert:
+		return BulkImportUpsert, nil
+	default:
+		return "", fmt.Errorf("unknown bulk import mode %q", raw)
+	}
+}
+
+// BulkImportRow is one decoded input row; Err carries decoding failures so they are reported
+// alongside validation errors instead of aborting the import.
+type BulkImportRow struct {
+	Line     int
+	Mutation PetMutationInput
+	Err      error
+}
+
+// BulkImportInput configures a bulk import.
+type BulkImportInput struct {
+	Rows      iter.Seq[BulkImportRow]
+	Mode      BulkImportMode
+	DryRun    bool
+	BatchSize int
+}
+
+// BulkRowStatus classifies the outcome of a single row.
+type BulkRowStatus string
+
+const (
+	BulkRowCreated BulkRowStatus = "created"
+	BulkRowUpdated BulkRowStatus = "updated"
+	// BulkRowValid marks a row that passed validation during a dry run.
+	BulkRowValid  BulkRowStatus = "valid"
+	BulkRowFailed BulkRowStatus = "failed"
+)
+
+// BulkRowResult reports what happened to one row.
+type BulkRowResult struct {
+	Line   int           `json:"line"`
+	ID     int64         `json:"id,omitempty"`
+	Status BulkRowStatus `json:"status"`
+	Error  string        `json:"error,omitempty"`
+	// Warning reports partner sync failures for rows that were saved.
+	Warning string `json:"warning,omitempty"`
+}
+
+// BulkImportSummary totals the row results.
+type BulkImportSummary struct {
+	Total   int  `json:"total"`
+	Created int  `json:"created"`
+	Updated int  `json:"updated"`
+	Valid   int  `json:"valid"`
+	Failed  int  `json:"failed"`
+	DryRun  bool `json:"dryRun"`
+}
+
+// Add counts result into the summary.
+func (s *BulkImportSummary) Add(result BulkRowResult) {
+	s.Total++
+	switch result.Status {
+	case BulkRowCreated:
+		s.Created++
+	case BulkRowUpdated:
+		s.Updated++
+	case BulkRowValid:
+		s.Valid++
+	case BulkRowFailed:
+		s.Failed++
+	}
+}
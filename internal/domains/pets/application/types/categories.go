@@ -0,0 +1,9 @@
+package types
+
+// CategoryMutationInput creates or replaces a managed category. ParentID nests it under another
+// category; nil or zero makes it top-level.
+type CategoryMutationInput struct {
+	ID       int64
+	Name     string
+	ParentID *int64
+}
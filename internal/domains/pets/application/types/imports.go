@@ -20,6 +20,29 @@ type PartnerImportCandidate struct {
 	ExternalReference *ExternalReferenceInput
 }
 
+// PartnerImportInput carries a partner-originated pet change into the application layer.
+type PartnerImportInput struct {
+	Provider  string
+	EventID   string
+	Candidate PartnerImportCandidate
+}
+
+// PartnerImportOutcome describes what an import did with the candidate.
+type PartnerImportOutcome string
+
+const (
+	PartnerImportCreated PartnerImportOutcome = "created"
+	PartnerImportUpdated PartnerImportOutcome = "updated"
+	PartnerImportParked  PartnerImportOutcome = "parked"
+)
+
+// PartnerImportResult reports the outcome of a partner import.
+type PartnerImportResult struct {
+	Outcome    PartnerImportOutcome
+	Projection *PetProjection
+	Missing    []string
+}
+
 // MissingFields lists which mandatory fields are absent to materialize a domain pet.
 func (c PartnerImportCandidate) MissingFields() []string {
 	var missing []string
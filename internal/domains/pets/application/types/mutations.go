@@ -29,6 +29,11 @@ type PetMutationInput struct {
 	Status            *string
 	HairLengthCm      *float64
 	ExternalReference *ExternalReferenceInput
+	// ClearCategory, ClearTags, and ClearExternalReference remove the field explicitly (e.g. a
+	// patch setting it to null); they cannot be combined with a value for the same field.
+	ClearCategory          bool
+	ClearTags              bool
+	ClearExternalReference bool
 }
 
 // AddPetInput captures the request to add a new pet into the catalog.
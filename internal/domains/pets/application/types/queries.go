@@ -5,9 +5,18 @@ type FindPetsByStatusInput struct {
 	Statuses []string
 }
 
-// FindPetsByTagsInput filters pets by tag names.
+// TagMatch selects whether a tag search returns pets carrying any or all of the tags.
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
+// FindPetsByTagsInput filters pets by tag names; an empty Match means TagMatchAny.
 type FindPetsByTagsInput struct {
-	Tags []string
+	Tags  []string
+	Match TagMatch
 }
 
 // PetIdentifier references a pet by its aggregate ID.
@@ -0,0 +1,123 @@
+package types
+
+import "time"
+
+// ReconciliationStatus classifies how a local pet compares with a partner's copy.
+type ReconciliationStatus string
+
+const (
+	ReconciliationInSync         ReconciliationStatus = "in_sync"
+	ReconciliationDrifted        ReconciliationStatus = "drifted"
+	ReconciliationMissingRemote  ReconciliationStatus = "missing_remote"
+	ReconciliationOrphanedRemote ReconciliationStatus = "orphaned_remote"
+	ReconciliationFailed         ReconciliationStatus = "failed"
+)
+
+// Repairable reports whether re-syncing the local pet would fix the record.
+func (s ReconciliationStatus) Repairable() bool {
+	return s == ReconciliationDrifted || s == ReconciliationMissingRemote
+}
+
+// ReconciliationRecord is one (pet, provider) comparison; orphaned records have no PetID.
+type ReconciliationRecord struct {
+	Provider    string               `json:"provider"`
+	PetID       int64                `json:"petId,omitempty"`
+	Reference   string               `json:"reference"`
+	Status      ReconciliationStatus `json:"status"`
+	Differences []string             `json:"differences,omitempty"`
+	Error       string               `json:"error,omitempty"`
+	Repaired    bool                 `json:"repaired,omitempty"`
+	RepairError string               `json:"repairError,omitempty"`
+}
+
+// ReconciliationSummary counts record statuses for a provider.
+type ReconciliationSummary struct {
+	Provider       string `json:"provider"`
+	InSync         int    `json:"inSync"`
+	Drifted        int    `json:"drifted"`
+	MissingRemote  int    `json:"missingRemote"`
+	OrphanedRemote int    `json:"orphanedRemote"`
+	Failed         int    `json:"failed"`
+	Repaired       int    `json:"repaired"`
+}
+
+// MaxReportRecords caps the records a workflow report carries, keeping it under Temporal's
+// payload limit; the summaries still count every record.
+const MaxReportRecords = 1000
+
+// ReconciliationReport is the machine-readable outcome of a reconciliation run.
+// In-sync records are counted in the summary but omitted from Records.
+type ReconciliationReport struct {
+	StartedAt  time.Time               `json:"startedAt"`
+	FinishedAt time.Time               `json:"finishedAt"`
+	Repair     bool                    `json:"repair"`
+	Summaries  []ReconciliationSummary `json:"summaries"`
+	Records    []ReconciliationRecord  `json:"records"`
+	// OmittedRecords counts records left out of Records once it reached MaxReportRecords.
+	OmittedRecords int `json:"omittedRecords,omitempty"`
+}
+
+// Summarize recounts every status except in-sync (which has no record) from Records.
+// Providers missing from Summaries are appended in the order they first appear.
+func (r *ReconciliationReport) Summarize() {
+	for i := range r.Summaries {
+		r.Summaries[i] = ReconciliationSummary{Provider: r.Summaries[i].Provider, InSync: r.Summaries[i].InSync}
+	}
+	for _, record := range r.Records {
+		r.count(record)
+	}
+}
+
+// Add counts the record in its provider summary and keeps it while fewer than limit records are
+// held; later records are only counted, in OmittedRecords as well.
+func (r *ReconciliationReport) Add(record ReconciliationRecord, limit int) {
+	r.count(record)
+	if len(r.Records) >= limit {
+		r.OmittedRecords++
+		return
+	}
+	r.Records = append(r.Records, record)
+}
+
+// AddInSync counts pets that match the provider's copy.
+func (r *ReconciliationReport) AddInSync(provider string, n int) {
+	r.summary(provider).InSync += n
+}
+
+// Unrepaired reports whether any drifted, missing, orphaned, or failed record was left unrepaired,
+// including records omitted from Records.
+func (r *ReconciliationReport) Unrepaired() bool {
+	for _, summary := range r.Summaries {
+		if summary.Drifted+summary.MissingRemote+summary.OrphanedRemote+summary.Failed > summary.Repaired {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *ReconciliationReport) count(record ReconciliationRecord) {
+	summary := r.summary(record.Provider)
+	switch record.Status {
+	case ReconciliationDrifted:
+		summary.Drifted++
+	case ReconciliationMissingRemote:
+		summary.MissingRemote++
+	case ReconciliationOrphanedRemote:
+		summary.OrphanedRemote++
+	case ReconciliationFailed:
+		summary.Failed++
+	}
+	if record.Repaired {
+		summary.Repaired++
+	}
+}
+
+func (r *ReconciliationReport) summary(provider string) *ReconciliationSummary {
+	for i := range r.Summaries {
+		if r.Summaries[i].Provider == provider {
+			return &r.Summaries[i]
+		}
+	}
+	r.Summaries = append(r.Summaries, ReconciliationSummary{Provider: provider})
+	return &r.Summaries[len(r.Summaries)-1]
+}
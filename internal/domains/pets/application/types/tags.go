@@ -0,0 +1,22 @@
+package types
+
+import "github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+
+// TagMutationInput creates or renames a catalog tag. The catalog assigns IDs, so ID only
+// selects the tag to rename.
+type TagMutationInput struct {
+	ID   int64
+	Name string
+}
+
+// MergeTagsInput moves every pet from SourceID to TargetID and removes the source tag.
+type MergeTagsInput struct {
+	SourceID int64
+	TargetID int64
+}
+
+// TagUsage is a catalog tag with the number of pets carrying it.
+type TagUsage struct {
+	Tag      *domain.Tag
+	PetCount int64
+}
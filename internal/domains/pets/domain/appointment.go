@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// AppointmentStatus represents the lifecycle of a grooming appointment.
+type AppointmentStatus string
+
+const (
+	AppointmentScheduled AppointmentStatus = "scheduled"
+	AppointmentCancelled AppointmentStatus = "cancelled"
+	AppointmentCompleted AppointmentStatus = "completed"
+)
+
+// TimeSlot is the half-open interval [Start, End) a groomer is booked for, so back-to-back
+// appointments do not overlap.
+type TimeSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Appointment books a groomer for a pet. Only scheduled appointments occupy the groomer's
+// calendar; cancelled and completed ones are kept as history.
+type Appointment struct {
+	ID              int64
+	PetID           int64
+	Groomer         string
+	Slot            TimeSlot
+	RequestedTrimCm float64
+	Status          AppointmentStatus
+	// RemindedAt is set once the reminder for the current slot went out; rescheduling clears it.
+	RemindedAt  *time.Time
+	CompletedAt *time.Time
+}
+
+// GroomingRecord keeps the measurements taken when an appointment was completed.
+type GroomingRecord struct {
+	AppointmentID   int64
+	PetID           int64
+	Groomer         string
+	InitialLengthCm float64
+	TrimByCm        float64
+	ResultLengthCm  float64
+	GroomedAt       time.Time
+}
+
+var (
+	ErrEmptyGroomer         = errors.New("groomer is required")
+	ErrInvalidTimeSlot      = errors.New("time slot must end after it starts")
+	ErrInvalidRequestedTrim = errors.New("requested trim must be greater or equal to zero")
+	ErrAppointmentClosed    = errors.New("appointment is no longer scheduled")
+)
+
+// NewTimeSlot validates that the slot ends after it starts.
+func NewTimeSlot(start, end time.Time) (TimeSlot, error) {
+	if start.IsZero() || !end.After(start) {
+		return TimeSlot{}, ErrInvalidTimeSlot
+	}
+	return TimeSlot{Start: start.UTC(), End: end.UTC()}, nil
+}
+
+// Overlaps reports whether both slots share any instant.
+func (s TimeSlot) Overlaps(other TimeSlot) bool {
+	return s.Start.Before(other.End) && other.Start.Before(s.End)
+}
+
+// NewAppointment validates the invariants and builds a scheduled appointment.
+func NewAppointment(id, petID int64, groomer string, slot TimeSlot, requestedTrimCm float64) (*Appointment, error) {
+	groomer = strings.TrimSpace(groomer)
+	if groomer == "" {
+		return nil, ErrEmptyGroomer
+	}
+	if !slot.End.After(slot.Start) {
+		return nil, ErrInvalidTimeSlot
+	}
+	if requestedTrimCm < 0 {
+		return nil, ErrInvalidRequestedTrim
+	}
+	return &Appointment{
+		ID:              id,
+		PetID:           petID,
+		Groomer:         groomer,
+		Slot:            slot,
+		RequestedTrimCm: requestedTrimCm,
+		Status:          AppointmentScheduled,
+	}, nil
+}
+
+// Conflicts reports whether both appointments hold the same groomer at the same time.
+func (a *Appointment) Conflicts(other *Appointment) bool {
+	return a.ID != other.ID &&
+		a.Status == AppointmentScheduled && other.Status == AppointmentScheduled &&
+		a.Groomer == other.Groomer &&
+		a.Slot.Overlaps(other.Slot)
+}
+
+// Reschedule moves a scheduled appointment to another slot; its reminder is due again.
+func (a *Appointment) Reschedule(slot TimeSlot) error {
+	if a.Status != AppointmentScheduled {
+		return ErrAppointmentClosed
+	}
+	if !slot.End.After(slot.Start) {
+		return ErrInvalidTimeSlot
+	}
+	a.Slot = slot
+	a.RemindedAt = nil
+	return nil
+}
+
+// Cancel frees the groomer's slot.
+func (a *Appointment) Cancel() error {
+	if a.Status != AppointmentScheduled {
+		return ErrAppointmentClosed
+	}
+	a.Status = AppointmentCancelled
+	return nil
+}
+
+// Complete closes a scheduled appointment at the given time.
+func (a *Appointment) Complete(at time.Time) error {
+	if a.Status != AppointmentScheduled {
+		return ErrAppointmentClosed
+	}
+	completedAt := at.UTC()
+	a.Status = AppointmentCompleted
+	a.CompletedAt = &completedAt
+	return nil
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// Category groups pets in the catalog. Categories are managed on their own and may nest under a
+// parent; pets reference them by ID and only carry the name as a read model.
+type Category struct {
+	ID       int64
+	Name     string
+	ParentID *int64
+}
+
+var (
+	ErrEmptyCategoryName = errors.New("category name is required")
+	ErrCategoryCycle     = errors.New("a category cannot be nested under itself or one of its sub-categories")
+)
+
+// NewCategory validates the invariants and builds a new Category aggregate.
+func NewCategory(id int64, name string, parentID *int64) (*Category, error) {
+	c := &Category{ID: id}
+	if err := c.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := c.MoveUnder(parentID); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Rename changes the category name; pets pick it up on their next read.
+func (c *Category) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyCategoryName
+	}
+	c.Name = name
+	return nil
+}
+
+// MoveUnder nests the category under parentID; nil or zero makes it a top-level category.
+// Cycles through other categories are checked by the application, which can load ancestors.
+func (c *Category) MoveUnder(parentID *int64) error {
+	if parentID == nil || *parentID == 0 {
+		c.ParentID = nil
+		return nil
+	}
+	if c.ID != 0 && *parentID == c.ID {
+		return ErrCategoryCycle
+	}
+	parent := *parentID
+	c.ParentID = &parent
+	return nil
+}
@@ -14,18 +14,6 @@ const (
 	StatusSold      Status = "sold"
 )
 
-// Category groups pets in the catalog.
-type Category struct {
-	ID   int64
-	Name string
-}
-
-// Tag is a lightweight marker attached to pets for filtering.
-type Tag struct {
-	ID   int64
-	Name string
-}
-
 // ExternalReference captures how the domain pet links to external providers.
 type ExternalReference struct {
 	Provider   string
@@ -49,6 +37,8 @@ type Pet struct {
 	Status       Status
 	HairLengthCm float64
 	ExternalRef  *ExternalReference
+	// PartnerRefs links the pet to providers other than the primary ExternalRef provider.
+	PartnerRefs []ExternalReference
 }
 
 var (
@@ -134,7 +124,7 @@ func (p *Pet) ReplaceTags(tags []Tag) {
 	seen := map[string]struct{}{}
 	normalized := make([]Tag, 0, len(tags))
 	for _, t := range tags {
-		name := strings.ToLower(strings.TrimSpace(t.Name))
+		name := NormalizeTagName(t.Name)
 		tag := Tag{ID: t.ID, Name: name}
 		if name != "" {
 			if _, exists := seen[name]; exists {
@@ -169,13 +159,7 @@ func (p *Pet) UpdateExternalReference(ref *ExternalReference) {
 		p.ExternalRef = nil
 		return
 	}
-	copy := ExternalReference{Provider: ref.Provider, ID: ref.ID}
-	if len(ref.Attributes) > 0 {
-		copy.Attributes = make(map[string]string, len(ref.Attributes))
-		for k, v := range ref.Attributes {
-			copy.Attributes[k] = v
-		}
-	}
+	copy := ref.clone()
 	p.ExternalRef = &copy
 }
 
@@ -184,12 +168,59 @@ func (p *Pet) SnapshotExternalReference() *ExternalReference {
 	if p.ExternalRef == nil {
 		return nil
 	}
-	copy := ExternalReference{Provider: p.ExternalRef.Provider, ID: p.ExternalRef.ID}
-	if len(p.ExternalRef.Attributes) > 0 {
-		copy.Attributes = make(map[string]string, len(p.ExternalRef.Attributes))
-		for k, v := range p.ExternalRef.Attributes {
+	copy := p.ExternalRef.clone()
+	return &copy
+}
+
+// SnapshotPartnerReferences returns defensive copies of the secondary provider references.
+func (p *Pet) SnapshotPartnerReferences() []ExternalReference {
+	if len(p.PartnerRefs) == 0 {
+		return nil
+	}
+	refs := make([]ExternalReference, 0, len(p.PartnerRefs))
+	for _, ref := range p.PartnerRefs {
+		refs = append(refs, ref.clone())
+	}
+	return refs
+}
+
+// ExternalReferenceFor returns a copy of the reference held for provider, or nil when the pet is not linked to it.
+func (p *Pet) ExternalReferenceFor(provider string) *ExternalReference {
+	if p.ExternalRef != nil && p.ExternalRef.Provider == provider {
+		return p.SnapshotExternalReference()
+	}
+	for _, ref := range p.PartnerRefs {
+		if ref.Provider == provider {
+			copy := ref.clone()
+			return &copy
+		}
+	}
+	return nil
+}
+
+// LinkExternalReference upserts the reference for ref.Provider.
+// The first provider linked becomes the primary ExternalRef; later providers are kept in PartnerRefs.
+func (p *Pet) LinkExternalReference(ref ExternalReference) {
+	if p.ExternalRef == nil || p.ExternalRef.Provider == ref.Provider {
+		p.UpdateExternalReference(&ref)
+		return
+	}
+	for i := range p.PartnerRefs {
+		if p.PartnerRefs[i].Provider == ref.Provider {
+			p.PartnerRefs[i] = ref.clone()
+			return
+		}
+	}
+	p.PartnerRefs = append(p.PartnerRefs, ref.clone())
+}
+
+func (r ExternalReference) clone() ExternalReference {
+	copy := ExternalReference{Provider: r.Provider, ID: r.ID}
+	if len(r.Attributes) > 0 {
+		copy.Attributes = make(map[string]string, len(r.Attributes))
+		for k, v := range r.Attributes {
 			copy.Attributes[k] = v
 		}
 	}
-	return &copy
+	return copy
 }
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// Tag is a lightweight marker attached to pets for filtering. Tags live in a catalog that
+// assigns their IDs; names are stored lower-cased so lookups ignore case.
+type Tag struct {
+	ID   int64
+	Name string
+}
+
+var ErrEmptyTagName = errors.New("tag name is required")
+
+// NormalizeTagName trims and lower-cases a tag name, the form tags are stored and matched in.
+func NormalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// NewTag validates the invariants and builds a catalog Tag.
+func NewTag(id int64, name string) (*Tag, error) {
+	t := &Tag{ID: id}
+	if err := t.Rename(name); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Rename changes the tag name; pets carrying the tag pick it up on their next read.
+func (t *Tag) Rename(name string) error {
+	name = NormalizeTagName(name)
+	if name == "" {
+		return ErrEmptyTagName
+	}
+	t.Name = name
+	return nil
+}
@@ -0,0 +1,66 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+var (
+	// ErrAppointmentNotFound indicates no grooming appointment has the requested ID.
+	ErrAppointmentNotFound = errors.New("grooming appointment not found")
+	// ErrGroomerUnavailable indicates the groomer already has a scheduled appointment overlapping the slot.
+	ErrGroomerUnavailable = errors.New("groomer already has an appointment in that slot")
+)
+
+// AppointmentRepository stores grooming appointments and the grooming history they produce.
+type AppointmentRepository interface {
+	// Save inserts or updates an appointment, assigning an ID to new ones. A scheduled appointment
+	// overlapping another scheduled appointment of the same groomer is rejected with
+	// ErrGroomerUnavailable; the check and the write happen atomically. Updating an appointment
+	// whose stored status is no longer scheduled fails with domain.ErrAppointmentClosed.
+	Save(ctx context.Context, appointment *domain.Appointment) (*domain.Appointment, error)
+	GetByID(ctx context.Context, id int64) (*domain.Appointment, error)
+	// List returns the appointments matching filter ordered by start time.
+	List(ctx context.Context, filter pettypes.AppointmentFilter) ([]*domain.Appointment, error)
+	// MarkReminded sets RemindedAt unless the appointment was closed or moved away from slotStart
+	// since the reminder was sent for it.
+	MarkReminded(ctx context.Context, id int64, slotStart, at time.Time) error
+	// RecordGrooming stores the measurements of a completed appointment, replacing an earlier
+	// record for the same appointment.
+	RecordGrooming(ctx context.Context, record domain.GroomingRecord) error
+	// GroomingHistory returns a pet's grooming records, oldest first.
+	GroomingHistory(ctx context.Context, petID int64) ([]domain.GroomingRecord, error)
+}
+
+// GroomingReminderScheduler arranges for the reminder of an appointment to be sent at remindAt.
+type GroomingReminderScheduler interface {
+	// ScheduleReminder replaces any pending reminder of the appointment.
+	ScheduleReminder(ctx context.Context, appointmentID int64, remindAt time.Time) error
+	// CancelReminder drops the pending reminder; it is not an error when none is pending.
+	CancelReminder(ctx context.Context, appointmentID int64) error
+}
+
+// GroomingReminderSender delivers a due reminder. Schedulers call it when the timer fires.
+type GroomingReminderSender interface {
+	SendGroomingReminder(ctx context.Context, appointmentID int64) error
+}
+
+// GroomingReminderNotifier tells the owner about an upcoming appointment.
+type GroomingReminderNotifier interface {
+	NotifyGroomingReminder(ctx context.Context, appointment *domain.Appointment) error
+}
+
+// AppointmentService defines the grooming appointment use cases exposed to adapters.
+type AppointmentService interface {
+	ScheduleAppointment(ctx context.Context, input pettypes.ScheduleAppointmentInput) (*domain.Appointment, error)
+	RescheduleAppointment(ctx context.Context, input pettypes.RescheduleAppointmentInput) (*domain.Appointment, error)
+	CancelAppointment(ctx context.Context, id int64) (*domain.Appointment, error)
+	CompleteAppointment(ctx context.Context, input pettypes.CompleteAppointmentInput) (*domain.Appointment, error)
+	GetAppointment(ctx context.Context, id int64) (*domain.Appointment, error)
+	ListAppointments(ctx context.Context, filter pettypes.AppointmentFilter) ([]*domain.Appointment, error)
+	GroomingHistory(ctx context.Context, petID int64) ([]domain.GroomingRecord, error)
+}
@@ -0,0 +1,54 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+var (
+	// ErrCategoryNotFound indicates no category has the requested ID or name.
+	ErrCategoryNotFound = errors.New("category not found")
+	// ErrCategoryIDTaken indicates a category is created with the ID of an existing one.
+	ErrCategoryIDTaken = errors.New("category id already exists")
+	// ErrCategoryNameTaken indicates another category already uses the name, compared case-insensitively.
+	ErrCategoryNameTaken = errors.New("category name already exists")
+	// ErrCategoryInUse indicates pets or sub-categories still reference the category.
+	ErrCategoryInUse = errors.New("category is still referenced")
+)
+
+// CategoryRepository stores managed categories.
+type CategoryRepository interface {
+	// Create inserts a new category, assigning an ID unless one is given. It returns
+	// ErrCategoryIDTaken when the given ID exists and ErrCategoryNameTaken when the name is used.
+	Create(ctx context.Context, category *domain.Category) (*domain.Category, error)
+	// Save inserts or updates a category, assigning an ID to new ones. It returns
+	// ErrCategoryNameTaken when the name is used by another category.
+	Save(ctx context.Context, category *domain.Category) (*domain.Category, error)
+	GetByID(ctx context.Context, id int64) (*domain.Category, error)
+	// FindByName matches the name case-insensitively or returns ErrCategoryNotFound.
+	FindByName(ctx context.Context, name string) (*domain.Category, error)
+	// List returns every category ordered by ID.
+	List(ctx context.Context) ([]*domain.Category, error)
+	// Delete removes a category, returning ErrCategoryInUse while pets or sub-categories reference it.
+	Delete(ctx context.Context, id int64) error
+}
+
+// CategoryReferences is implemented by pet repositories that can tell whether pets use a category.
+type CategoryReferences interface {
+	CountByCategory(ctx context.Context, categoryID int64) (int64, error)
+	// TouchByCategory bumps the update time of every pet filed under the category, so cached
+	// representations that embed the category name are revalidated.
+	TouchByCategory(ctx context.Context, categoryID int64) error
+}
+
+// CategoryService defines the category management use cases exposed to adapters.
+type CategoryService interface {
+	CreateCategory(ctx context.Context, input pettypes.CategoryMutationInput) (*domain.Category, error)
+	UpdateCategory(ctx context.Context, input pettypes.CategoryMutationInput) (*domain.Category, error)
+	GetCategory(ctx context.Context, id int64) (*domain.Category, error)
+	ListCategories(ctx context.Context) ([]*domain.Category, error)
+	DeleteCategory(ctx context.Context, id int64) error
+}
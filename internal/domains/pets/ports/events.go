@@ -0,0 +1,67 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+// PetEventType names a catalog change.
+type PetEventType string
+
+const (
+	PetCreated       PetEventType = "pet.created"
+	PetUpdated       PetEventType = "pet.updated"
+	PetStatusChanged PetEventType = "pet.status_changed"
+	PetDeleted       PetEventType = "pet.deleted"
+)
+
+// PetEvent describes one committed catalog change.
+type PetEvent struct {
+	// ID orders events within a stream; it is assigned by the event bus, zero until then.
+	ID    uint64
+	Type  PetEventType
+	PetID int64
+	// Status is the pet's status after the change, or its last status for deletions.
+	Status domain.Status
+	// PreviousStatus is set for status changes.
+	PreviousStatus domain.Status
+	Tags           []string
+	// Pet is the saved state; nil for deletions.
+	Pet        *pettypes.PetProjection
+	OccurredAt time.Time
+}
+
+// PetEventPublisher announces catalog changes after they are persisted. Publishing is best
+// effort: a failed delivery never fails the mutation that caused it.
+type PetEventPublisher interface {
+	Publish(ctx context.Context, event PetEvent)
+}
+
+// NoopPetEvents is the default when no one listens for catalog changes.
+var NoopPetEvents PetEventPublisher = noopPetEvents{}
+
+type noopPetEvents struct{}
+
+func (noopPetEvents) Publish(context.Context, PetEvent) {}
+
+// PetEventSubscription is a live view of the event stream.
+type PetEventSubscription struct {
+	// Replay holds buffered events newer than the requested id, oldest first.
+	Replay []PetEvent
+	// Gap reports that events after the requested id are no longer buffered. Replay is then
+	// empty: the subscriber should reload the catalog and resume from LatestID.
+	Gap      bool
+	LatestID uint64
+	// Events delivers new events; it is closed when the subscriber falls too far behind.
+	Events <-chan PetEvent
+	// Cancel releases the subscription.
+	Cancel func()
+}
+
+// PetEventSource lets readers follow catalog changes, resuming after a previously seen event id.
+type PetEventSource interface {
+	Subscribe(afterID uint64) PetEventSubscription
+}
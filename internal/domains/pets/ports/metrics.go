@@ -0,0 +1,39 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+// BusinessMetrics records domain signals emitted by use cases and activities.
+type BusinessMetrics interface {
+	IdempotencyReplayed(ctx context.Context)
+	IdempotencyConflicted(ctx context.Context)
+	PartnerSyncSucceeded(ctx context.Context, provider string)
+	PartnerSyncSkipped(ctx context.Context, provider string)
+	PartnerSyncFailed(ctx context.Context, provider string)
+}
+
+// NoopBusinessMetrics is a safe default when callers do not export business metrics.
+var NoopBusinessMetrics BusinessMetrics = noopBusinessMetrics{}
+
+type noopBusinessMetrics struct{}
+
+func (noopBusinessMetrics) IdempotencyReplayed(context.Context)          {}
+func (noopBusinessMetrics) IdempotencyConflicted(context.Context)        {}
+func (noopBusinessMetrics) PartnerSyncSucceeded(context.Context, string) {}
+func (noopBusinessMetrics) PartnerSyncSkipped(context.Context, string)   {}
+func (noopBusinessMetrics) PartnerSyncFailed(context.Context, string)    {}
+
+// CatalogCount is one bucket of the catalog breakdown by status and category name.
+type CatalogCount struct {
+	Status   domain.Status
+	Category string
+	Count    int64
+}
+
+// CatalogCounter is implemented by repositories that can aggregate the catalog without loading every pet.
+type CatalogCounter interface {
+	CountByStatusAndCategory(ctx context.Context) ([]CatalogCount, error)
+}
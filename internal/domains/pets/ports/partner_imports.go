@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+)
+
+// ErrReplayedDelivery indicates a partner webhook delivery was already accepted.
+var ErrReplayedDelivery = errors.New("partner webhook delivery already processed")
+
+// ParkedImport is a partner event that could not hydrate a pet and awaits manual review.
+type ParkedImport struct {
+	ID         int64
+	Provider   string
+	EventID    string
+	ExternalID string
+	Missing    []string
+	Candidate  pettypes.PartnerImportCandidate
+	CreatedAt  time.Time
+}
+
+// ImportReviewStore parks incomplete partner imports for later review.
+type ImportReviewStore interface {
+	Park(ctx context.Context, parked ParkedImport) (*ParkedImport, error)
+	List(ctx context.Context) ([]ParkedImport, error)
+}
+
+// WebhookReplayGuard remembers accepted webhook deliveries until they expire.
+type WebhookReplayGuard interface {
+	// Claim records the delivery, returning ErrReplayedDelivery when it was already claimed and has not expired.
+	Claim(ctx context.Context, provider, deliveryID string, expiresAt time.Time) error
+	// Release forgets a claimed delivery whose processing failed, so the partner's retry is accepted.
+	Release(ctx context.Context, provider, deliveryID string) error
+}
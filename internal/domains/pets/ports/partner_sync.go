@@ -6,7 +6,58 @@ import (
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
 )
 
+// DefaultPartnerProvider names the partner wired through PARTNER_API_BASE_URL.
+const DefaultPartnerProvider = "partner"
+
 // PartnerSync defines outbound integration for syncing pets with an external provider.
 type PartnerSync interface {
 	Sync(ctx context.Context, pet *domain.Pet) error
 }
+
+// ProviderSync pushes pets to a single named partner provider.
+type ProviderSync interface {
+	PartnerSync
+	Provider() string
+}
+
+// PartnerTargets is implemented by sync adapters that fan out to several providers.
+type PartnerTargets interface {
+	// Targets returns the providers the pet should be pushed to under their sync policies.
+	Targets(pet *domain.Pet) []ProviderSync
+}
+
+// SyncTargets resolves the per-provider targets for pet. A PartnerSync that does not fan out is
+// treated as the single DefaultPartnerProvider.
+func SyncTargets(sync PartnerSync, pet *domain.Pet) []ProviderSync {
+	switch s := sync.(type) {
+	case nil:
+		return nil
+	case PartnerTargets:
+		return s.Targets(pet)
+	case ProviderSync:
+		return []ProviderSync{s}
+	default:
+		return []ProviderSync{namedSync{PartnerSync: s, provider: DefaultPartnerProvider}}
+	}
+}
+
+type resyncKey struct{}
+
+// WithResync marks ctx as a forced re-sync so adapters do not let the partner replay an earlier
+// response for an unchanged payload; token distinguishes one repair run from another.
+func WithResync(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, resyncKey{}, token)
+}
+
+// ResyncToken returns the token set by WithResync, or "" for regular syncs.
+func ResyncToken(ctx context.Context) string {
+	token, _ := ctx.Value(resyncKey{}).(string)
+	return token
+}
+
+type namedSync struct {
+	PartnerSync
+	provider string
+}
+
+func (n namedSync) Provider() string { return n.provider }
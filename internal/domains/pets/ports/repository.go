@@ -10,11 +10,33 @@ import (
 
 var ErrNotFound = errors.New("pet not found")
 
+// ErrExternalReferenceTaken indicates a pet is saved with the partner reference of another pet.
+var ErrExternalReferenceTaken = errors.New("external reference already linked to another pet")
+
 type Repository interface {
+	// Save inserts or replaces the pet, returning ErrExternalReferenceTaken when another pet holds
+	// its primary external reference.
 	Save(ctx context.Context, pet *domain.Pet) (*pettypes.PetProjection, error)
 	GetByID(ctx context.Context, id int64) (*pettypes.PetProjection, error)
 	Delete(ctx context.Context, id int64) error
 	FindByStatus(ctx context.Context, statuses []domain.Status) ([]*pettypes.PetProjection, error)
-	FindByTags(ctx context.Context, tags []string) ([]*pettypes.PetProjection, error)
+	// FindByTags returns pets carrying any or all of the normalized tag names, depending on match.
+	FindByTags(ctx context.Context, tags []string, match pettypes.TagMatch) ([]*pettypes.PetProjection, error)
 	List(ctx context.Context) ([]*pettypes.PetProjection, error)
+	// FindByExternalReference returns the pet linked to a partner record or ErrNotFound.
+	FindByExternalReference(ctx context.Context, provider, externalID string) (*pettypes.PetProjection, error)
+	// GetMany returns the pets that exist among ids, in the order of ids; unknown ids are skipped.
+	GetMany(ctx context.Context, ids []int64) ([]*pettypes.PetProjection, error)
+	// SaveMany upserts pets atomically, assigns identifiers to new pets, and returns projections in input order.
+	SaveMany(ctx context.Context, pets []*domain.Pet) ([]*pettypes.PetProjection, error)
+	// UpdateStatus moves the pets among ids to status in one transaction, writing only the status
+	// and update time of pets whose status differs. With requireAll, an unknown id leaves every pet
+	// untouched and is only reported in Missing.
+	UpdateStatus(ctx context.Context, ids []int64, status domain.Status, requireAll bool) (*pettypes.StatusUpdate, error)
+}
+
+// PageLister is implemented by repositories that can page through pets in ID order.
+type PageLister interface {
+	// ListAfter returns up to limit pets with an ID greater than afterID, ordered by ID.
+	ListAfter(ctx context.Context, afterID int64, limit int) ([]*pettypes.PetProjection, error)
 }
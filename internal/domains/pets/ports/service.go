@@ -16,13 +16,21 @@ type UploadImageResult struct {
 // Service defines the pets use cases exposed to adapters (inbound/driving port).
 type Service interface {
 	AddPet(ctx context.Context, input pettypes.AddPetInput) (*pettypes.PetProjection, error)
+	// ReplayAddPet returns the pet an earlier AddPet with the same idempotency key created, nil when
+	// the key is unused, or an idempotency conflict when it was used with a different payload.
+	ReplayAddPet(ctx context.Context, input pettypes.AddPetInput) (*pettypes.PetProjection, error)
 	UpdatePet(ctx context.Context, input pettypes.UpdatePetInput) (*pettypes.PetProjection, error)
 	UpdatePetWithForm(ctx context.Context, input pettypes.UpdatePetWithFormInput) (*pettypes.PetProjection, error)
 	FindByStatus(ctx context.Context, input pettypes.FindPetsByStatusInput) ([]*pettypes.PetProjection, error)
 	FindByTags(ctx context.Context, input pettypes.FindPetsByTagsInput) ([]*pettypes.PetProjection, error)
 	GetByID(ctx context.Context, input pettypes.PetIdentifier) (*pettypes.PetProjection, error)
+	BatchGet(ctx context.Context, input pettypes.BatchGetInput) (*pettypes.BatchGetResult, error)
+	BatchUpdateStatus(ctx context.Context, input pettypes.BatchStatusInput) (*pettypes.BatchStatusResult, error)
 	Delete(ctx context.Context, input pettypes.PetIdentifier) error
 	GroomPet(ctx context.Context, input pettypes.GroomPetInput) (*pettypes.PetProjection, error)
 	UploadImage(ctx context.Context, input pettypes.UploadImageInput) (*UploadImageResult, error)
 	List(ctx context.Context) ([]*pettypes.PetProjection, error)
+	ImportFromPartner(ctx context.Context, input pettypes.PartnerImportInput) (*pettypes.PartnerImportResult, error)
+	ImportPets(ctx context.Context, input pettypes.BulkImportInput, emit func(pettypes.BulkRowResult) error) (pettypes.BulkImportSummary, error)
+	ExportPets(ctx context.Context, emit func(*pettypes.PetProjection) error) error
 }
@@ -0,0 +1,53 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	pettypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+var (
+	// ErrTagNotFound indicates no catalog tag has the requested ID or name.
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrTagNameTaken indicates another tag already uses the name; merge the tags instead.
+	ErrTagNameTaken = errors.New("tag name already exists")
+	// ErrTagInUse indicates pets still carry the tag, so it cannot be deleted.
+	ErrTagInUse = errors.New("tag is still in use")
+)
+
+// TagRepository stores the tag catalog.
+type TagRepository interface {
+	// Save inserts or renames a tag, assigning an ID to new ones. It returns ErrTagNameTaken
+	// when the name is used by another tag.
+	Save(ctx context.Context, tag *domain.Tag) (*domain.Tag, error)
+	GetByID(ctx context.Context, id int64) (*domain.Tag, error)
+	// FindByName matches the normalized name or returns ErrTagNotFound.
+	FindByName(ctx context.Context, name string) (*domain.Tag, error)
+	// List returns every tag ordered by ID.
+	List(ctx context.Context) ([]*domain.Tag, error)
+	// Delete removes a tag, returning ErrTagInUse while pets still carry it.
+	Delete(ctx context.Context, id int64) error
+	// EnsureNames returns the catalog tags for names in the given order, creating missing ones.
+	EnsureNames(ctx context.Context, names []string) ([]domain.Tag, error)
+}
+
+// TagReferences is implemented by pet repositories that link pets to catalog tags.
+type TagReferences interface {
+	// CountByTag returns the number of pets carrying each tag, keyed by tag ID.
+	CountByTag(ctx context.Context) (map[int64]int64, error)
+	// ReassignTag moves every pet carrying fromID to toID; pets that already carry toID keep it once.
+	ReassignTag(ctx context.Context, fromID, toID int64) error
+	// TouchByTag bumps the update time of every pet carrying the tag, so cached representations
+	// that embed the tag name are revalidated.
+	TouchByTag(ctx context.Context, tagID int64) error
+}
+
+// TagService defines the tag catalog use cases exposed to adapters.
+type TagService interface {
+	CreateTag(ctx context.Context, input pettypes.TagMutationInput) (*domain.Tag, error)
+	RenameTag(ctx context.Context, input pettypes.TagMutationInput) (*domain.Tag, error)
+	MergeTags(ctx context.Context, input pettypes.MergeTagsInput) (*pettypes.TagUsage, error)
+	ListTags(ctx context.Context) ([]pettypes.TagUsage, error)
+}
@@ -0,0 +1,109 @@
+// Package grpc serves the store use cases over gRPC (petstore.store.v1.StoreService).
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storev1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/store/v1"
+	"github.com/Apurer/go-gin-api-server/internal/domains/store/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/store/ports"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+var statusToProto = map[domain.Status]storev1.OrderStatus{
+	domain.StatusPlaced:    storev1.OrderStatus_ORDER_STATUS_PLACED,
+	domain.StatusApproved:  storev1.OrderStatus_ORDER_STATUS_APPROVED,
+	domain.StatusDelivered: storev1.OrderStatus_ORDER_STATUS_DELIVERED,
+}
+
+var statusFromProto = map[storev1.OrderStatus]domain.Status{
+	storev1.OrderStatus_ORDER_STATUS_UNSPECIFIED: "",
+	storev1.OrderStatus_ORDER_STATUS_PLACED:      domain.StatusPlaced,
+	storev1.OrderStatus_ORDER_STATUS_APPROVED:    domain.StatusApproved,
+	storev1.OrderStatus_ORDER_STATUS_DELIVERED:   domain.StatusDelivered,
+}
+
+// Server adapts ports.Service to storev1.StoreServiceServer.
+type Server struct {
+	storev1.UnimplementedStoreServiceServer
+	service ports.Service
+}
+
+// NewServer serves service.
+func NewServer(service ports.Service) *Server {
+	return &Server{service: service}
+}
+
+func (s *Server) PlaceOrder(ctx context.Context, req *storev1.PlaceOrderRequest) (*storev1.Order, error) {
+	order, err := toDomainOrder(req.GetOrder())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	saved, err := s.service.PlaceOrder(ctx, order)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromDomainOrder(saved), nil
+}
+
+func (s *Server) GetOrder(ctx context.Context, req *storev1.GetOrderRequest) (*storev1.Order, error) {
+	order, err := s.service.GetOrderByID(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromDomainOrder(order), nil
+}
+
+func (s *Server) DeleteOrder(ctx context.Context, req *storev1.DeleteOrderRequest) (*storev1.DeleteOrderResponse, error) {
+	if err := s.service.DeleteOrder(ctx, req.GetId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &storev1.DeleteOrderResponse{}, nil
+}
+
+func (s *Server) GetInventory(ctx context.Context, _ *storev1.GetInventoryRequest) (*storev1.GetInventoryResponse, error) {
+	counts, err := s.service.Inventory(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &storev1.GetInventoryResponse{Counts: counts}, nil
+}
+
+func toDomainOrder(order *storev1.Order) (*domain.Order, error) {
+	orderStatus, ok := statusFromProto[order.GetStatus()]
+	if !ok {
+		return nil, fmt.Errorf("order status %v is not one of placed, approved, delivered", order.GetStatus())
+	}
+	var shipDate time.Time
+	if order.GetShipTime() != nil {
+		shipDate = order.GetShipTime().AsTime()
+	}
+	return domain.NewOrder(order.GetId(), order.GetPetId(), order.GetQuantity(), shipDate, orderStatus, order.GetComplete())
+}
+
+func fromDomainOrder(order *domain.Order) *storev1.Order {
+	result := &storev1.Order{
+		Id:       order.ID,
+		PetId:    order.PetID,
+		Quantity: order.Quantity,
+		Status:   statusToProto[order.Status],
+		Complete: order.Complete,
+	}
+	if !order.ShipDate.IsZero() {
+		result.ShipTime = timestamppb.New(order.ShipDate)
+	}
+	return result
+}
+
+// toStatus maps service errors through the error catalog, so codes match the HTTP problems.
+func toStatus(err error) error {
+	return apierrors.GRPCError(err)
+}
+
+var _ storev1.StoreServiceServer = (*Server)(nil)
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storev1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/store/v1"
+	storememory "github.com/Apurer/go-gin-api-server/internal/domains/store/adapters/memory"
+	storeapp "github.com/Apurer/go-gin-api-server/internal/domains/store/application"
+	"github.com/Apurer/go-gin-api-server/internal/platform/grpcserver"
+)
+
+func TestStoreServer_PlacesOrdersAndMapsErrors(t *testing.T) {
+	server := grpcserver.New(grpcserver.Config{}, grpcserver.WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))))
+	storev1.RegisterStoreServiceServer(server, NewServer(storeapp.NewService(storememory.NewRepository())))
+	listener := bufconn.Listen(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = server.Serve(ctx, listener) }()
+	conn, err := grpclib.NewClient("passthrough:///bufnet",
+		grpclib.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpclib.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := storev1.NewStoreServiceClient(conn)
+
+	shipTime := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	placed, err := client.PlaceOrder(ctx, &storev1.PlaceOrderRequest{Order: &storev1.Order{
+		Id: 7, PetId: 1, Quantity: 2, ShipTime: timestamppb.New(shipTime),
+	}})
+	require.NoError(t, err)
+	require.Equal(t, storev1.OrderStatus_ORDER_STATUS_PLACED, placed.GetStatus(), "unspecified status places the order")
+	require.True(t, shipTime.Equal(placed.GetShipTime().AsTime()))
+
+	fetched, err := client.GetOrder(ctx, &storev1.GetOrderRequest{Id: 7})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), fetched.GetQuantity())
+
+	_, err = client.PlaceOrder(ctx, &storev1.PlaceOrderRequest{Order: &storev1.Order{Id: 8, PetId: 1}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.DeleteOrder(ctx, &storev1.DeleteOrderRequest{Id: 7})
+	require.NoError(t, err)
+	_, err = client.GetOrder(ctx, &storev1.GetOrderRequest{Id: 7})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/Apurer/go-gin-api-server/internal/domains/store/ports"
 )
 
-var _ ports.Repository = (*Repository)(nil)
+var (
+	_ ports.Repository    = (*Repository)(nil)
+	_ ports.StatusCounter = (*Repository)(nil)
+)
 
 // Repository is an in-memory order persistence adapter.
 type Repository struct {
@@ -76,3 +79,14 @@ func (r *Repository) List(_ context.Context) ([]*domain.Order, error) {
 	}
 	return list, nil
 }
+
+// CountByStatus returns the number of orders per status.
+func (r *Repository) CountByStatus(_ context.Context) (map[domain.Status]int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	counts := map[domain.Status]int64{}
+	for _, order := range r.orders {
+		counts[order.Status]++
+	}
+	return counts, nil
+}
@@ -0,0 +1,60 @@
+package observability
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/store/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/store/ports"
+	platformobservability "github.com/Apurer/go-gin-api-server/internal/platform/observability"
+)
+
+// RegisterOrderGauges exposes the number of orders per status.
+// Repositories implementing ports.StatusCounter are aggregated in storage; others fall back to List.
+func RegisterOrderGauges(m metric.Meter, repo ports.Repository, interval time.Duration, logger *slog.Logger) error {
+	if m == nil || repo == nil {
+		return nil
+	}
+	if logger == nil {
+		logger = defaultLogger()
+	}
+	cache := platformobservability.NewCachedObservation(interval, func(ctx context.Context) (map[domain.Status]int64, error) {
+		return countOrders(ctx, repo)
+	})
+	gauge, err := m.Int64ObservableGauge("store.orders", metric.WithDescription("Orders by status"))
+	if err != nil {
+		return err
+	}
+	_, err = m.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		counts, err := cache.Get(ctx)
+		if err != nil {
+			logger.WarnContext(ctx, "order gauge refresh failed", slog.String("error", err.Error()))
+		}
+		for status, count := range counts {
+			o.ObserveInt64(gauge, count, metric.WithAttributes(attribute.String("order.status", string(status))))
+		}
+		return nil
+	}, gauge)
+	return err
+}
+
+func countOrders(ctx context.Context, repo ports.Repository) (map[domain.Status]int64, error) {
+	if counter, ok := repo.(ports.StatusCounter); ok {
+		return counter.CountByStatus(ctx)
+	}
+	orders, err := repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	counts := map[domain.Status]int64{}
+	for _, order := range orders {
+		if order != nil {
+			counts[order.Status]++
+		}
+	}
+	return counts, nil
+}
@@ -12,7 +12,10 @@ import (
 	"github.com/Apurer/go-gin-api-server/internal/domains/store/ports"
 )
 
-var _ ports.Repository = (*Repository)(nil)
+var (
+	_ ports.Repository    = (*Repository)(nil)
+	_ ports.StatusCounter = (*Repository)(nil)
+)
 
 // Repository persists orders in PostgreSQL using GORM.
 type Repository struct {
@@ -111,6 +114,29 @@ func (r *Repository) List(ctx context.Context) ([]*domain.Order, error) {
 	return orders, nil
 }
 
+// CountByStatus aggregates orders per status with a single GROUP BY query.
+func (r *Repository) CountByStatus(ctx context.Context) (map[domain.Status]int64, error) {
+	if err := r.ensureDB(); err != nil {
+		return nil, err
+	}
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&orderRecord{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[domain.Status]int64, len(rows))
+	for _, row := range rows {
+		counts[domain.Status(row.Status)] = row.Count
+	}
+	return counts, nil
+}
+
 func (r *Repository) ensureDB() error {
 	if r == nil || r.db == nil {
 		return errors.New("postgres order repository not configured")
@@ -3,8 +3,11 @@ package application
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/Apurer/go-gin-api-server/internal/domains/store/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/store/ports"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
 )
 
 var (
@@ -12,6 +15,17 @@ var (
 	ErrInvalidInput = errors.New("invalid order input")
 )
 
+// Domain errors come before ErrInvalidInput, which wraps them, so lookups report the precise code.
+func init() {
+	apierrors.Register(
+		apierrors.Definition{Err: domain.ErrInvalidPetID, Code: "store.invalid_pet_id", Status: http.StatusBadRequest, Title: "Invalid Pet ID", Field: "body.petId"},
+		apierrors.Definition{Err: domain.ErrInvalidQuantity, Code: "store.invalid_quantity", Status: http.StatusBadRequest, Title: "Invalid Order Quantity", Field: "body.quantity"},
+		apierrors.Definition{Err: domain.ErrInvalidStatus, Code: "store.invalid_status", Status: http.StatusBadRequest, Title: "Invalid Order Status", Field: "body.status"},
+		apierrors.Definition{Err: ErrInvalidInput, Code: "store.invalid_input", Status: http.StatusBadRequest, Title: "Invalid Order Input"},
+		apierrors.Definition{Err: ports.ErrNotFound, Code: "store.order_not_found", Status: http.StatusNotFound, Title: "Order Not Found"},
+	)
+}
+
 func mapError(err error) error {
 	if err == nil {
 		return nil
@@ -16,3 +16,8 @@ type Repository interface {
 	Delete(ctx context.Context, id int64) error
 	List(ctx context.Context) ([]*domain.Order, error)
 }
+
+// StatusCounter is implemented by repositories that can count orders per status in storage.
+type StatusCounter interface {
+	CountByStatus(ctx context.Context) (map[domain.Status]int64, error)
+}
@@ -0,0 +1,144 @@
+// Package grpc serves the users use cases over gRPC (petstore.users.v1.UserService).
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	usersv1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/users/v1"
+	userapp "github.com/Apurer/go-gin-api-server/internal/domains/users/application"
+	"github.com/Apurer/go-gin-api-server/internal/domains/users/domain"
+	"github.com/Apurer/go-gin-api-server/internal/domains/users/ports"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// Server adapts ports.Service to usersv1.UserServiceServer.
+type Server struct {
+	usersv1.UnimplementedUserServiceServer
+	service ports.Service
+}
+
+// NewServer serves service.
+func NewServer(service ports.Service) *Server {
+	return &Server{service: service}
+}
+
+func (s *Server) CreateUser(ctx context.Context, req *usersv1.CreateUserRequest) (*usersv1.User, error) {
+	user, err := toDomainUser(req.GetUser())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	saved, err := s.service.CreateUser(ctx, user)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromDomainUser(saved), nil
+}
+
+func (s *Server) CreateUsers(ctx context.Context, req *usersv1.CreateUsersRequest) (*usersv1.CreateUsersResponse, error) {
+	users := make([]*domain.User, 0, len(req.GetUsers()))
+	for _, model := range req.GetUsers() {
+		user, err := toDomainUser(model)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		users = append(users, user)
+	}
+	saved, err := s.service.CreateUsers(ctx, users)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	response := &usersv1.CreateUsersResponse{}
+	for _, user := range saved {
+		response.Users = append(response.Users, fromDomainUser(user))
+	}
+	return response, nil
+}
+
+func (s *Server) GetUser(ctx context.Context, req *usersv1.GetUserRequest) (*usersv1.User, error) {
+	user, err := s.service.GetByUsername(ctx, req.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromDomainUser(user), nil
+}
+
+// UpdateUser replaces the user; the username comes from the request when the user omits it.
+func (s *Server) UpdateUser(ctx context.Context, req *usersv1.UpdateUserRequest) (*usersv1.User, error) {
+	model := &usersv1.User{}
+	if req.GetUser() != nil {
+		model = proto.Clone(req.GetUser()).(*usersv1.User)
+	}
+	if model.GetUsername() == "" {
+		model.Username = req.GetUsername()
+	}
+	user, err := toDomainUser(model)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	saved, err := s.service.Update(ctx, req.GetUsername(), user)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return fromDomainUser(saved), nil
+}
+
+func (s *Server) DeleteUser(ctx context.Context, req *usersv1.DeleteUserRequest) (*usersv1.DeleteUserResponse, error) {
+	if err := s.service.Delete(ctx, req.GetUsername()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &usersv1.DeleteUserResponse{}, nil
+}
+
+// Login reports unknown users like wrong passwords so callers cannot probe for usernames.
+func (s *Server) Login(ctx context.Context, req *usersv1.LoginRequest) (*usersv1.LoginResponse, error) {
+	token, err := s.service.Login(ctx, req.GetUsername(), req.GetPassword())
+	if errors.Is(err, ports.ErrNotFound) || errors.Is(err, userapp.ErrAuthentication) {
+		return nil, status.Error(codes.Unauthenticated, ports.ErrInvalidCredentials.Error())
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &usersv1.LoginResponse{SessionToken: token}, nil
+}
+
+func (s *Server) Logout(ctx context.Context, req *usersv1.LogoutRequest) (*usersv1.LogoutResponse, error) {
+	s.service.Logout(ctx, req.GetUsername())
+	return &usersv1.LogoutResponse{}, nil
+}
+
+func toDomainUser(model *usersv1.User) (*domain.User, error) {
+	user, err := domain.NewUser(model.GetId(), model.GetUsername(), model.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	if err := user.UpdateProfile(model.GetFirstName(), model.GetLastName(), model.GetEmail(), model.GetPhone()); err != nil {
+		return nil, err
+	}
+	user.UpdateStatus(model.GetUserStatus())
+	return user, nil
+}
+
+// fromDomainUser leaves the password out; it is write-only over gRPC.
+func fromDomainUser(user *domain.User) *usersv1.User {
+	return &usersv1.User{
+		Id:         user.ID,
+		Username:   user.Username,
+		FirstName:  user.FirstName,
+		LastName:   user.LastName,
+		Email:      user.Email,
+		Phone:      user.Phone,
+		UserStatus: user.Status,
+	}
+}
+
+// toStatus maps service errors through the error catalog, so codes match the HTTP problems.
+func toStatus(err error) error {
+	return apierrors.GRPCError(err)
+}
+
+var _ usersv1.UserServiceServer = (*Server)(nil)
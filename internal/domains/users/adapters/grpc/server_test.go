@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	usersv1 "github.com/Apurer/go-gin-api-server/api/proto/petstore/users/v1"
+	usermemory "github.com/Apurer/go-gin-api-server/internal/domains/users/adapters/memory"
+	userapp "github.com/Apurer/go-gin-api-server/internal/domains/users/application"
+	"github.com/Apurer/go-gin-api-server/internal/platform/grpcserver"
+)
+
+func TestUserServer_ManagesUsersAndSessions(t *testing.T) {
+	server := grpcserver.New(grpcserver.Config{}, grpcserver.WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))))
+	service := userapp.NewService(usermemory.NewRepository(), usermemory.NewSessionStore())
+	usersv1.RegisterUserServiceServer(server, NewServer(service))
+	listener := bufconn.Listen(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = server.Serve(ctx, listener) }()
+	conn, err := grpclib.NewClient("passthrough:///bufnet",
+		grpclib.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpclib.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := usersv1.NewUserServiceClient(conn)
+
+	created, err := client.CreateUser(ctx, &usersv1.CreateUserRequest{User: &usersv1.User{
+		Id: 1, Username: "ada", Password: "s3cret", Email: "ada@example.com",
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "ada", created.GetUsername())
+	require.Empty(t, created.GetPassword(), "passwords are write-only")
+
+	_, err = client.CreateUser(ctx, &usersv1.CreateUserRequest{User: &usersv1.User{Username: "bob", Password: "pw"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	updated, err := client.UpdateUser(ctx, &usersv1.UpdateUserRequest{Username: "ada", User: &usersv1.User{Password: "s3cret", FirstName: "Ada"}})
+	require.NoError(t, err)
+	require.Equal(t, "Ada", updated.GetFirstName())
+
+	login, err := client.Login(ctx, &usersv1.LoginRequest{Username: "ada", Password: "s3cret"})
+	require.NoError(t, err)
+	require.NotEmpty(t, login.GetSessionToken())
+
+	_, err = client.Login(ctx, &usersv1.LoginRequest{Username: "ada", Password: "wrong"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.Login(ctx, &usersv1.LoginRequest{Username: "nobody", Password: "s3cret"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = client.DeleteUser(ctx, &usersv1.DeleteUserRequest{Username: "ada"})
+	require.NoError(t, err)
+	_, err = client.GetUser(ctx, &usersv1.GetUserRequest{Username: "ada"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
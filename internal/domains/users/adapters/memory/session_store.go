@@ -23,3 +23,13 @@ func (s *SessionStore) Delete(_ context.Context, username string) error {
 	s.session.Delete(username)
 	return nil
 }
+
+// CountActive returns the number of stored sessions; memory sessions never expire.
+func (s *SessionStore) CountActive(_ context.Context) (int64, error) {
+	var count int64
+	s.session.Range(func(_, _ any) bool {
+		count++
+		return true
+	})
+	return count, nil
+}
@@ -0,0 +1,37 @@
+package observability
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/Apurer/go-gin-api-server/internal/domains/users/ports"
+	platformobservability "github.com/Apurer/go-gin-api-server/internal/platform/observability"
+)
+
+// RegisterSessionGauges exposes the number of active sessions when the store implements ports.SessionCounter.
+func RegisterSessionGauges(m metric.Meter, store ports.SessionStore, interval time.Duration, logger *slog.Logger) error {
+	counter, ok := store.(ports.SessionCounter)
+	if m == nil || !ok {
+		return nil
+	}
+	if logger == nil {
+		logger = defaultLogger()
+	}
+	cache := platformobservability.NewCachedObservation(interval, counter.CountActive)
+	gauge, err := m.Int64ObservableGauge("users.sessions.active", metric.WithDescription("Sessions that have not expired"))
+	if err != nil {
+		return err
+	}
+	_, err = m.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		count, err := cache.Get(ctx)
+		if err != nil {
+			logger.WarnContext(ctx, "session gauge refresh failed", slog.String("error", err.Error()))
+		}
+		o.ObserveInt64(gauge, count)
+		return nil
+	}, gauge)
+	return err
+}
@@ -81,6 +81,19 @@ func (s *SessionStore) PurgeExpired(ctx context.Context) error {
 	return s.db.WithContext(ctx).Where("expires_at IS NOT NULL AND expires_at <= ?", now).Delete(&sessionRecord{}).Error
 }
 
+// CountActive returns the number of sessions that have not expired yet.
+func (s *SessionStore) CountActive(ctx context.Context) (int64, error) {
+	if err := s.ensureDB(); err != nil {
+		return 0, err
+	}
+	var count int64
+	err := s.db.WithContext(ctx).
+		Model(&sessionRecord{}).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Count(&count).Error
+	return count, err
+}
+
 func (s *SessionStore) ensureDB() error {
 	if s == nil || s.db == nil {
 		return errors.New("postgres session store not configured")
@@ -88,4 +101,7 @@ func (s *SessionStore) ensureDB() error {
 	return nil
 }
 
-var _ userports.SessionStore = (*SessionStore)(nil)
+var (
+	_ userports.SessionStore   = (*SessionStore)(nil)
+	_ userports.SessionCounter = (*SessionStore)(nil)
+)
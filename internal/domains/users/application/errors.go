@@ -3,9 +3,11 @@ package application
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/Apurer/go-gin-api-server/internal/domains/users/domain"
 	"github.com/Apurer/go-gin-api-server/internal/domains/users/ports"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
 )
 
 var (
@@ -15,6 +17,20 @@ var (
 	ErrAuthentication = errors.New("authentication failed")
 )
 
+// Domain errors come before the application errors that wrap them, so lookups report the precise code.
+func init() {
+	apierrors.Register(
+		apierrors.Definition{Err: domain.ErrEmptyUsername, Code: "users.username_required", Status: http.StatusBadRequest, Title: "Username Required", Field: "body.username"},
+		apierrors.Definition{Err: domain.ErrEmptyPassword, Code: "users.password_required", Status: http.StatusBadRequest, Title: "Password Required", Field: "body.password"},
+		apierrors.Definition{Err: domain.ErrWeakPassword, Code: "users.weak_password", Status: http.StatusBadRequest, Title: "Password Too Weak", Field: "body.password"},
+		apierrors.Definition{Err: domain.ErrInvalidEmail, Code: "users.invalid_email", Status: http.StatusBadRequest, Title: "Invalid Email", Field: "body.email"},
+		apierrors.Definition{Err: ErrInvalidInput, Code: "users.invalid_input", Status: http.StatusBadRequest, Title: "Invalid User Input"},
+		apierrors.Definition{Err: ports.ErrNotFound, Code: "users.not_found", Status: http.StatusNotFound, Title: "User Not Found"},
+		apierrors.Definition{Err: ports.ErrInvalidCredentials, Code: "users.invalid_credentials", Status: http.StatusUnauthorized, Title: "Invalid Credentials"},
+		apierrors.Definition{Err: ErrAuthentication, Code: "users.authentication_failed", Status: http.StatusUnauthorized, Title: "Authentication Failed"},
+	)
+}
+
 func mapError(err error) error {
 	if err == nil {
 		return nil
@@ -8,6 +8,11 @@ type SessionStore interface {
 	Delete(ctx context.Context, username string) error
 }
 
+// SessionCounter is implemented by session stores that can report how many sessions are still active.
+type SessionCounter interface {
+	CountActive(ctx context.Context) (int64, error)
+}
+
 // NoopSessionStore is a safe default when callers do not need session persistence.
 var NoopSessionStore SessionStore = noopSessionStore{}
 
@@ -0,0 +1,87 @@
+package grpcserver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Defaults mirror the HTTP server: the shutdown timeout leaves a Kubernetes pod time to finish
+// in-flight calls, and messages keep gRPC's usual 4 MiB cap.
+const (
+	DefaultPort            = "9090"
+	DefaultShutdownTimeout = 20 * time.Second
+	DefaultMaxRecvMsgBytes = 4 << 20
+)
+
+// Config carries the gRPC listener settings.
+type Config struct {
+	// Port is where the gRPC API listens, separate from the HTTP port.
+	Port     string
+	Disabled bool
+	// Reflection registers the reflection service so tools such as grpcurl can list the API.
+	Reflection bool
+	// ShutdownTimeout bounds how long in-flight calls may run once shutdown starts; calls still
+	// running afterwards are cancelled.
+	ShutdownTimeout time.Duration
+	MaxRecvMsgBytes int
+}
+
+// LoadConfig reads the GRPC_* environment variables.
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		Port:            DefaultPort,
+		Disabled:        isTruthy(os.Getenv("GRPC_DISABLED")),
+		Reflection:      isTruthy(os.Getenv("GRPC_REFLECTION")),
+		ShutdownTimeout: DefaultShutdownTimeout,
+		MaxRecvMsgBytes: DefaultMaxRecvMsgBytes,
+	}
+	if raw := strings.TrimSpace(os.Getenv("GRPC_PORT")); raw != "" {
+		cfg.Port = raw
+	}
+	if raw := strings.TrimSpace(os.Getenv("GRPC_SHUTDOWN_TIMEOUT_SECONDS")); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds < 0 {
+			return Config{}, fmt.Errorf("GRPC_SHUTDOWN_TIMEOUT_SECONDS must be a non-negative integer")
+		}
+		cfg.ShutdownTimeout = time.Duration(seconds) * time.Second
+	}
+	if raw := strings.TrimSpace(os.Getenv("GRPC_MAX_RECV_MSG_BYTES")); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil || size <= 0 {
+			return Config{}, fmt.Errorf("GRPC_MAX_RECV_MSG_BYTES must be a positive integer")
+		}
+		cfg.MaxRecvMsgBytes = size
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Validate rejects a missing port on an enabled server.
+func (c Config) Validate() error {
+	if !c.Disabled && strings.TrimSpace(c.Port) == "" {
+		return errors.New("GRPC_PORT must be set unless GRPC_DISABLED is true")
+	}
+	return nil
+}
+
+// Summary describes the configuration.
+func (c Config) Summary() map[string]any {
+	return map[string]any{
+		"port":                  c.Port,
+		"disabled":              c.Disabled,
+		"reflection":            c.Reflection,
+		"shutdown_timeout_secs": c.ShutdownTimeout.Seconds(),
+		"max_recv_msg_bytes":    c.MaxRecvMsgBytes,
+	}
+}
+
+func isTruthy(value string) bool {
+	value = strings.TrimSpace(strings.ToLower(value))
+	return value == "1" || value == "true" || value == "yes"
+}
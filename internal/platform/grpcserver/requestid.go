@@ -0,0 +1,45 @@
+package grpcserver
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
+)
+
+// withRequestID reuses a valid x-request-id from the caller's metadata or generates one, sends
+// it back as header metadata, and stores it in the context for handlers, logs, and spans.
+func withRequestID(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestid.MetadataKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	id = requestid.Ensure(id)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestid.MetadataKey, id))
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String(requestid.SpanAttribute, id))
+	return requestid.WithID(ctx, id)
+}
+
+func requestIDUnary(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+func requestIDStream(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &requestIDStreamWrapper{ServerStream: ss, ctx: withRequestID(ss.Context())})
+}
+
+// requestIDStreamWrapper exposes the context carrying the request ID to stream handlers.
+type requestIDStreamWrapper struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDStreamWrapper) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,194 @@
+// Package grpcserver runs the gRPC API next to the HTTP one with OpenTelemetry instrumentation,
+// request IDs, panic recovery, the standard health service, and a bounded graceful shutdown.
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// Server wraps a grpc.Server. It implements grpc.ServiceRegistrar, so generated Register*Server
+// functions accept it directly; every registered service reports SERVING on the health service
+// until shutdown starts.
+type Server struct {
+	cfg            Config
+	logger         *slog.Logger
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	unary          []grpc.UnaryServerInterceptor
+	stream         []grpc.StreamServerInterceptor
+	server         *grpc.Server
+	health         *health.Server
+}
+
+// Option customizes optional Server collaborators.
+type Option func(*Server)
+
+// WithLogger records listener and shutdown events and recovered panics.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// WithTracerProvider records a server span per call; the global provider is used otherwise.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(s *Server) {
+		s.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider records call duration and message size metrics; the global provider is
+// used otherwise.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return func(s *Server) {
+		s.meterProvider = mp
+	}
+}
+
+// WithUnaryInterceptors runs interceptors, in order, inside panic recovery for unary calls.
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) Option {
+	return func(s *Server) {
+		s.unary = append(s.unary, interceptors...)
+	}
+}
+
+// WithStreamInterceptors runs interceptors, in order, inside panic recovery for streaming calls.
+func WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) Option {
+	return func(s *Server) {
+		s.stream = append(s.stream, interceptors...)
+	}
+}
+
+// New builds the server; services are registered before Run.
+func New(cfg Config, opts ...Option) *Server {
+	s := &Server{cfg: cfg}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	if s.logger == nil {
+		s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	}
+	var otelOpts []otelgrpc.Option
+	if s.tracerProvider != nil {
+		otelOpts = append(otelOpts, otelgrpc.WithTracerProvider(s.tracerProvider))
+	}
+	if s.meterProvider != nil {
+		otelOpts = append(otelOpts, otelgrpc.WithMeterProvider(s.meterProvider))
+	}
+	serverOpts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler(otelOpts...)),
+		grpc.ChainUnaryInterceptor(append([]grpc.UnaryServerInterceptor{requestIDUnary, s.recoverUnary}, s.unary...)...),
+		grpc.ChainStreamInterceptor(append([]grpc.StreamServerInterceptor{requestIDStream, s.recoverStream}, s.stream...)...),
+	}
+	if cfg.MaxRecvMsgBytes > 0 {
+		serverOpts = append(serverOpts, grpc.MaxRecvMsgSize(cfg.MaxRecvMsgBytes))
+	}
+	s.server = grpc.NewServer(serverOpts...)
+	s.health = health.NewServer()
+	healthpb.RegisterHealthServer(s.server, s.health)
+	if cfg.Reflection {
+		reflection.Register(s.server)
+	}
+	return s
+}
+
+// RegisterService implements grpc.ServiceRegistrar.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.server.RegisterService(desc, impl)
+	s.health.SetServingStatus(desc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+}
+
+// Run listens on the configured port and serves until ctx is cancelled.
+func (s *Server) Run(ctx context.Context) error {
+	addr := ":" + s.cfg.Port
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen on %s: %w", addr, err)
+	}
+	return s.Serve(ctx, l)
+}
+
+// Serve accepts connections on l until ctx is cancelled or the listener fails. On cancellation
+// the health service reports NOT_SERVING, new calls are refused, and in-flight calls get up to
+// the shutdown timeout to finish before they are cancelled.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	serveErr := make(chan error, 1)
+	s.logger.Info("gRPC listener started", slog.String("addr", l.Addr().String()))
+	go func() {
+		serveErr <- s.server.Serve(l)
+	}()
+	select {
+	case err := <-serveErr:
+		s.health.Shutdown()
+		s.logger.Error("gRPC listener failed", slog.String("error", err.Error()))
+		return err
+	case <-ctx.Done():
+	}
+	s.health.Shutdown()
+	s.logger.Info("shutdown requested, draining gRPC server", slog.Duration("shutdown_timeout", s.cfg.ShutdownTimeout))
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+	var timeout <-chan time.Time
+	if s.cfg.ShutdownTimeout > 0 {
+		timer := time.NewTimer(s.cfg.ShutdownTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-stopped:
+	case <-timeout:
+		s.logger.Warn("gRPC calls still running after shutdown timeout, cancelling them")
+		s.server.Stop()
+		<-stopped
+	}
+	s.logger.Info("gRPC server stopped")
+	return nil
+}
+
+// recoverUnary turns a handler panic into an Internal status instead of crashing the process.
+func (s *Server) recoverUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = s.recovered(ctx, info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func (s *Server) recoverStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = s.recovered(ss.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+func (s *Server) recovered(ctx context.Context, method string, r any) error {
+	s.logger.ErrorContext(ctx, "gRPC handler panicked",
+		slog.String("method", method),
+		slog.Any("panic", r),
+		slog.String("stack", string(debug.Stack())))
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,192 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Defaults keep a slow or hostile client from holding connections open and leave a Kubernetes
+// pod (30s termination grace period) time to drain before it is killed.
+const (
+	DefaultReadHeaderTimeout = 10 * time.Second
+	DefaultReadTimeout       = 30 * time.Second
+	DefaultIdleTimeout       = 120 * time.Second
+	DefaultMaxHeaderBytes    = 1 << 20
+	DefaultMaxBodyBytes      = 10 << 20
+	DefaultDrainDelay        = 5 * time.Second
+	DefaultShutdownTimeout   = 20 * time.Second
+	DefaultStreamingTimeout  = 15 * time.Minute
+)
+
+// DefaultCachePolicies make clients revalidate the conditional reads on every use instead of
+// relying on heuristic freshness, which turns unchanged polls into bodyless 304s.
+var DefaultCachePolicies = map[string]string{
+	"getPetById":       "no-cache",
+	"findPetsByStatus": "no-cache",
+	"findPetsByTags":   "no-cache",
+	"getOrderById":     "no-cache",
+}
+
+// Config carries the HTTP server limits, shutdown behaviour, and TLS settings.
+type Config struct {
+	ReadHeaderTimeout time.Duration
+	ReadTimeout       time.Duration
+	// WriteTimeout is off by default because catalog exports stream for as long as they need.
+	WriteTimeout   time.Duration
+	IdleTimeout    time.Duration
+	MaxHeaderBytes int
+	// MaxBodyBytes caps request bodies; zero disables the limit.
+	MaxBodyBytes int64
+	// StreamingTimeout replaces ReadTimeout and WriteTimeout on streaming uploads, which are
+	// exempt from MaxBodyBytes; zero lets them run for as long as the client keeps sending.
+	StreamingTimeout time.Duration
+	// DrainDelay is how long readiness reports 503 before the listeners stop accepting, so load
+	// balancers stop routing to the instance first.
+	DrainDelay time.Duration
+	// ShutdownTimeout bounds how long in-flight requests may run after draining starts.
+	ShutdownTimeout time.Duration
+	// CachePolicies maps operationIds to the Cache-Control header their responses carry.
+	CachePolicies map[string]string
+	TLS           TLSConfig
+}
+
+// TLSConfig enables HTTPS, and mutual TLS when ClientCAFile is set.
+type TLSConfig struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile holds the PEM bundle client certificates must chain to.
+	ClientCAFile string
+	// Port serves TLS next to the plain listener; when empty TLS replaces the plain listener.
+	Port string
+}
+
+// Enabled reports whether a certificate is configured.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
+// LoadConfig reads the HTTP_* environment variables.
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		ReadHeaderTimeout: DefaultReadHeaderTimeout,
+		ReadTimeout:       DefaultReadTimeout,
+		IdleTimeout:       DefaultIdleTimeout,
+		MaxHeaderBytes:    DefaultMaxHeaderBytes,
+		MaxBodyBytes:      DefaultMaxBodyBytes,
+		StreamingTimeout:  DefaultStreamingTimeout,
+		DrainDelay:        DefaultDrainDelay,
+		ShutdownTimeout:   DefaultShutdownTimeout,
+		CachePolicies:     maps.Clone(DefaultCachePolicies),
+		TLS: TLSConfig{
+			CertFile:     strings.TrimSpace(os.Getenv("HTTP_TLS_CERT_FILE")),
+			KeyFile:      strings.TrimSpace(os.Getenv("HTTP_TLS_KEY_FILE")),
+			ClientCAFile: strings.TrimSpace(os.Getenv("HTTP_TLS_CLIENT_CA_FILE")),
+			Port:         strings.TrimSpace(os.Getenv("HTTP_TLS_PORT")),
+		},
+	}
+	durations := []struct {
+		env    string
+		target *time.Duration
+	}{
+		{"HTTP_READ_HEADER_TIMEOUT_SECONDS", &cfg.ReadHeaderTimeout},
+		{"HTTP_READ_TIMEOUT_SECONDS", &cfg.ReadTimeout},
+		{"HTTP_WRITE_TIMEOUT_SECONDS", &cfg.WriteTimeout},
+		{"HTTP_STREAMING_TIMEOUT_SECONDS", &cfg.StreamingTimeout},
+		{"HTTP_IDLE_TIMEOUT_SECONDS", &cfg.IdleTimeout},
+		{"HTTP_SHUTDOWN_DRAIN_SECONDS", &cfg.DrainDelay},
+		{"HTTP_SHUTDOWN_TIMEOUT_SECONDS", &cfg.ShutdownTimeout},
+	}
+	for _, d := range durations {
+		raw := strings.TrimSpace(os.Getenv(d.env))
+		if raw == "" {
+			continue
+		}
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds < 0 {
+			return Config{}, fmt.Errorf("%s must be a non-negative integer", d.env)
+		}
+		*d.target = time.Duration(seconds) * time.Second
+	}
+	if raw := strings.TrimSpace(os.Getenv("HTTP_MAX_HEADER_BYTES")); raw != "" {
+		size, err := strconv.Atoi(raw)
+		if err != nil || size <= 0 {
+			return Config{}, fmt.Errorf("HTTP_MAX_HEADER_BYTES must be a positive integer")
+		}
+		cfg.MaxHeaderBytes = size
+	}
+	if raw := strings.TrimSpace(os.Getenv("HTTP_MAX_BODY_BYTES")); raw != "" {
+		size, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || size < 0 {
+			return Config{}, fmt.Errorf("HTTP_MAX_BODY_BYTES must be a non-negative integer")
+		}
+		cfg.MaxBodyBytes = size
+	}
+	policies, err := parseCachePolicies(os.Getenv("HTTP_CACHE_CONTROL"))
+	if err != nil {
+		return Config{}, err
+	}
+	maps.Copy(cfg.CachePolicies, policies)
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// parseCachePolicies reads "operationId=policy" pairs separated by semicolons, e.g.
+// "getPetById=private, max-age=30;findPetsByTags=no-store". An empty policy removes the header.
+func parseCachePolicies(raw string) (map[string]string, error) {
+	policies := map[string]string{}
+	for _, entry := range strings.Split(raw, ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		operationID, policy, ok := strings.Cut(entry, "=")
+		operationID = strings.TrimSpace(operationID)
+		if !ok || operationID == "" {
+			return nil, fmt.Errorf("HTTP_CACHE_CONTROL entry %q must look like operationId=policy", strings.TrimSpace(entry))
+		}
+		policies[operationID] = strings.TrimSpace(policy)
+	}
+	return policies, nil
+}
+
+// Validate rejects incomplete TLS settings.
+func (c Config) Validate() error {
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return errors.New("HTTP_TLS_CERT_FILE and HTTP_TLS_KEY_FILE must be set together")
+	}
+	if !c.TLS.Enabled() && (c.TLS.ClientCAFile != "" || c.TLS.Port != "") {
+		return errors.New("HTTP_TLS_CLIENT_CA_FILE and HTTP_TLS_PORT need HTTP_TLS_CERT_FILE and HTTP_TLS_KEY_FILE")
+	}
+	return nil
+}
+
+// Summary describes the configuration without file contents.
+func (c Config) Summary() map[string]any {
+	tls := "off"
+	switch {
+	case c.TLS.ClientCAFile != "":
+		tls = "mtls"
+	case c.TLS.Enabled():
+		tls = "tls"
+	}
+	return map[string]any{
+		"read_header_timeout_secs": c.ReadHeaderTimeout.Seconds(),
+		"read_timeout_secs":        c.ReadTimeout.Seconds(),
+		"write_timeout_secs":       c.WriteTimeout.Seconds(),
+		"idle_timeout_secs":        c.IdleTimeout.Seconds(),
+		"max_header_bytes":         c.MaxHeaderBytes,
+		"max_body_bytes":           c.MaxBodyBytes,
+		"streaming_timeout_secs":   c.StreamingTimeout.Seconds(),
+		"drain_delay_secs":         c.DrainDelay.Seconds(),
+		"shutdown_timeout_secs":    c.ShutdownTimeout.Seconds(),
+		"tls":                      tls,
+		"tls_port":                 c.TLS.Port,
+		"cache_policies":           c.CachePolicies,
+	}
+}
@@ -0,0 +1,68 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// RouteLimit replaces the request limits for one route, e.g. a streaming upload or response that
+// may run far past the server-wide body cap and timeouts.
+type RouteLimit struct {
+	Method string
+	// Route is the gin route template, e.g. "/v2/pet/bulk".
+	Route string
+	// MaxBodyBytes caps the body; zero lifts the cap.
+	MaxBodyBytes int64
+	// Deadline bounds reading the body and writing the response in place of the server's
+	// ReadTimeout and WriteTimeout; zero lifts both.
+	Deadline time.Duration
+}
+
+// LimitBody rejects requests whose declared Content-Length exceeds limit with a 413 problem and
+// caps streamed bodies so reads past the limit fail with *http.MaxBytesError. Zero disables it.
+// Routes listed in overrides get their own body cap and deadline instead.
+func LimitBody(limit int64, overrides ...RouteLimit) gin.HandlerFunc {
+	routes := make(map[string]RouteLimit, len(overrides))
+	for _, override := range overrides {
+		routes[override.Method+" "+override.Route] = override
+	}
+	return func(c *gin.Context) {
+		limit := limit
+		if override, ok := routes[c.Request.Method+" "+c.FullPath()]; ok {
+			limit = override.MaxBodyBytes
+			extendDeadlines(c, override.Deadline)
+		}
+		if limit <= 0 || c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+		if c.Request.ContentLength > limit {
+			apierrors.Respond(c, apierrors.NewPayloadTooLargeProblem(limit))
+			c.Abort()
+			return
+		}
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		c.Next()
+	}
+}
+
+// extendDeadlines moves the connection's read and write deadlines to deadline from now, or clears
+// them when deadline is zero. Writers that cannot set deadlines, such as test recorders, keep theirs.
+func extendDeadlines(c *gin.Context, deadline time.Duration) {
+	var at time.Time
+	if deadline > 0 {
+		at = time.Now().Add(deadline)
+	}
+	controller := http.NewResponseController(c.Writer)
+	if err := controller.SetReadDeadline(at); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		_ = c.Error(err)
+	}
+	if err := controller.SetWriteDeadline(at); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		_ = c.Error(err)
+	}
+}
@@ -0,0 +1,23 @@
+package httpserver
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
+)
+
+// RequestID reuses a valid X-Request-ID from the client or generates one, echoes it on the
+// response, stores it in the request context, and tags the server span with it. Mount it right
+// after otelgin so problems written by later middleware already carry the ID.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := requestid.Ensure(c.GetHeader(requestid.Header))
+		c.Header(requestid.Header, id)
+		ctx := requestid.WithID(c.Request.Context(), id)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String(requestid.SpanAttribute, id))
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
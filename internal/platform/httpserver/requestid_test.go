@@ -0,0 +1,57 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
+)
+
+func TestRequestID_ReusesValidIDsAndStampsProblems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/v2/pet/:petId", func(c *gin.Context) {
+		require.Equal(t, c.Writer.Header().Get(requestid.Header), requestid.FromContext(c.Request.Context()))
+		apierrors.Respond(c, apierrors.NewNotFoundProblem("pet", c.Param("petId")))
+	})
+
+	cases := []struct {
+		name   string
+		header string
+		reused bool
+	}{
+		{name: "client id", header: "support-4711", reused: true},
+		{name: "missing", header: ""},
+		{name: "control characters", header: "bad\tid"},
+		{name: "too long", header: strings.Repeat("a", requestid.MaxLength+1)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v2/pet/9", nil)
+			if tc.header != "" {
+				req.Header.Set(requestid.Header, tc.header)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			id := rec.Header().Get(requestid.Header)
+			require.True(t, requestid.Valid(id))
+			if tc.reused {
+				require.Equal(t, tc.header, id)
+			} else {
+				require.NotEqual(t, tc.header, id)
+			}
+			var problem apierrors.ProblemDetail
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			require.Equal(t, apierrors.RequestInstancePrefix+id, problem.Instance)
+		})
+	}
+}
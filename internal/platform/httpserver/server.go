@@ -0,0 +1,189 @@
+// Package httpserver runs the API behind an http.Server with hardened limits, optional TLS/mTLS,
+// and a graceful shutdown that flips readiness before draining in-flight requests.
+package httpserver
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// Server serves one handler on a plain listener, a TLS listener, or both.
+type Server struct {
+	addr       string
+	handler    http.Handler
+	cfg        Config
+	logger     *slog.Logger
+	onShutdown []func()
+	ready      atomic.Bool
+}
+
+// Option customizes optional Server collaborators.
+type Option func(*Server)
+
+// WithLogger records listener, drain, and shutdown events.
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// WithOnShutdown runs fn when the listeners stop accepting, after the drain delay. Long-lived
+// responses such as event streams use it to end instead of holding the shutdown open.
+func WithOnShutdown(fn func()) Option {
+	return func(s *Server) {
+		s.onShutdown = append(s.onShutdown, fn)
+	}
+}
+
+// New prepares a server for addr (e.g. ":8080"); nothing is bound until Run.
+func New(addr string, handler http.Handler, cfg Config, opts ...Option) *Server {
+	s := &Server{addr: addr, handler: handler, cfg: cfg}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	if s.logger == nil {
+		s.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+	}
+	return s
+}
+
+// Ready reports whether the server should receive traffic. It turns false as soon as shutdown
+// starts, while in-flight and keep-alive requests are still being served.
+func (s *Server) Ready() bool {
+	return s.ready.Load()
+}
+
+// Run binds the configured listeners and serves until ctx is cancelled, then shuts down gracefully.
+func (s *Server) Run(ctx context.Context) error {
+	listeners, err := s.Listen()
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, listeners...)
+}
+
+// Listen binds the plain listener on the server address unless TLS replaces it, and the TLS
+// listener on HTTP_TLS_PORT (or the server address) when a certificate is configured.
+func (s *Server) Listen() ([]net.Listener, error) {
+	var listeners []net.Listener
+	closeAll := func() {
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	}
+	if !s.cfg.TLS.Enabled() || s.cfg.TLS.Port != "" {
+		l, err := net.Listen("tcp", s.addr)
+		if err != nil {
+			return nil, fmt.Errorf("listen on %s: %w", s.addr, err)
+		}
+		listeners = append(listeners, l)
+	}
+	if s.cfg.TLS.Enabled() {
+		tlsConfig, err := s.cfg.TLS.serverConfig()
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		addr := s.addr
+		if s.cfg.TLS.Port != "" {
+			addr = ":" + s.cfg.TLS.Port
+		}
+		l, err := net.Listen("tcp", addr)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("listen on %s: %w", addr, err)
+		}
+		listeners = append(listeners, tls.NewListener(l, tlsConfig))
+	}
+	return listeners, nil
+}
+
+// Serve accepts connections on listeners until ctx is cancelled or a listener fails. On
+// cancellation readiness flips first, the drain delay lets load balancers notice, and
+// in-flight requests then get up to the shutdown timeout to finish.
+func (s *Server) Serve(ctx context.Context, listeners ...net.Listener) error {
+	srv := &http.Server{
+		Handler:           s.handler,
+		ReadHeaderTimeout: s.cfg.ReadHeaderTimeout,
+		ReadTimeout:       s.cfg.ReadTimeout,
+		WriteTimeout:      s.cfg.WriteTimeout,
+		IdleTimeout:       s.cfg.IdleTimeout,
+		MaxHeaderBytes:    s.cfg.MaxHeaderBytes,
+		ErrorLog:          slog.NewLogLogger(s.logger.Handler(), slog.LevelWarn),
+	}
+	for _, fn := range s.onShutdown {
+		srv.RegisterOnShutdown(fn)
+	}
+	serveErrs := make(chan error, len(listeners))
+	for _, l := range listeners {
+		s.logger.Info("HTTP listener started", slog.String("addr", l.Addr().String()))
+		go func(l net.Listener) {
+			serveErrs <- srv.Serve(l)
+		}(l)
+	}
+	s.ready.Store(true)
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+		s.ready.Store(false)
+		s.logger.Info("shutdown requested, draining HTTP server", slog.Duration("drain_delay", s.cfg.DrainDelay))
+		if s.cfg.DrainDelay > 0 {
+			timer := time.NewTimer(s.cfg.DrainDelay)
+			<-timer.C
+		}
+	case serveErr = <-serveErrs:
+		s.ready.Store(false)
+		s.logger.Error("HTTP listener failed", slog.String("error", serveErr.Error()))
+	}
+
+	shutdownCtx := context.Background()
+	if s.cfg.ShutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		shutdownCtx, cancel = context.WithTimeout(shutdownCtx, s.cfg.ShutdownTimeout)
+		defer cancel()
+	}
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		_ = srv.Close()
+		return errors.Join(serveErr, fmt.Errorf("graceful shutdown: %w", err))
+	}
+	s.logger.Info("HTTP server stopped")
+	return serveErr
+}
+
+// serverConfig loads the certificate and, for mTLS, the client CA pool.
+func (c TLSConfig) serverConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load TLS certificate: %w", err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+	if c.ClientCAFile != "" {
+		pem, err := os.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("client CA bundle %s holds no PEM certificates", c.ClientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
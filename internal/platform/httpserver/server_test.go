@@ -0,0 +1,190 @@
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_DrainsInFlightRequestsOnShutdown(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		_, _ = io.WriteString(w, "done")
+	})
+	cfg := Config{DrainDelay: 50 * time.Millisecond, ShutdownTimeout: 5 * time.Second}
+	server := New("127.0.0.1:0", handler, cfg, WithLogger(slog.New(slog.NewTextHandler(io.Discard, nil))))
+	listeners, err := server.Listen()
+	require.NoError(t, err)
+	require.Len(t, listeners, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- server.Serve(ctx, listeners...) }()
+
+	type result struct {
+		body string
+		err  error
+	}
+	responses := make(chan result, 1)
+	go func() {
+		res, err := http.Get("http://" + listeners[0].Addr().String())
+		if err != nil {
+			responses <- result{err: err}
+			return
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		responses <- result{body: string(body), err: err}
+	}()
+	<-started
+	require.True(t, server.Ready())
+
+	cancel()
+	require.Eventually(t, func() bool { return !server.Ready() }, time.Second, 5*time.Millisecond)
+	select {
+	case err := <-served:
+		t.Fatalf("server stopped before the in-flight request finished: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	got := <-responses
+	require.NoError(t, got.err)
+	require.Equal(t, "done", got.body)
+	require.NoError(t, <-served)
+}
+
+func TestLimitBody_RejectsOversizedBodies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(LimitBody(8))
+	router.POST("/echo", func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			_, tooLarge := err.(*http.MaxBytesError)
+			c.String(http.StatusRequestEntityTooLarge, "streamed too large: %t", tooLarge)
+			return
+		}
+		c.String(http.StatusOK, string(body))
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader("small")))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "small", rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader("far too large")))
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+	require.Contains(t, rec.Body.String(), `"maxBodyBytes":8`)
+
+	// Without a Content-Length the limit applies while the handler reads.
+	req := httptest.NewRequest(http.MethodPost, "/echo", io.NopCloser(strings.NewReader("far too large")))
+	req.ContentLength = -1
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.Equal(t, "streamed too large: true", rec.Body.String())
+}
+
+func TestLimitBody_StreamingRouteOutlivesBodyCapAndReadTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(LimitBody(DefaultMaxBodyBytes, RouteLimit{Method: http.MethodPost, Route: "/v2/pet/bulk", Deadline: time.Minute}))
+	count := func(c *gin.Context) {
+		n, err := io.Copy(io.Discard, c.Request.Body)
+		if err != nil {
+			c.String(http.StatusRequestEntityTooLarge, err.Error())
+			return
+		}
+		c.String(http.StatusOK, "%d", n)
+	}
+	router.POST("/v2/pet/bulk", count)
+	router.POST("/v2/pet", count)
+	server := httptest.NewUnstartedServer(router)
+	server.Config.ReadTimeout = 200 * time.Millisecond
+	server.Start()
+	t.Cleanup(server.Close)
+
+	// Eleven 1 MiB chunks sent over ~440ms: past both the 10 MiB cap and the read timeout.
+	const chunks = 11
+	body, writer := io.Pipe()
+	go func() {
+		chunk := []byte(strings.Repeat("x", 1<<20))
+		for range chunks {
+			time.Sleep(40 * time.Millisecond)
+			if _, err := writer.Write(chunk); err != nil {
+				return
+			}
+		}
+		_ = writer.Close()
+	}()
+	resp, err := server.Client().Post(server.URL+"/v2/pet/bulk", "application/x-ndjson", body)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	got, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, string(got))
+	require.Equal(t, strconv.Itoa(chunks<<20), string(got))
+
+	resp, err = server.Client().Post(server.URL+"/v2/pet", "application/json", strings.NewReader(strings.Repeat("x", DefaultMaxBodyBytes+1)))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode, "other routes keep the body cap")
+}
+
+func TestLimitBody_StreamingResponsesOutliveWriteTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(LimitBody(DefaultMaxBodyBytes,
+		RouteLimit{Method: http.MethodGet, Route: "/v2/pet/export", Deadline: time.Minute},
+		RouteLimit{Method: http.MethodGet, Route: "/v2/pet/events"},
+	))
+	stream := func(c *gin.Context) {
+		for i := range 5 {
+			time.Sleep(60 * time.Millisecond)
+			if _, err := fmt.Fprintf(c.Writer, "chunk %d\n", i); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+	router.GET("/v2/pet/export", stream)
+	router.GET("/v2/pet/events", stream)
+	router.GET("/v2/pet/findByStatus", stream)
+	server := httptest.NewUnstartedServer(router)
+	server.Config.WriteTimeout = 150 * time.Millisecond
+	server.Start()
+	t.Cleanup(server.Close)
+
+	read := func(path string) (string, error) {
+		resp, err := server.Client().Get(server.URL + path)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		got, err := io.ReadAll(resp.Body)
+		return string(got), err
+	}
+	for _, path := range []string{"/v2/pet/export", "/v2/pet/events"} {
+		got, err := read(path)
+		require.NoError(t, err, path)
+		require.Equal(t, 5, strings.Count(got, "chunk"), path)
+	}
+	got, err := read("/v2/pet/findByStatus")
+	require.Less(t, strings.Count(got, "chunk"), 5, "other routes keep the write timeout (err: %v)", err)
+}
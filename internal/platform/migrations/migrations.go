@@ -12,35 +12,236 @@ func Run(db *gorm.DB) error {
 	if db == nil {
 		return nil
 	}
-	return db.AutoMigrate(
+	if err := db.AutoMigrate(
+		&categoryRecord{},
 		&petRecord{},
+		&tagRecord{},
+		&petTagRecord{},
+		&groomingAppointmentRecord{},
+		&groomingRecord{},
 		&petIdempotencyRecord{},
+		&partnerImportReviewRecord{},
+		&partnerWebhookDeliveryRecord{},
 		&orderRecord{},
 		&userRecord{},
 		&sessionRecord{},
-	)
+		&apiKeyRecord{},
+	); err != nil {
+		return err
+	}
+	if err := migrateCategories(db); err != nil {
+		return err
+	}
+	if err := migrateTags(db); err != nil {
+		return err
+	}
+	// Bulk imports used to upsert explicit ids without moving the sequence; catch it up once.
+	if err := db.Exec("SELECT setval(pg_get_serial_sequence('pets', 'id'), COALESCE((SELECT MAX(id) FROM pets), 0) + 1, false)").Error; err != nil {
+		return err
+	}
+	if err := migratePartnerReferences(db); err != nil {
+		return err
+	}
+	// Appointments and their history go with the pet; history rows go with the appointment.
+	if err := addConstraints(db, []constraint{
+		{"grooming_appointments", "fk_grooming_appointments_pet", "ALTER TABLE grooming_appointments ADD CONSTRAINT fk_grooming_appointments_pet FOREIGN KEY (pet_id) REFERENCES pets (id) ON DELETE CASCADE"},
+		{"grooming_records", "fk_grooming_records_appointment", "ALTER TABLE grooming_records ADD CONSTRAINT fk_grooming_records_appointment FOREIGN KEY (appointment_id) REFERENCES grooming_appointments (id) ON DELETE CASCADE"},
+	}); err != nil {
+		return err
+	}
+	// Pet catalog events take their ids from one sequence so Last-Event-ID means the same thing on every instance.
+	return db.Exec("CREATE SEQUENCE IF NOT EXISTS pet_event_ids").Error
+}
+
+// migrateCategories backfills the categories table from the names denormalized onto pets,
+// then enforces unique names and the foreign keys from pets and parent categories.
+func migrateCategories(db *gorm.DB) error {
+	statements := []string{
+		"UPDATE pets SET category_id = NULL WHERE category_id = 0",
+		`INSERT INTO categories (id, name, created_at, updated_at)
+			SELECT DISTINCT ON (category_id) category_id, COALESCE(NULLIF(TRIM(category_name), ''), 'category-' || category_id), NOW(), NOW()
+			FROM pets WHERE category_id IS NOT NULL
+			ORDER BY category_id, updated_at DESC
+			ON CONFLICT (id) DO NOTHING`,
+		// Legacy rows could reuse a name under different ids; suffix all but the lowest id.
+		`UPDATE categories c SET name = c.name || '-' || c.id
+			WHERE EXISTS (SELECT 1 FROM categories o WHERE lower(o.name) = lower(c.name) AND o.id < c.id)`,
+		"SELECT setval(pg_get_serial_sequence('categories', 'id'), COALESCE((SELECT MAX(id) FROM categories), 0) + 1, false)",
+		"CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_name ON categories (lower(name))",
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return addConstraints(db, []constraint{
+		{"pets", "fk_pets_category", "ALTER TABLE pets ADD CONSTRAINT fk_pets_category FOREIGN KEY (category_id) REFERENCES categories (id) ON DELETE RESTRICT"},
+		{"categories", "fk_categories_parent", "ALTER TABLE categories ADD CONSTRAINT fk_categories_parent FOREIGN KEY (parent_id) REFERENCES categories (id) ON DELETE RESTRICT"},
+	})
+}
+
+// migratePartnerReferences makes the primary partner reference unique, so concurrent webhook
+// deliveries for a new partner pet cannot both insert it, and indexes partner_references for the
+// containment lookup of secondary references. Duplicates left by earlier races stay linked only
+// on the lowest pet id.
+func migratePartnerReferences(db *gorm.DB) error {
+	statements := []string{
+		`UPDATE pets p SET external_provider = '', external_id = ''
+			WHERE p.external_id <> '' AND EXISTS (SELECT 1 FROM pets o
+				WHERE o.external_provider = p.external_provider AND o.external_id = p.external_id AND o.id < p.id)`,
+		"DROP INDEX IF EXISTS idx_pets_external_ref",
+		"CREATE UNIQUE INDEX IF NOT EXISTS idx_pets_external_ref_unique ON pets (external_provider, external_id) WHERE external_id <> ''",
+		"CREATE INDEX IF NOT EXISTS idx_pets_partner_references ON pets USING GIN (partner_references jsonb_path_ops)",
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+// constraint is a named table constraint with the DDL that adds it.
+type constraint struct{ table, name, ddl string }
+
+// addConstraints adds the constraints that do not exist yet; Postgres has no
+// ADD CONSTRAINT IF NOT EXISTS.
+func addConstraints(db *gorm.DB, constraints []constraint) error {
+	for _, c := range constraints {
+		if db.Migrator().HasConstraint(c.table, c.name) {
+			continue
+		}
+		if err := db.Exec(c.ddl).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateTags moves the tag_ids/tag_names arrays on pets into the tag catalog and pet_tags
+// once, then drops them. Only names carry over: the array ids were client-supplied and never
+// matched up across pets, so the catalog assigns new ones.
+func migrateTags(db *gorm.DB) error {
+	if db.Migrator().HasColumn(&petRecord{}, "tag_names") {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			statements := []string{
+				`INSERT INTO tags (name, created_at, updated_at)
+					SELECT DISTINCT lower(trim(tag.name)), NOW(), NOW()
+					FROM pets CROSS JOIN LATERAL unnest(pets.tag_names) AS tag(name)
+					WHERE trim(tag.name) <> ''
+					ON CONFLICT (name) DO NOTHING`,
+				`INSERT INTO pet_tags (pet_id, tag_id, position)
+					SELECT pets.id, tags.id, MIN(tag.position)
+					FROM pets CROSS JOIN LATERAL unnest(pets.tag_names) WITH ORDINALITY AS tag(name, position)
+					JOIN tags ON tags.name = lower(trim(tag.name))
+					GROUP BY pets.id, tags.id
+					ON CONFLICT (pet_id, tag_id) DO NOTHING`,
+				"ALTER TABLE pets DROP COLUMN IF EXISTS tag_ids, DROP COLUMN IF EXISTS tag_names",
+			}
+			for _, statement := range statements {
+				if err := tx.Exec(statement).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return addConstraints(db, []constraint{
+		{"pet_tags", "fk_pet_tags_pet", "ALTER TABLE pet_tags ADD CONSTRAINT fk_pet_tags_pet FOREIGN KEY (pet_id) REFERENCES pets (id) ON DELETE CASCADE"},
+		{"pet_tags", "fk_pet_tags_tag", "ALTER TABLE pet_tags ADD CONSTRAINT fk_pet_tags_tag FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE RESTRICT"},
+	})
+}
+
+// Category schema mirrors the pets category adapter.
+type categoryRecord struct {
+	ID        int64     `gorm:"primaryKey;column:id"`
+	Name      string    `gorm:"column:name"`
+	ParentID  *int64    `gorm:"column:parent_id;index"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (categoryRecord) TableName() string { return "categories" }
+
+// Tag catalog schema mirrors the pets tag adapter.
+type tagRecord struct {
+	ID        int64     `gorm:"primaryKey;column:id"`
+	Name      string    `gorm:"column:name;uniqueIndex:idx_tags_name"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (tagRecord) TableName() string { return "tags" }
+
+// petTagRecord links pets to tags. The primary key serves per-pet reads; the (tag_id, pet_id)
+// index answers tag searches and usage counts without touching pets.
+type petTagRecord struct {
+	PetID    int64 `gorm:"primaryKey;column:pet_id;index:idx_pet_tags_tag_pet,priority:2"`
+	TagID    int64 `gorm:"primaryKey;column:tag_id;index:idx_pet_tags_tag_pet,priority:1"`
+	Position int   `gorm:"column:position"`
+}
+
+func (petTagRecord) TableName() string { return "pet_tags" }
+
+// groomingAppointmentRecord mirrors the pets appointment adapter. The (groomer, starts_at) index
+// serves the calendar conflict check and groomer listings.
+type groomingAppointmentRecord struct {
+	ID              int64      `gorm:"primaryKey;column:id"`
+	PetID           int64      `gorm:"column:pet_id;index"`
+	Groomer         string     `gorm:"column:groomer;index:idx_grooming_appointments_groomer_slot,priority:1"`
+	StartsAt        time.Time  `gorm:"column:starts_at;index:idx_grooming_appointments_groomer_slot,priority:2"`
+	EndsAt          time.Time  `gorm:"column:ends_at"`
+	RequestedTrimCm float64    `gorm:"column:requested_trim_cm"`
+	Status          string     `gorm:"column:status;type:varchar(32)"`
+	RemindedAt      *time.Time `gorm:"column:reminded_at"`
+	CompletedAt     *time.Time `gorm:"column:completed_at"`
+	CreatedAt       time.Time  `gorm:"column:created_at"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at"`
+}
+
+func (groomingAppointmentRecord) TableName() string { return "grooming_appointments" }
+
+// groomingRecord keeps the measurements of completed appointments.
+type groomingRecord struct {
+	AppointmentID   int64     `gorm:"primaryKey;column:appointment_id"`
+	PetID           int64     `gorm:"column:pet_id;index:idx_grooming_records_pet,priority:1"`
+	Groomer         string    `gorm:"column:groomer"`
+	InitialLengthCm float64   `gorm:"column:initial_length_cm"`
+	TrimByCm        float64   `gorm:"column:trim_by_cm"`
+	ResultLengthCm  float64   `gorm:"column:result_length_cm"`
+	GroomedAt       time.Time `gorm:"column:groomed_at;index:idx_grooming_records_pet,priority:2"`
+}
+
+func (groomingRecord) TableName() string { return "grooming_records" }
+
 // Pet schema mirrors the pets Postgres adapter.
 type petRecord struct {
-	ID                 int64             `gorm:"primaryKey;column:id"`
-	CategoryID         *int64            `gorm:"column:category_id"`
-	CategoryName       string            `gorm:"column:category_name"`
-	Name               string            `gorm:"column:name"`
-	PhotoURLs          pq.StringArray    `gorm:"column:photo_urls;type:text[]"`
-	Status             string            `gorm:"column:status;type:varchar(32);index"`
-	HairLengthCm       float64           `gorm:"column:hair_length_cm"`
-	TagIDs             pq.Int64Array     `gorm:"column:tag_ids;type:bigint[]"`
-	TagNames           pq.StringArray    `gorm:"column:tag_names;type:text[]"`
-	ExternalProvider   string            `gorm:"column:external_provider"`
-	ExternalID         string            `gorm:"column:external_id"`
-	ExternalAttributes map[string]string `gorm:"column:external_attributes;type:jsonb;serializer:json"`
-	CreatedAt          time.Time         `gorm:"column:created_at"`
-	UpdatedAt          time.Time         `gorm:"column:updated_at"`
+	ID                 int64              `gorm:"primaryKey;column:id"`
+	CategoryID         *int64             `gorm:"column:category_id;index"`
+	CategoryName       string             `gorm:"column:category_name"`
+	Name               string             `gorm:"column:name"`
+	PhotoURLs          pq.StringArray     `gorm:"column:photo_urls;type:text[]"`
+	Status             string             `gorm:"column:status;type:varchar(32);index"`
+	HairLengthCm       float64            `gorm:"column:hair_length_cm"`
+	ExternalProvider   string             `gorm:"column:external_provider"`
+	ExternalID         string             `gorm:"column:external_id"`
+	ExternalAttributes map[string]string  `gorm:"column:external_attributes;type:jsonb;serializer:json"`
+	PartnerReferences  []partnerRefRecord `gorm:"column:partner_references;type:jsonb;serializer:json"`
+	CreatedAt          time.Time          `gorm:"column:created_at"`
+	UpdatedAt          time.Time          `gorm:"column:updated_at"`
 }
 
 func (petRecord) TableName() string { return "pets" }
 
+type partnerRefRecord struct {
+	Provider   string            `json:"provider"`
+	ID         string            `json:"id"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
 type petIdempotencyRecord struct {
 	Key         string    `gorm:"primaryKey;column:key;size:255"`
 	RequestHash string    `gorm:"column:request_hash;size:128"`
@@ -51,6 +252,27 @@ type petIdempotencyRecord struct {
 
 func (petIdempotencyRecord) TableName() string { return "pet_idempotency_keys" }
 
+type partnerImportReviewRecord struct {
+	ID         int64          `gorm:"primaryKey;column:id"`
+	Provider   string         `gorm:"column:provider;size:64;index"`
+	EventID    string         `gorm:"column:event_id;size:255"`
+	ExternalID string         `gorm:"column:external_id;size:255"`
+	Missing    pq.StringArray `gorm:"column:missing;type:text[]"`
+	Candidate  []byte         `gorm:"column:candidate;type:jsonb"`
+	CreatedAt  time.Time      `gorm:"column:created_at;index"`
+}
+
+func (partnerImportReviewRecord) TableName() string { return "partner_import_reviews" }
+
+type partnerWebhookDeliveryRecord struct {
+	Provider   string    `gorm:"primaryKey;column:provider;size:64"`
+	DeliveryID string    `gorm:"primaryKey;column:delivery_id;size:255"`
+	ExpiresAt  time.Time `gorm:"column:expires_at;index"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (partnerWebhookDeliveryRecord) TableName() string { return "partner_webhook_deliveries" }
+
 // Order schema mirrors the store Postgres adapter.
 type orderRecord struct {
 	ID        int64     `gorm:"primaryKey;column:id"`
@@ -91,3 +313,15 @@ type sessionRecord struct {
 }
 
 func (sessionRecord) TableName() string { return "user_sessions" }
+
+// API key schema mirrors the security key store.
+type apiKeyRecord struct {
+	ID        string     `gorm:"primaryKey;column:id;size:32"`
+	Name      string     `gorm:"column:name;size:255"`
+	KeyHash   string     `gorm:"column:key_hash;size:64;uniqueIndex"`
+	CreatedAt time.Time  `gorm:"column:created_at"`
+	ExpiresAt *time.Time `gorm:"column:expires_at"`
+	RevokedAt *time.Time `gorm:"column:revoked_at"`
+}
+
+func (apiKeyRecord) TableName() string { return "api_keys" }
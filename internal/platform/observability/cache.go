@@ -0,0 +1,63 @@
+package observability
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultGaugeCacheInterval bounds how often gauge callbacks hit repositories.
+const DefaultGaugeCacheInterval = 30 * time.Second
+
+// CachedObservation memoizes an expensive measurement so observable gauge callbacks,
+// which run on every collection, only reach the repositories once per interval.
+type CachedObservation[T any] struct {
+	mu       sync.Mutex
+	interval time.Duration
+	timeout  time.Duration
+	load     func(ctx context.Context) (T, error)
+	now      func() time.Time
+	value    T
+	loadedAt time.Time
+	loaded   bool
+}
+
+// NewCachedObservation wraps load with a cache that refreshes after interval.
+func NewCachedObservation[T any](interval time.Duration, load func(ctx context.Context) (T, error)) *CachedObservation[T] {
+	if interval <= 0 {
+		interval = DefaultGaugeCacheInterval
+	}
+	return &CachedObservation[T]{
+		interval: interval,
+		timeout:  5 * time.Second,
+		load:     load,
+		now:      time.Now,
+	}
+}
+
+// WithClock overrides the time source for deterministic testing.
+func (c *CachedObservation[T]) WithClock(now func() time.Time) {
+	if now != nil {
+		c.now = now
+	}
+}
+
+// Get returns the cached value while fresh, otherwise reloads it.
+// When a reload fails the previous value is returned together with the error so gauges keep reporting.
+func (c *CachedObservation[T]) Get(ctx context.Context) (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loaded && c.now().Sub(c.loadedAt) < c.interval {
+		return c.value, nil
+	}
+	loadCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	value, err := c.load(loadCtx)
+	if err != nil {
+		return c.value, err
+	}
+	c.value = value
+	c.loadedAt = c.now()
+	c.loaded = true
+	return value, nil
+}
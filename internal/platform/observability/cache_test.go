@@ -0,0 +1,39 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachedObservation_RefreshesAfterIntervalAndKeepsStaleValueOnError(t *testing.T) {
+	now := time.Unix(0, 0)
+	calls := 0
+	var loadErr error
+	cache := NewCachedObservation(time.Minute, func(context.Context) (int, error) {
+		calls++
+		if loadErr != nil {
+			return 0, loadErr
+		}
+		return calls, nil
+	})
+	cache.WithClock(func() time.Time { return now })
+
+	value, err := cache.Get(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, value)
+
+	value, err = cache.Get(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, value)
+	require.Equal(t, 1, calls)
+
+	now = now.Add(2 * time.Minute)
+	loadErr = errors.New("db down")
+	value, err = cache.Get(t.Context())
+	require.Error(t, err)
+	require.Equal(t, 1, value)
+}
@@ -0,0 +1,209 @@
+package observability
+
+import (
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Exporter selects the span exporter used by the tracer provider.
+type Exporter string
+
+const (
+	// ExporterOTLP ships spans to an OTLP collector using the configured protocol.
+	ExporterOTLP Exporter = "otlp"
+	// ExporterStdout writes spans to stdout, useful for local debugging.
+	ExporterStdout Exporter = "stdout"
+	// ExporterNone keeps tracing enabled in-process but drops every finished span.
+	ExporterNone Exporter = "none"
+)
+
+// Protocol selects the OTLP transport.
+type Protocol string
+
+const (
+	ProtocolHTTP Protocol = "http/protobuf"
+	ProtocolGRPC Protocol = "grpc"
+)
+
+const (
+	defaultEnvironment    = "local"
+	defaultServiceVersion = "dev"
+)
+
+// DefaultRouteSampling never traces probe endpoints that are hit every few seconds.
+var DefaultRouteSampling = map[string]float64{
+	"/healthz": 0,
+	"/readyz":  0,
+}
+
+// Config carries the typed settings for Init.
+type Config struct {
+	ServiceName    string
+	ServiceVersion string
+	Environment    string
+	// Noop disables the SDK entirely: tracer and meter providers are no-ops and logs are discarded.
+	Noop     bool
+	Exporter Exporter
+	Protocol Protocol
+	// Endpoint is the collector host:port; empty uses the exporter default.
+	Endpoint string
+	Insecure bool
+	// SampleRatio is applied to root spans; children follow their parent's decision.
+	SampleRatio float64
+	// RouteSampling overrides SampleRatio per HTTP route (matched on the http.route span attribute).
+	RouteSampling map[string]float64
+}
+
+// LoadConfig reads OpenTelemetry settings from the standard OTEL_* variables plus ENVIRONMENT.
+func LoadConfig(serviceName string) (Config, error) {
+	cfg := Config{
+		ServiceName:    envOrDefault("OTEL_SERVICE_NAME", serviceName),
+		ServiceVersion: envOrDefault("SERVICE_VERSION", buildVersion()),
+		Environment:    envOrDefault("ENVIRONMENT", defaultEnvironment),
+		Noop:           isTruthy(os.Getenv("OTEL_SDK_DISABLED")),
+		Exporter:       Exporter(strings.ToLower(envOrDefault("OTEL_TRACES_EXPORTER", string(ExporterOTLP)))),
+		Protocol:       Protocol(strings.ToLower(envOrDefault("OTEL_EXPORTER_OTLP_PROTOCOL", string(ProtocolHTTP)))),
+		Endpoint:       strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")),
+		Insecure:       strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_INSECURE")) != "0",
+		SampleRatio:    1,
+		RouteSampling:  cloneRouteSampling(DefaultRouteSampling),
+	}
+	if raw := strings.TrimSpace(os.Getenv("OTEL_TRACES_SAMPLER_ARG")); raw != "" {
+		ratio, err := parseRatio(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("OTEL_TRACES_SAMPLER_ARG %w", err)
+		}
+		cfg.SampleRatio = ratio
+	}
+	if raw := strings.TrimSpace(os.Getenv("OTEL_TRACES_ROUTE_SAMPLING")); raw != "" {
+		overrides, err := parseRouteSampling(raw)
+		if err != nil {
+			return Config{}, fmt.Errorf("OTEL_TRACES_ROUTE_SAMPLING %w", err)
+		}
+		for route, ratio := range overrides {
+			cfg.RouteSampling[route] = ratio
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Validate checks the enumerations and ratios.
+func (c Config) Validate() error {
+	if strings.TrimSpace(c.ServiceName) == "" {
+		return fmt.Errorf("observability service name is required")
+	}
+	if c.Noop {
+		return nil
+	}
+	switch c.Exporter {
+	case ExporterOTLP, ExporterStdout, ExporterNone:
+	default:
+		return fmt.Errorf("unsupported traces exporter %q", c.Exporter)
+	}
+	if c.Exporter == ExporterOTLP {
+		switch c.Protocol {
+		case ProtocolHTTP, ProtocolGRPC:
+		default:
+			return fmt.Errorf("unsupported OTLP protocol %q", c.Protocol)
+		}
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("sample ratio must be between 0 and 1")
+	}
+	for route, ratio := range c.RouteSampling {
+		if ratio < 0 || ratio > 1 {
+			return fmt.Errorf("sample ratio for route %s must be between 0 and 1", route)
+		}
+	}
+	return nil
+}
+
+// Summary returns a sanitized view suitable for debug endpoints.
+func (c Config) Summary() map[string]any {
+	routes := make([]string, 0, len(c.RouteSampling))
+	for route, ratio := range c.RouteSampling {
+		routes = append(routes, fmt.Sprintf("%s=%g", route, ratio))
+	}
+	sort.Strings(routes)
+	return map[string]any{
+		"service_name":    c.ServiceName,
+		"service_version": c.ServiceVersion,
+		"environment":     c.Environment,
+		"noop":            c.Noop,
+		"exporter":        c.Exporter,
+		"protocol":        c.Protocol,
+		"endpoint_set":    c.Endpoint != "",
+		"sample_ratio":    c.SampleRatio,
+		"route_sampling":  routes,
+	}
+}
+
+func parseRatio(raw string) (float64, error) {
+	ratio, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return 0, fmt.Errorf("must be a number between 0 and 1")
+	}
+	return ratio, nil
+}
+
+// parseRouteSampling accepts a comma separated list of route=ratio pairs, e.g. "/healthz=0,/v2/pet/:petId=0.1".
+func parseRouteSampling(raw string) (map[string]float64, error) {
+	overrides := map[string]float64{}
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		route, value, ok := strings.Cut(pair, "=")
+		route = strings.TrimSpace(route)
+		if !ok || route == "" {
+			return nil, fmt.Errorf("entry %q must use route=ratio", pair)
+		}
+		ratio, err := parseRatio(value)
+		if err != nil {
+			return nil, fmt.Errorf("entry %q %w", pair, err)
+		}
+		overrides[route] = ratio
+	}
+	return overrides, nil
+}
+
+func cloneRouteSampling(source map[string]float64) map[string]float64 {
+	copy := make(map[string]float64, len(source))
+	for k, v := range source {
+		copy[k] = v
+	}
+	return copy
+}
+
+// buildVersion derives service.version from the module version or VCS revision embedded by the Go toolchain.
+func buildVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return defaultServiceVersion
+	}
+	if v := info.Main.Version; v != "" && v != "(devel)" {
+		return v
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" && setting.Value != "" {
+			if len(setting.Value) > 12 {
+				return setting.Value[:12]
+			}
+			return setting.Value
+		}
+	}
+	return defaultServiceVersion
+}
+
+func isTruthy(value string) bool {
+	value = strings.TrimSpace(strings.ToLower(value))
+	return value == "1" || value == "true" || value == "yes"
+}
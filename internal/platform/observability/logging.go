@@ -0,0 +1,41 @@
+package observability
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
+)
+
+// RequestIDLogKey is the attribute carrying the request ID on log records.
+const RequestIDLogKey = "request_id"
+
+// NewContextHandler adds the request ID found in the record's context to every record, so logs
+// written with the *Context methods can be joined with problem responses and traces.
+func NewContextHandler(next slog.Handler) slog.Handler {
+	return contextHandler{next: next}
+}
+
+type contextHandler struct {
+	next slog.Handler
+}
+
+func (h contextHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := requestid.FromContext(ctx); id != "" {
+		record = record.Clone()
+		record.AddAttrs(slog.String(RequestIDLogKey, id))
+	}
+	return h.next.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{next: h.next.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{next: h.next.WithGroup(name)}
+}
@@ -0,0 +1,30 @@
+package observability
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
+)
+
+func TestContextHandler_AddsRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(NewContextHandler(slog.NewJSONHandler(&buf, nil))).With(slog.String("component", "pets"))
+
+	logger.InfoContext(requestid.WithID(context.Background(), "req-1"), "pet added")
+	logger.InfoContext(context.Background(), "gauge refreshed")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	var withID, withoutID map[string]any
+	require.NoError(t, json.Unmarshal(lines[0], &withID))
+	require.NoError(t, json.Unmarshal(lines[1], &withoutID))
+	require.Equal(t, "req-1", withID[RequestIDLogKey])
+	require.Equal(t, "pets", withID["component"])
+	require.NotContains(t, withoutID, RequestIDLogKey)
+}
@@ -3,12 +3,15 @@ package observability
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"strings"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/metric"
@@ -18,6 +21,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
 )
 
 // Instruments bundles the runtime-wide observability dependencies.
@@ -30,7 +34,13 @@ type Instruments struct {
 // Init configures slog, OpenTelemetry tracing, and meters for the process.
 // It returns initialized instruments plus a shutdown function that should be
 // invoked on exit to flush pending spans/metrics.
-func Init(ctx context.Context, serviceName string) (*Instruments, func(context.Context) error, error) {
+func Init(ctx context.Context, cfg Config) (*Instruments, func(context.Context) error, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if cfg.Noop {
+		return NewNoopInstruments(), func(context.Context) error { return nil }, nil
+	}
 	logger := newLogger()
 
 	res, err := resource.New(ctx,
@@ -39,23 +49,28 @@ func Init(ctx context.Context, serviceName string) (*Instruments, func(context.C
 		resource.WithTelemetrySDK(),
 		resource.WithHost(),
 		resource.WithAttributes(
-			attribute.String("service.name", serviceName),
-			attribute.String("deployment.environment", envOrDefault("ENVIRONMENT", "local")),
+			attribute.String("service.name", cfg.ServiceName),
+			attribute.String("service.version", cfg.ServiceVersion),
+			attribute.String("deployment.environment", cfg.Environment),
 		),
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	spanExporter, err := newSpanExporter(ctx, logger)
+	spanExporter, err := newSpanExporter(ctx, cfg)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, fmt.Errorf("init %s trace exporter: %w", cfg.Exporter, err)
 	}
 
-	tracerProvider := sdktrace.NewTracerProvider(
+	tracerOptions := []sdktrace.TracerProviderOption{
 		sdktrace.WithResource(res),
-		sdktrace.WithBatcher(spanExporter),
-	)
+		sdktrace.WithSampler(NewSampler(cfg.SampleRatio, cfg.RouteSampling)),
+	}
+	if spanExporter != nil {
+		tracerOptions = append(tracerOptions, sdktrace.WithBatcher(spanExporter))
+	}
+	tracerProvider := sdktrace.NewTracerProvider(tracerOptions...)
 	otel.SetTracerProvider(tracerProvider)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
 		propagation.TraceContext{},
@@ -82,9 +97,24 @@ func Init(ctx context.Context, serviceName string) (*Instruments, func(context.C
 		return shutdownErr
 	}
 
+	logger.Info("observability initialized",
+		slog.String("exporter", string(cfg.Exporter)),
+		slog.Float64("sample_ratio", cfg.SampleRatio),
+		slog.String("service_version", cfg.ServiceVersion),
+	)
 	return instruments, shutdown, nil
 }
 
+// NewNoopInstruments returns instruments that record nothing and discard logs.
+// Global OpenTelemetry providers are left untouched.
+func NewNoopInstruments() *Instruments {
+	return &Instruments{
+		Logger:         slog.New(slog.NewTextHandler(io.Discard, nil)),
+		TracerProvider: tracenoop.NewTracerProvider(),
+		MeterProvider:  metricnoop.NewMeterProvider(),
+	}
+}
+
 // Tracer returns a named tracer from the configured provider.
 func (i *Instruments) Tracer(name string) trace.Tracer {
 	if i == nil || i.TracerProvider == nil {
@@ -103,28 +133,36 @@ func (i *Instruments) Meter(name string) metric.Meter {
 
 func newLogger() *slog.Logger {
 	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo, AddSource: true})
-	logger := slog.New(handler)
+	logger := slog.New(NewContextHandler(handler))
 	slog.SetDefault(logger)
 	return logger
 }
 
-func newSpanExporter(ctx context.Context, logger *slog.Logger) (sdktrace.SpanExporter, error) {
-	endpoint := strings.TrimSpace(os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
-	opts := []otlptracehttp.Option{}
-	if endpoint != "" {
-		opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+func newSpanExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterNone:
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New()
 	}
-	if os.Getenv("OTEL_EXPORTER_OTLP_INSECURE") != "0" {
-		opts = append(opts, otlptracehttp.WithInsecure())
+	if cfg.Protocol == ProtocolGRPC {
+		opts := []otlptracegrpc.Option{}
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
 	}
-	exporter, err := otlptracehttp.New(ctx, opts...)
-	if err == nil {
-		return exporter, nil
+	opts := []otlptracehttp.Option{}
+	if cfg.Endpoint != "" {
+		opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
 	}
-	if logger != nil {
-		logger.Warn("failed to initialize OTLP trace exporter, falling back to stdout", slog.String("error", err.Error()))
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
 	}
-	return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	return otlptracehttp.New(ctx, opts...)
 }
 
 func newMeterProvider(res *resource.Resource) *sdkmetric.MeterProvider {
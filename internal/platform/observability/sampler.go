@@ -0,0 +1,71 @@
+package observability
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// httpRouteKey matches the semconv attribute otelgin sets on server spans.
+const httpRouteKey = attribute.Key("http.route")
+
+// routeSampler applies per-route ratios to root spans and otherwise follows the parent-based
+// default, so a trace started upstream stays complete. Probe routes (DefaultRouteSampling) use
+// their override even under a parent, so probes stay silent regardless of callers.
+type routeSampler struct {
+	fallback  sdktrace.Sampler
+	overrides map[string]sdktrace.Sampler
+}
+
+// NewSampler builds the process sampler: parent-based ratio sampling with per-route overrides.
+func NewSampler(ratio float64, routes map[string]float64) sdktrace.Sampler {
+	fallback := sdktrace.ParentBased(ratioSampler(ratio))
+	if len(routes) == 0 {
+		return fallback
+	}
+	overrides := make(map[string]sdktrace.Sampler, len(routes))
+	for route, routeRatio := range routes {
+		overrides[route] = ratioSampler(routeRatio)
+	}
+	return routeSampler{fallback: fallback, overrides: overrides}
+}
+
+func (s routeSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	root := !trace.SpanContextFromContext(p.ParentContext).IsValid()
+	for _, attr := range p.Attributes {
+		if attr.Key != httpRouteKey {
+			continue
+		}
+		route := attr.Value.AsString()
+		_, probe := DefaultRouteSampling[route]
+		if sampler, ok := s.overrides[route]; ok && (root || probe) {
+			return sampler.ShouldSample(p)
+		}
+		break
+	}
+	return s.fallback.ShouldSample(p)
+}
+
+func (s routeSampler) Description() string {
+	routes := make([]string, 0, len(s.overrides))
+	for route, sampler := range s.overrides {
+		routes = append(routes, fmt.Sprintf("%s:%s", route, sampler.Description()))
+	}
+	sort.Strings(routes)
+	return fmt.Sprintf("RouteSampler{%s,fallback:%s}", strings.Join(routes, ","), s.fallback.Description())
+}
+
+func ratioSampler(ratio float64) sdktrace.Sampler {
+	switch {
+	case ratio <= 0:
+		return sdktrace.NeverSample()
+	case ratio >= 1:
+		return sdktrace.AlwaysSample()
+	default:
+		return sdktrace.TraceIDRatioBased(ratio)
+	}
+}
@@ -0,0 +1,83 @@
+package observability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSampler_RouteOverridesApplyToRootSpansAndProbes(t *testing.T) {
+	sampler := NewSampler(1, map[string]float64{"/healthz": 0, "/v2/store/inventory": 0})
+
+	parent := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+	result := sampler.ShouldSample(sdktrace.SamplingParameters{
+		ParentContext: parent,
+		TraceID:       trace.TraceID{1},
+		Name:          "/healthz",
+		Attributes:    []attribute.KeyValue{httpRouteKey.String("/healthz")},
+	})
+	require.Equal(t, sdktrace.Drop, result.Decision)
+
+	result = sampler.ShouldSample(sdktrace.SamplingParameters{
+		ParentContext: parent,
+		TraceID:       trace.TraceID{1},
+		Name:          "/v2/pet/:petId",
+		Attributes:    []attribute.KeyValue{httpRouteKey.String("/v2/pet/:petId")},
+	})
+	require.Equal(t, sdktrace.RecordAndSample, result.Decision)
+
+	inventory := []attribute.KeyValue{httpRouteKey.String("/v2/store/inventory")}
+	result = sampler.ShouldSample(sdktrace.SamplingParameters{
+		ParentContext: parent,
+		TraceID:       trace.TraceID{1},
+		Name:          "/v2/store/inventory",
+		Attributes:    inventory,
+	})
+	require.Equal(t, sdktrace.RecordAndSample, result.Decision, "a sampled parent keeps its trace complete")
+
+	result = sampler.ShouldSample(sdktrace.SamplingParameters{
+		ParentContext: t.Context(),
+		TraceID:       trace.TraceID{3},
+		Name:          "/v2/store/inventory",
+		Attributes:    inventory,
+	})
+	require.Equal(t, sdktrace.Drop, result.Decision, "root spans use the route override")
+}
+
+func TestSampler_ChildrenFollowParentDecision(t *testing.T) {
+	sampler := NewSampler(1, nil)
+
+	unsampledParent := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{2},
+		SpanID:  trace.SpanID{2},
+	}))
+	result := sampler.ShouldSample(sdktrace.SamplingParameters{ParentContext: unsampledParent, TraceID: trace.TraceID{2}})
+	require.Equal(t, sdktrace.Drop, result.Decision)
+}
+
+func TestLoadConfig_ParsesRouteOverrides(t *testing.T) {
+	t.Setenv("OTEL_TRACES_SAMPLER_ARG", "0.25")
+	t.Setenv("OTEL_TRACES_ROUTE_SAMPLING", "/v2/store/inventory=0.5, /readyz=1")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "grpc")
+
+	cfg, err := LoadConfig("petstore-test")
+	require.NoError(t, err)
+	require.Equal(t, 0.25, cfg.SampleRatio)
+	require.Equal(t, ProtocolGRPC, cfg.Protocol)
+	require.Equal(t, 0.5, cfg.RouteSampling["/v2/store/inventory"])
+	require.Equal(t, float64(1), cfg.RouteSampling["/readyz"])
+	require.Equal(t, float64(0), cfg.RouteSampling["/healthz"])
+	require.NotEmpty(t, cfg.ServiceVersion)
+
+	t.Setenv("OTEL_TRACES_ROUTE_SAMPLING", "/healthz")
+	_, err = LoadConfig("petstore-test")
+	require.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CacheControl sets the Cache-Control policy configured for the matched operation before the
+// handler runs. Policies are keyed by operationId; unknown ids are rejected so a typo in the
+// configuration fails at startup instead of silently caching nothing.
+func CacheControl(spec *Spec, policies map[string]string) (gin.HandlerFunc, error) {
+	known := map[string]bool{}
+	for _, op := range spec.operations {
+		known[op.ID] = true
+	}
+	var unknown []string
+	for operationID := range policies {
+		if !known[operationID] {
+			unknown = append(unknown, operationID)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("cache policies reference unknown operations %v", unknown)
+	}
+	return func(c *gin.Context) {
+		if op, ok := spec.Operation(c.Request.Method, c.FullPath()); ok {
+			if policy := policies[op.ID]; policy != "" {
+				c.Header("Cache-Control", policy)
+			}
+		}
+		c.Next()
+	}, nil
+}
@@ -0,0 +1,35 @@
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheControl_AppliesPolicyByOperationID(t *testing.T) {
+	spec, err := LoadFile(context.Background(), "../../../"+DefaultSpecPath)
+	require.NoError(t, err)
+
+	_, err = CacheControl(spec, map[string]string{"getPetByID": "no-cache"})
+	require.ErrorContains(t, err, "getPetByID")
+
+	middleware, err := CacheControl(spec, map[string]string{"getPetById": "private, max-age=30"})
+	require.NoError(t, err)
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware)
+	router.GET("/v2/pet/:petId", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.GET("/v2/store/inventory", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/pet/1", nil))
+	require.Equal(t, "private, max-age=30", rec.Header().Get("Cache-Control"))
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/store/inventory", nil))
+	require.Empty(t, rec.Header().Get("Cache-Control"))
+}
@@ -0,0 +1,184 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+// swaggerUI holds the index page and the swagger-ui-dist assets it loads, vendored by
+// `make swagger-ui-vendor` so the docs page works offline and under a strict CSP.
+//
+//go:embed swaggerui
+var swaggerUI embed.FS
+
+var swaggerIndex = template.Must(template.ParseFS(swaggerUI, "swaggerui/index.html"))
+
+// Docs is the published form of the contract: the servers block rewritten for this deployment
+// and routes registered outside the generator added, rendered once as YAML and JSON.
+type Docs struct {
+	yaml  []byte
+	json  []byte
+	index []byte
+	ui    fs.FS
+}
+
+// DocsOption customises the published contract.
+type DocsOption func(*docsConfig)
+
+type docsConfig struct {
+	servers   []string
+	rootPaths map[string]*openapi3.PathItem
+}
+
+// WithServers replaces the spec's servers. URLs without a path get the spec's base path, so
+// "https://petstore.example.com" publishes "https://petstore.example.com/v2".
+func WithServers(urls ...string) DocsOption {
+	return func(cfg *docsConfig) {
+		cfg.servers = append(cfg.servers, urls...)
+	}
+}
+
+// WithRootPath documents a route served from the host root rather than the base path, such as
+// the health probes. The path item gets its own servers so clients resolve it correctly.
+func WithRootPath(path string, item *openapi3.PathItem) DocsOption {
+	return func(cfg *docsConfig) {
+		cfg.rootPaths[path] = item
+	}
+}
+
+// NewDocs parses the contract in data, applies opts, validates the result, and renders it.
+func NewDocs(ctx context.Context, data []byte, opts ...DocsOption) (*Docs, error) {
+	cfg := docsConfig{rootPaths: map[string]*openapi3.PathItem{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	loader := openapi3.NewLoader()
+	loader.Context = ctx
+	doc, err := loader.LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec: %w", err)
+	}
+	basePath, err := serverBasePath(doc)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.servers) > 0 {
+		doc.Servers = nil
+		for _, raw := range cfg.servers {
+			server, err := publishedServer(raw, basePath)
+			if err != nil {
+				return nil, err
+			}
+			doc.AddServer(server)
+		}
+	}
+	rootServers, err := hostServers(doc.Servers)
+	if err != nil {
+		return nil, err
+	}
+	for routePath, item := range cfg.rootPaths {
+		if doc.Paths.Value(routePath) != nil {
+			return nil, fmt.Errorf("openapi path %s is already documented", routePath)
+		}
+		item.Servers = rootServers
+		doc.Paths.Set(routePath, item)
+	}
+	if err := doc.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("validate published openapi spec: %w", err)
+	}
+
+	docs := &Docs{}
+	if docs.json, err = doc.MarshalJSON(); err != nil {
+		return nil, fmt.Errorf("render openapi json: %w", err)
+	}
+	var rendered bytes.Buffer
+	encoder := yaml.NewEncoder(&rendered)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("render openapi yaml: %w", err)
+	}
+	docs.yaml = rendered.Bytes()
+	if docs.ui, err = fs.Sub(swaggerUI, "swaggerui"); err != nil {
+		return nil, err
+	}
+	var index bytes.Buffer
+	if err := swaggerIndex.Execute(&index, map[string]string{"SpecURL": "/openapi.json"}); err != nil {
+		return nil, fmt.Errorf("render swagger ui: %w", err)
+	}
+	docs.index = index.Bytes()
+	return docs, nil
+}
+
+// JSON returns the published contract as JSON.
+func (d *Docs) JSON() []byte {
+	return d.json
+}
+
+// YAML returns the published contract as YAML.
+func (d *Docs) YAML() []byte {
+	return d.yaml
+}
+
+// Register serves /openapi.yaml, /openapi.json, and Swagger UI under /swagger/.
+func (d *Docs) Register(router gin.IRoutes) {
+	router.GET("/openapi.yaml", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/yaml", d.yaml)
+	})
+	router.GET("/openapi.json", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json", d.json)
+	})
+	router.GET("/swagger", func(c *gin.Context) {
+		c.Redirect(http.StatusMovedPermanently, "/swagger/")
+	})
+	router.GET("/swagger/*asset", d.serveSwaggerUI)
+}
+
+func (d *Docs) serveSwaggerUI(c *gin.Context) {
+	asset := strings.TrimPrefix(path.Clean(c.Param("asset")), "/")
+	if asset == "" || asset == "index.html" {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", d.index)
+		return
+	}
+	c.FileFromFS(asset, http.FS(d.ui))
+}
+
+func publishedServer(raw, basePath string) (*openapi3.Server, error) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("openapi server %q must be an absolute URL", raw)
+	}
+	if strings.Trim(parsed.Path, "/") == "" {
+		parsed.Path = basePath
+	}
+	return &openapi3.Server{URL: strings.TrimSuffix(parsed.String(), "/")}, nil
+}
+
+// hostServers strips the base path from servers so root routes resolve against the host.
+func hostServers(servers openapi3.Servers) (openapi3.Servers, error) {
+	root := openapi3.Servers{}
+	for _, server := range servers {
+		parsed, err := url.Parse(server.URL)
+		if err != nil {
+			return nil, fmt.Errorf("parse server url %q: %w", server.URL, err)
+		}
+		parsed.Path = ""
+		rootURL := parsed.String()
+		if rootURL == "" {
+			rootURL = "/"
+		}
+		root = append(root, &openapi3.Server{URL: rootURL, Description: server.Description})
+	}
+	return root, nil
+}
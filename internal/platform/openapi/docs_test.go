@@ -0,0 +1,108 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Apurer/go-gin-api-server/api"
+)
+
+func TestNewDocs_RewritesServersAndAddsRootPaths(t *testing.T) {
+	health := &openapi3.PathItem{Get: &openapi3.Operation{
+		OperationID: "getLiveness",
+		Responses: openapi3.NewResponses(openapi3.WithStatus(http.StatusOK, &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().WithDescription("OK"),
+		})),
+	}}
+	docs, err := NewDocs(context.Background(), api.OpenAPI,
+		WithServers("https://petstore.example.com", "https://staging.example.com/v2/"),
+		WithRootPath("/healthz", health),
+	)
+	require.NoError(t, err)
+
+	var published struct {
+		Servers []struct{ URL string } `json:"servers" yaml:"servers"`
+		Paths   map[string]struct {
+			Servers []struct{ URL string } `json:"servers" yaml:"servers"`
+		} `json:"paths" yaml:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(docs.JSON(), &published))
+	require.Equal(t, []struct{ URL string }{{"https://petstore.example.com/v2"}, {"https://staging.example.com/v2"}}, published.Servers)
+	require.Equal(t, []struct{ URL string }{{"https://petstore.example.com"}, {"https://staging.example.com"}}, published.Paths["/healthz"].Servers)
+	require.Contains(t, published.Paths, "/pet/{petId}")
+
+	var fromYAML map[string]any
+	require.NoError(t, yaml.Unmarshal(docs.YAML(), &fromYAML))
+	_, err = Load(context.Background(), docs.YAML())
+	require.NoError(t, err, "the published YAML is a valid contract")
+}
+
+func TestNewDocs_RejectsInvalidServersAndDuplicatePaths(t *testing.T) {
+	_, err := NewDocs(context.Background(), api.OpenAPI, WithServers("/v2"))
+	require.ErrorContains(t, err, "absolute URL")
+
+	_, err = NewDocs(context.Background(), api.OpenAPI, WithRootPath("/pet", &openapi3.PathItem{}))
+	require.ErrorContains(t, err, "already documented")
+}
+
+func TestDocs_Register(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	docs, err := NewDocs(context.Background(), api.OpenAPI)
+	require.NoError(t, err)
+	router := gin.New()
+	docs.Register(router)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	rec := serve("/openapi.json")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.JSONEq(t, string(docs.JSON()), rec.Body.String())
+
+	rec = serve("/openapi.yaml")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, docs.YAML(), rec.Body.Bytes())
+
+	rec = serve("/swagger")
+	require.Equal(t, http.StatusMovedPermanently, rec.Code)
+	require.Equal(t, "/swagger/", rec.Header().Get("Location"))
+
+	rec = serve("/swagger/")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `data-spec-url="/openapi.json"`)
+	require.Contains(t, rec.Body.String(), `src="/swagger/swagger-ui-bundle.js"`)
+	require.Contains(t, rec.Body.String(), `href="/swagger/swagger-ui.css"`)
+	require.NotContains(t, rec.Body.String(), "https://", "the UI must not load assets from a CDN")
+
+	require.Equal(t, http.StatusNotFound, serve("/swagger/missing.js").Code)
+}
+
+func TestDocs_ServesVendoredSwaggerUIAssets(t *testing.T) {
+	docs, err := NewDocs(context.Background(), api.OpenAPI)
+	require.NoError(t, err)
+	if _, err := fs.Stat(docs.ui, "swagger-ui-bundle.js"); err != nil {
+		t.Skip("swagger-ui-dist is not vendored; run `make swagger-ui-vendor`")
+	}
+	router := gin.New()
+	docs.Register(router)
+
+	for _, asset := range []string{"/swagger/swagger-ui-bundle.js", "/swagger/swagger-ui.css"} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, asset, nil))
+		require.Equal(t, http.StatusOK, rec.Code, asset)
+		require.NotEmpty(t, rec.Body.Bytes(), asset)
+	}
+}
@@ -0,0 +1,153 @@
+// Package openapi loads the API contract and indexes its operations by the gin route that serves
+// them, so HTTP middleware can look up per-operation metadata such as security requirements.
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// DefaultSpecPath is where the live contract lives relative to the repository root.
+const DefaultSpecPath = "api/openapi.yaml"
+
+// Operation is a spec operation together with the route it is served on.
+type Operation struct {
+	// ID is the operationId.
+	ID string
+	// Method is the upper-case HTTP method.
+	Method string
+	// Path is the templated spec path without the server base path, e.g. "/pet/{petId}".
+	Path      string
+	PathItem  *openapi3.PathItem
+	Operation *openapi3.Operation
+}
+
+// Spec is a validated OpenAPI document indexed by gin route.
+type Spec struct {
+	doc        *openapi3.T
+	basePath   string
+	operations map[string]*Operation
+	byID       map[string]*Operation
+}
+
+// LoadFile reads and validates the OpenAPI document at path.
+func LoadFile(ctx context.Context, path string) (*Spec, error) {
+	loader := openapi3.NewLoader()
+	loader.Context = ctx
+	doc, err := loader.LoadFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec %s: %w", path, err)
+	}
+	return newSpec(ctx, doc)
+}
+
+// Load parses and validates an OpenAPI document held in memory.
+func Load(ctx context.Context, data []byte) (*Spec, error) {
+	loader := openapi3.NewLoader()
+	loader.Context = ctx
+	doc, err := loader.LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec: %w", err)
+	}
+	return newSpec(ctx, doc)
+}
+
+func newSpec(ctx context.Context, doc *openapi3.T) (*Spec, error) {
+	if err := doc.Validate(ctx); err != nil {
+		return nil, fmt.Errorf("validate openapi spec: %w", err)
+	}
+	basePath, err := serverBasePath(doc)
+	if err != nil {
+		return nil, err
+	}
+	spec := &Spec{doc: doc, basePath: basePath, operations: map[string]*Operation{}, byID: map[string]*Operation{}}
+	for path, item := range doc.Paths.Map() {
+		for method, op := range item.Operations() {
+			operation := &Operation{
+				ID:        op.OperationID,
+				Method:    method,
+				Path:      path,
+				PathItem:  item,
+				Operation: op,
+			}
+			spec.operations[routeKey(method, basePath+ginPath(path))] = operation
+			if op.OperationID != "" {
+				spec.byID[op.OperationID] = operation
+			}
+		}
+	}
+	return spec, nil
+}
+
+// Document exposes the parsed contract.
+func (s *Spec) Document() *openapi3.T {
+	return s.doc
+}
+
+// BasePath is the path prefix of the first server entry, e.g. "/v2".
+func (s *Spec) BasePath() string {
+	return s.basePath
+}
+
+// Operation returns the operation served by the gin route template (c.FullPath()) and method.
+func (s *Spec) Operation(method, route string) (*Operation, bool) {
+	op, ok := s.operations[routeKey(method, route)]
+	return op, ok
+}
+
+// OperationByID returns the operation with the given operationId.
+func (s *Spec) OperationByID(id string) (*Operation, bool) {
+	op, ok := s.byID[id]
+	return op, ok
+}
+
+// SecurityRequirements returns the requirements that apply to op: its own when declared
+// (an empty list disables security), otherwise the document-wide default.
+func (s *Spec) SecurityRequirements(op *Operation) openapi3.SecurityRequirements {
+	if op.Operation.Security != nil {
+		return *op.Operation.Security
+	}
+	return s.doc.Security
+}
+
+// SecurityScheme resolves a scheme declared under components.securitySchemes.
+func (s *Spec) SecurityScheme(name string) (*openapi3.SecurityScheme, bool) {
+	if s.doc.Components == nil {
+		return nil, false
+	}
+	ref, ok := s.doc.Components.SecuritySchemes[name]
+	if !ok || ref == nil || ref.Value == nil {
+		return nil, false
+	}
+	return ref.Value, true
+}
+
+func serverBasePath(doc *openapi3.T) (string, error) {
+	if len(doc.Servers) == 0 {
+		return "", nil
+	}
+	parsed, err := url.Parse(doc.Servers[0].URL)
+	if err != nil {
+		return "", fmt.Errorf("parse server url %q: %w", doc.Servers[0].URL, err)
+	}
+	return strings.TrimSuffix(parsed.Path, "/"), nil
+}
+
+// ginPath converts "/pet/{petId}" into gin's "/pet/:petId".
+func ginPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			segments[i] = ":" + strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func routeKey(method, route string) string {
+	return strings.ToUpper(method) + " " + route
+}
@@ -0,0 +1,52 @@
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFile_IndexesOperationsByGinRoute(t *testing.T) {
+	spec, err := LoadFile(context.Background(), "../../../"+DefaultSpecPath)
+	require.NoError(t, err)
+	require.Equal(t, "/v2", spec.BasePath())
+
+	op, ok := spec.Operation(http.MethodGet, "/v2/pet/:petId")
+	require.True(t, ok)
+	require.Equal(t, "getPetById", op.ID)
+	require.Equal(t, "/pet/{petId}", op.Path)
+	require.Contains(t, spec.SecurityRequirements(op)[0], "api_key")
+
+	op, ok = spec.Operation(http.MethodPost, "/v2/partner/webhooks/:provider")
+	require.True(t, ok)
+	require.Empty(t, spec.SecurityRequirements(op))
+
+	_, ok = spec.Operation(http.MethodGet, "/healthz")
+	require.False(t, ok)
+}
+
+// publicMutations are the write operations authenticated by something other than the API's own
+// schemes, and so opt out with `security: []`.
+var publicMutations = map[string]bool{
+	"receivePartnerWebhook": true, // HMAC signature over the body
+}
+
+func TestLoadFile_EveryMutatingOperationDeclaresSecurity(t *testing.T) {
+	spec, err := LoadFile(context.Background(), "../../../"+DefaultSpecPath)
+	require.NoError(t, err)
+
+	for _, op := range spec.operations {
+		switch op.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			continue
+		}
+		require.NotNil(t, op.Operation.Security, "%s %s must declare security, or security: [] if it is public", op.Method, op.Path)
+		if publicMutations[op.ID] {
+			require.Empty(t, *op.Operation.Security, "%s is public", op.ID)
+			continue
+		}
+		require.NotEmpty(t, spec.SecurityRequirements(op), "%s %s is writable without credentials", op.Method, op.Path)
+	}
+}
@@ -0,0 +1,326 @@
+package openapi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// ResponseMode selects what happens when a response does not match the contract.
+type ResponseMode string
+
+const (
+	// ResponseOff skips response validation.
+	ResponseOff ResponseMode = "off"
+	// ResponseLog logs contract drift and sends the response unchanged.
+	ResponseLog ResponseMode = "log"
+	// ResponseFail logs contract drift and replaces the response with a 500 problem.
+	ResponseFail ResponseMode = "fail"
+)
+
+// jsonPatchMediaTypes are JSON documents kin-openapi has no decoder for out of the box.
+var jsonPatchMediaTypes = []string{"application/merge-patch+json", "application/json-patch+json"}
+
+func init() {
+	for _, mediaType := range jsonPatchMediaTypes {
+		if openapi3filter.RegisteredBodyDecoder(mediaType) == nil {
+			openapi3filter.RegisterBodyDecoder(mediaType, openapi3filter.JSONBodyDecoder)
+		}
+	}
+}
+
+// ParseResponseMode accepts "off" (the default when empty), "log", or "fail".
+func ParseResponseMode(raw string) (ResponseMode, error) {
+	switch mode := ResponseMode(strings.ToLower(strings.TrimSpace(raw))); mode {
+	case "":
+		return ResponseOff, nil
+	case ResponseOff, ResponseLog, ResponseFail:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown response validation mode %q", raw)
+	}
+}
+
+// Validator checks requests, and optionally responses, against the spec.
+type Validator struct {
+	spec     *Spec
+	requests bool
+	response ResponseMode
+	logger   *slog.Logger
+	routes   map[*Operation]*routers.Route
+}
+
+// ValidatorOption customizes a Validator.
+type ValidatorOption func(*Validator)
+
+// WithRequestValidation toggles request validation (on by default).
+func WithRequestValidation(enabled bool) ValidatorOption {
+	return func(v *Validator) {
+		v.requests = enabled
+	}
+}
+
+// WithResponseValidation enables response validation in the given mode.
+func WithResponseValidation(mode ResponseMode) ValidatorOption {
+	return func(v *Validator) {
+		v.response = mode
+	}
+}
+
+// WithValidationLogger receives contract drift reports.
+func WithValidationLogger(logger *slog.Logger) ValidatorOption {
+	return func(v *Validator) {
+		v.logger = logger
+	}
+}
+
+// NewValidator builds a Validator for spec.
+func NewValidator(spec *Spec, opts ...ValidatorOption) *Validator {
+	v := &Validator{spec: spec, requests: true, response: ResponseOff, routes: map[*Operation]*routers.Route{}}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(v)
+		}
+	}
+	for _, op := range spec.operations {
+		// Security is enforced by its own middleware; clearing it here also stops kin-openapi
+		// from buffering the request body to hand it to an authentication callback.
+		operation := *op.Operation
+		operation.Security = &openapi3.SecurityRequirements{}
+		v.routes[op] = &routers.Route{
+			Spec:      spec.doc,
+			Path:      op.Path,
+			PathItem:  op.PathItem,
+			Method:    op.Method,
+			Operation: &operation,
+		}
+	}
+	return v
+}
+
+// Middleware must be attached before routes are registered. Routes outside the spec pass
+// through. Only JSON request bodies are validated, so streamed NDJSON/CSV and form uploads reach
+// their handlers unbuffered.
+func (v *Validator) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := v.spec.Operation(c.Request.Method, c.FullPath())
+		if !ok {
+			c.Next()
+			return
+		}
+		pathParams := make(map[string]string, len(c.Params))
+		for _, param := range c.Params {
+			pathParams[param.Key] = param.Value
+		}
+		input := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      v.routes[op],
+			Options: &openapi3filter.Options{
+				MultiError:          true,
+				SkipSettingDefaults: true,
+				ExcludeRequestBody:  !isJSONMediaType(c.ContentType()),
+			},
+		}
+		if v.requests {
+			if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					apierrors.Respond(c, apierrors.NewPayloadTooLargeProblem(tooLarge.Limit))
+					c.Abort()
+					return
+				}
+				apierrors.Respond(c, apierrors.NewValidationProblem(fieldErrors(err)).
+					WithDetail("request does not match the API contract"))
+				c.Abort()
+				return
+			}
+		}
+		if v.response == ResponseOff {
+			c.Next()
+			return
+		}
+		v.validateResponse(c, op, input)
+	}
+}
+
+func (v *Validator) validateResponse(c *gin.Context, op *Operation, input *openapi3filter.RequestValidationInput) {
+	original := c.Writer
+	recorder := &responseRecorder{ResponseWriter: original, status: http.StatusOK}
+	c.Writer = recorder
+	c.Next()
+	c.Writer = original
+	if recorder.streaming {
+		return
+	}
+	body := recorder.body.Bytes()
+	err := openapi3filter.ValidateResponse(c.Request.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 recorder.status,
+		Header:                 original.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(body)),
+		Options:                &openapi3filter.Options{MultiError: true},
+	})
+	if err != nil {
+		fields := fieldErrors(err)
+		if v.logger != nil {
+			v.logger.WarnContext(c.Request.Context(), "response does not match the API contract",
+				slog.String("operation", op.ID),
+				slog.Int("status", recorder.status),
+				slog.Any("fields", fields),
+			)
+		}
+		if v.response == ResponseFail {
+			original.Header().Del("Content-Length")
+			apierrors.Respond(c, apierrors.ErrInternal.
+				WithDetail(fmt.Sprintf("response for %s does not match the API contract", op.ID)).
+				WithExtension("fields", fields))
+			return
+		}
+	}
+	original.WriteHeader(recorder.status)
+	if len(body) > 0 {
+		_, _ = original.Write(body)
+	}
+}
+
+// responseRecorder buffers JSON responses for validation. Other media types (NDJSON, CSV) are
+// streamed straight through on the first write and are not validated.
+type responseRecorder struct {
+	gin.ResponseWriter
+	status    int
+	body      bytes.Buffer
+	decided   bool
+	streaming bool
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.streaming {
+		return
+	}
+	r.status = code
+}
+
+func (r *responseRecorder) WriteHeaderNow() {
+	if r.streaming {
+		r.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	if !r.decided {
+		r.decided = true
+		if !isJSONMediaType(r.Header().Get("Content-Type")) {
+			r.streaming = true
+			r.ResponseWriter.WriteHeader(r.status)
+		}
+	}
+	if r.streaming {
+		return r.ResponseWriter.Write(data)
+	}
+	return r.body.Write(data)
+}
+
+func (r *responseRecorder) WriteString(s string) (int, error) {
+	return r.Write([]byte(s))
+}
+
+func (r *responseRecorder) Status() int {
+	return r.status
+}
+
+func (r *responseRecorder) Size() int {
+	if r.streaming {
+		return r.ResponseWriter.Size()
+	}
+	return r.body.Len()
+}
+
+func (r *responseRecorder) Written() bool {
+	return r.decided
+}
+
+func (r *responseRecorder) Flush() {
+	if r.streaming {
+		r.ResponseWriter.Flush()
+	}
+}
+
+func isJSONMediaType(value string) bool {
+	mediaType, _, err := mime.ParseMediaType(value)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// fieldErrors flattens kin-openapi errors into "location.field" -> message pairs, e.g.
+// "query.status", "path.petId", or "body.category.name".
+func fieldErrors(err error) map[string]string {
+	fields := map[string]string{}
+	collectFieldErrors(err, "body", fields)
+	return fields
+}
+
+func collectFieldErrors(err error, location string, fields map[string]string) {
+	// Switch on the concrete type rather than errors.As: request errors wrap multi errors, and
+	// unwrapping past them would lose the parameter location.
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, inner := range e {
+			collectFieldErrors(inner, location, fields)
+		}
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			location = e.Parameter.In + "." + e.Parameter.Name
+		}
+		if e.Err == nil {
+			addFieldError(fields, location, e.Reason)
+			return
+		}
+		collectFieldErrors(e.Err, location, fields)
+	case *openapi3filter.ResponseError:
+		if e.Err == nil {
+			addFieldError(fields, location, e.Reason)
+			return
+		}
+		collectFieldErrors(e.Err, location, fields)
+	case *openapi3filter.ParseError:
+		addFieldError(fields, location, e.Error())
+	case *openapi3.SchemaError:
+		if pointer := e.JSONPointer(); len(pointer) > 0 {
+			location += "." + strings.Join(pointer, ".")
+		}
+		addFieldError(fields, location, e.Reason)
+	default:
+		if inner := errors.Unwrap(err); inner != nil {
+			collectFieldErrors(inner, location, fields)
+			return
+		}
+		addFieldError(fields, location, err.Error())
+	}
+}
+
+// addFieldError keeps every message when several checks fail on the same field.
+func addFieldError(fields map[string]string, key, message string) {
+	if existing, ok := fields[key]; ok && existing != message {
+		messages := append(strings.Split(existing, "; "), message)
+		sort.Strings(messages)
+		fields[key] = strings.Join(messages, "; ")
+		return
+	}
+	fields[key] = message
+}
@@ -0,0 +1,105 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newValidatedRouter(t *testing.T, opts ...ValidatorOption) (*gin.Engine, *string) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	spec, err := LoadFile(context.Background(), "../../../"+DefaultSpecPath)
+	require.NoError(t, err)
+	router := gin.New()
+	router.Use(NewValidator(spec, opts...).Middleware())
+	received := new(string)
+	echo := func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		*received = string(body)
+		c.JSON(http.StatusOK, gin.H{"id": 1, "name": "Rex", "photoUrls": []string{"p"}, "status": c.Query("respond")})
+	}
+	router.POST("/v2/pet", echo)
+	router.GET("/v2/pet/findByStatus", echo)
+	router.GET("/v2/pet/:petId", echo)
+	router.PATCH("/v2/pet/:petId", echo)
+	router.POST("/v2/pet/bulk", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		*received = string(body)
+		c.Header("Content-Type", "application/x-ndjson")
+		c.String(http.StatusOK, "{}\n")
+	})
+	return router, received
+}
+
+func serve(router http.Handler, method, target, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestValidator_RejectsRequestsWithFieldErrors(t *testing.T) {
+	router, received := newValidatedRouter(t)
+
+	rec := serve(router, http.MethodPost, "/v2/pet", "application/json", `{"photoUrls":["p"],"status":"lost"}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	var problem struct {
+		Type       string `json:"type"`
+		Extensions struct {
+			Fields map[string]string `json:"fields"`
+		} `json:"extensions"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	require.Equal(t, "/problems/validation-error", problem.Type)
+	require.Contains(t, problem.Extensions.Fields, "body.name")
+	require.Contains(t, problem.Extensions.Fields, "body.status")
+
+	rec = serve(router, http.MethodGet, "/v2/pet/abc", "", "")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "path.petId")
+
+	rec = serve(router, http.MethodGet, "/v2/pet/findByStatus?status=lost", "", "")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "query.status")
+
+	rec = serve(router, http.MethodPost, "/v2/pet", "application/json", `{"name":"Rex","photoUrls":["p"]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"name":"Rex","photoUrls":["p"]}`, *received, "handlers see the original body")
+
+	rec = serve(router, http.MethodPatch, "/v2/pet/1", "application/merge-patch+json", `{"category":null,"tags":null}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = serve(router, http.MethodPost, "/v2/pet/bulk", "text/csv", "name,photoUrls\nRex,p\n")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "name,photoUrls\nRex,p\n", *received, "streamed bodies are not validated")
+}
+
+func TestValidator_ResponseModes(t *testing.T) {
+	router, _ := newValidatedRouter(t, WithResponseValidation(ResponseFail))
+	rec := serve(router, http.MethodGet, "/v2/pet/1?respond=available", "", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"name":"Rex"`)
+
+	rec = serve(router, http.MethodGet, "/v2/pet/1?respond=lost", "", "")
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	require.Contains(t, rec.Body.String(), "body.status")
+
+	rec = serve(router, http.MethodPost, "/v2/pet/bulk", "application/x-ndjson", "{}\n")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "{}\n", rec.Body.String())
+
+	router, _ = newValidatedRouter(t, WithResponseValidation(ResponseLog))
+	rec = serve(router, http.MethodGet, "/v2/pet/1?respond=lost", "", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,172 @@
+package security
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiKeyPrefix marks keys issued by this service so leaked keys are easy to grep for.
+const apiKeyPrefix = "pk_"
+
+var (
+	// ErrAPIKeyNotFound is returned for unknown, revoked, or expired keys.
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrAPIKeyExists is returned when registering a key whose secret is already stored.
+	ErrAPIKeyExists = errors.New("api key already registered")
+)
+
+// APIKey describes a managed key. The secret itself is never stored, only its SHA-256 hash.
+type APIKey struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+}
+
+// Active reports whether the key may authenticate requests at now.
+func (k APIKey) Active(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+// APIKeyStore manages API keys for the api_key security scheme.
+type APIKeyStore interface {
+	// Lookup returns the active key matching secret, or ErrAPIKeyNotFound.
+	Lookup(ctx context.Context, secret string) (*APIKey, error)
+	// Issue creates a key and returns its secret once; ttl <= 0 means it never expires.
+	Issue(ctx context.Context, name string, ttl time.Duration) (string, *APIKey, error)
+	// Register stores a caller-provided secret, e.g. keys bootstrapped from configuration.
+	Register(ctx context.Context, name, secret string) (*APIKey, error)
+	// Revoke disables a key by id.
+	Revoke(ctx context.Context, id string) error
+	// List returns every key, including revoked ones, oldest first.
+	List(ctx context.Context) ([]APIKey, error)
+}
+
+// HashAPIKey returns the hex SHA-256 digest stored for secret.
+func HashAPIKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateAPIKey returns a new random key secret.
+func GenerateAPIKey() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return apiKeyPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func newAPIKeyID() (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// MemoryAPIKeyStore keeps keys in process memory; used when Postgres is not configured.
+type MemoryAPIKeyStore struct {
+	mu     sync.RWMutex
+	byHash map[string]*APIKey
+	byID   map[string]string
+	now    func() time.Time
+}
+
+// NewMemoryAPIKeyStore returns an empty in-memory store.
+func NewMemoryAPIKeyStore() *MemoryAPIKeyStore {
+	return &MemoryAPIKeyStore{byHash: map[string]*APIKey{}, byID: map[string]string{}, now: time.Now}
+}
+
+// Lookup returns the active key matching secret.
+func (s *MemoryAPIKeyStore) Lookup(_ context.Context, secret string) (*APIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key, ok := s.byHash[HashAPIKey(secret)]
+	if !ok || !key.Active(s.now()) {
+		return nil, ErrAPIKeyNotFound
+	}
+	clone := *key
+	return &clone, nil
+}
+
+// Issue creates a random key.
+func (s *MemoryAPIKeyStore) Issue(ctx context.Context, name string, ttl time.Duration) (string, *APIKey, error) {
+	secret, err := GenerateAPIKey()
+	if err != nil {
+		return "", nil, err
+	}
+	key, err := s.store(name, secret, ttl)
+	if err != nil {
+		return "", nil, err
+	}
+	return secret, key, nil
+}
+
+// Register stores a caller-provided secret.
+func (s *MemoryAPIKeyStore) Register(_ context.Context, name, secret string) (*APIKey, error) {
+	return s.store(name, secret, 0)
+}
+
+func (s *MemoryAPIKeyStore) store(name, secret string, ttl time.Duration) (*APIKey, error) {
+	if strings.TrimSpace(secret) == "" {
+		return nil, errors.New("api key secret is required")
+	}
+	id, err := newAPIKeyID()
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	key := &APIKey{ID: id, Name: name, CreatedAt: now}
+	if ttl > 0 {
+		expires := now.Add(ttl)
+		key.ExpiresAt = &expires
+	}
+	hash := HashAPIKey(secret)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.byHash[hash]; exists {
+		return nil, ErrAPIKeyExists
+	}
+	s.byHash[hash] = key
+	s.byID[id] = hash
+	clone := *key
+	return &clone, nil
+}
+
+// Revoke disables a key by id.
+func (s *MemoryAPIKeyStore) Revoke(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hash, ok := s.byID[id]
+	if !ok {
+		return ErrAPIKeyNotFound
+	}
+	now := s.now()
+	s.byHash[hash].RevokedAt = &now
+	return nil
+}
+
+// List returns every key, oldest first.
+func (s *MemoryAPIKeyStore) List(_ context.Context) ([]APIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]APIKey, 0, len(s.byHash))
+	for _, key := range s.byHash {
+		keys = append(keys, *key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].CreatedAt.Before(keys[j].CreatedAt) })
+	return keys, nil
+}
@@ -0,0 +1,143 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostgresAPIKeyStore persists hashed API keys in the api_keys table.
+type PostgresAPIKeyStore struct {
+	db *gorm.DB
+}
+
+// NewPostgresAPIKeyStore returns a store backed by db; the schema is managed by platform migrations.
+func NewPostgresAPIKeyStore(db *gorm.DB) *PostgresAPIKeyStore {
+	return &PostgresAPIKeyStore{db: db}
+}
+
+type apiKeyRecord struct {
+	ID        string     `gorm:"primaryKey;column:id;size:32"`
+	Name      string     `gorm:"column:name;size:255"`
+	KeyHash   string     `gorm:"column:key_hash;size:64;uniqueIndex"`
+	CreatedAt time.Time  `gorm:"column:created_at"`
+	ExpiresAt *time.Time `gorm:"column:expires_at"`
+	RevokedAt *time.Time `gorm:"column:revoked_at"`
+}
+
+func (apiKeyRecord) TableName() string { return "api_keys" }
+
+func (r apiKeyRecord) toAPIKey() *APIKey {
+	return &APIKey{ID: r.ID, Name: r.Name, CreatedAt: r.CreatedAt, ExpiresAt: r.ExpiresAt, RevokedAt: r.RevokedAt}
+}
+
+// Lookup returns the active key matching secret.
+func (s *PostgresAPIKeyStore) Lookup(ctx context.Context, secret string) (*APIKey, error) {
+	if err := s.ensureDB(); err != nil {
+		return nil, err
+	}
+	var rec apiKeyRecord
+	err := s.db.WithContext(ctx).
+		Where("key_hash = ? AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", HashAPIKey(secret), time.Now()).
+		Take(&rec).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rec.toAPIKey(), nil
+}
+
+// Issue creates a random key.
+func (s *PostgresAPIKeyStore) Issue(ctx context.Context, name string, ttl time.Duration) (string, *APIKey, error) {
+	secret, err := GenerateAPIKey()
+	if err != nil {
+		return "", nil, err
+	}
+	key, err := s.create(ctx, name, secret, ttl)
+	if err != nil {
+		return "", nil, err
+	}
+	return secret, key, nil
+}
+
+// Register stores a caller-provided secret.
+func (s *PostgresAPIKeyStore) Register(ctx context.Context, name, secret string) (*APIKey, error) {
+	return s.create(ctx, name, secret, 0)
+}
+
+func (s *PostgresAPIKeyStore) create(ctx context.Context, name, secret string, ttl time.Duration) (*APIKey, error) {
+	if err := s.ensureDB(); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(secret) == "" {
+		return nil, errors.New("api key secret is required")
+	}
+	id, err := newAPIKeyID()
+	if err != nil {
+		return nil, err
+	}
+	rec := apiKeyRecord{ID: id, Name: name, KeyHash: HashAPIKey(secret), CreatedAt: time.Now().UTC()}
+	if ttl > 0 {
+		expires := rec.CreatedAt.Add(ttl)
+		rec.ExpiresAt = &expires
+	}
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&rec)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrAPIKeyExists
+	}
+	return rec.toAPIKey(), nil
+}
+
+// Revoke disables a key by id.
+func (s *PostgresAPIKeyStore) Revoke(ctx context.Context, id string) error {
+	if err := s.ensureDB(); err != nil {
+		return err
+	}
+	result := s.db.WithContext(ctx).Model(&apiKeyRecord{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now().UTC())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+// List returns every key, oldest first.
+func (s *PostgresAPIKeyStore) List(ctx context.Context) ([]APIKey, error) {
+	if err := s.ensureDB(); err != nil {
+		return nil, err
+	}
+	var records []apiKeyRecord
+	if err := s.db.WithContext(ctx).Order("created_at").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	keys := make([]APIKey, 0, len(records))
+	for _, rec := range records {
+		keys = append(keys, *rec.toAPIKey())
+	}
+	return keys, nil
+}
+
+func (s *PostgresAPIKeyStore) ensureDB() error {
+	if s == nil || s.db == nil {
+		return errors.New("postgres api key store not configured")
+	}
+	return nil
+}
+
+var (
+	_ APIKeyStore = (*PostgresAPIKeyStore)(nil)
+	_ APIKeyStore = (*MemoryAPIKeyStore)(nil)
+)
@@ -0,0 +1,136 @@
+package security
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config carries the settings for the security middleware.
+type Config struct {
+	// Enabled turns enforcement on; when false the spec's security requirements are ignored.
+	Enabled bool
+	// APIKeys are "name=secret" keys registered in the key store at startup.
+	APIKeys map[string]string
+	// JWKSFile and JWKSURL are mutually exclusive sources of bearer token verification keys.
+	JWKSFile    string
+	JWKSURL     string
+	JWKSRefresh time.Duration
+	Issuer      string
+	Audience    string
+	Leeway      time.Duration
+}
+
+// LoadConfig reads the AUTH_* environment variables.
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		Enabled:     isTruthy(os.Getenv("AUTH_ENABLED")),
+		JWKSFile:    strings.TrimSpace(os.Getenv("AUTH_JWKS_FILE")),
+		JWKSURL:     strings.TrimSpace(os.Getenv("AUTH_JWKS_URL")),
+		JWKSRefresh: DefaultJWKSRefreshInterval,
+		Issuer:      strings.TrimSpace(os.Getenv("AUTH_JWT_ISSUER")),
+		Audience:    strings.TrimSpace(os.Getenv("AUTH_JWT_AUDIENCE")),
+		Leeway:      DefaultJWTLeeway,
+	}
+	keys, err := parseAPIKeys(os.Getenv("AUTH_API_KEYS"))
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.APIKeys = keys
+	if raw := strings.TrimSpace(os.Getenv("AUTH_JWKS_REFRESH_SECONDS")); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds <= 0 {
+			return Config{}, fmt.Errorf("AUTH_JWKS_REFRESH_SECONDS must be a positive integer")
+		}
+		cfg.JWKSRefresh = time.Duration(seconds) * time.Second
+	}
+	if raw := strings.TrimSpace(os.Getenv("AUTH_JWT_LEEWAY_SECONDS")); raw != "" {
+		seconds, err := strconv.Atoi(raw)
+		if err != nil || seconds < 0 {
+			return Config{}, fmt.Errorf("AUTH_JWT_LEEWAY_SECONDS must be a non-negative integer")
+		}
+		cfg.Leeway = time.Duration(seconds) * time.Second
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
+
+// Validate rejects conflicting key sources.
+func (c Config) Validate() error {
+	if c.JWKSFile != "" && c.JWKSURL != "" {
+		return errors.New("AUTH_JWKS_FILE and AUTH_JWKS_URL are mutually exclusive")
+	}
+	return nil
+}
+
+// JWTVerifier builds the bearer token verifier, or returns nil when no JWKS is configured.
+func (c Config) JWTVerifier(client *http.Client) (*JWTVerifier, error) {
+	var keys KeySource
+	switch {
+	case c.JWKSFile != "":
+		set, err := LoadJWKSFile(c.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = set
+	case c.JWKSURL != "":
+		keys = NewRemoteJWKS(c.JWKSURL, client, c.JWKSRefresh)
+	default:
+		return nil, nil
+	}
+	return NewJWTVerifier(keys, c.Issuer, c.Audience, c.Leeway), nil
+}
+
+// Summary describes the configuration without secrets.
+func (c Config) Summary() map[string]any {
+	names := make([]string, 0, len(c.APIKeys))
+	for name := range c.APIKeys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	jwks := "none"
+	switch {
+	case c.JWKSFile != "":
+		jwks = "file"
+	case c.JWKSURL != "":
+		jwks = "url"
+	}
+	return map[string]any{
+		"enabled":          c.Enabled,
+		"bootstrap_keys":   names,
+		"jwks":             jwks,
+		"jwt_issuer_set":   c.Issuer != "",
+		"jwt_audience_set": c.Audience != "",
+	}
+}
+
+// parseAPIKeys reads "name=secret" pairs separated by commas.
+func parseAPIKeys(raw string) (map[string]string, error) {
+	keys := map[string]string{}
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, secret, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		secret = strings.TrimSpace(secret)
+		if !ok || name == "" || secret == "" {
+			return nil, fmt.Errorf("AUTH_API_KEYS entries must look like name=secret")
+		}
+		keys[name] = secret
+	}
+	return keys, nil
+}
+
+func isTruthy(value string) bool {
+	value = strings.TrimSpace(strings.ToLower(value))
+	return value == "1" || value == "true" || value == "yes"
+}
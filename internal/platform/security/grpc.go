@@ -0,0 +1,182 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys carrying gRPC credentials, matching the HTTP api_key header and bearer tokens.
+const (
+	APIKeyMetadata        = "api_key"
+	AuthorizationMetadata = "authorization"
+)
+
+// healthMethodPrefix is left open so probes and load balancers need no credentials.
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// WithRPCOperations maps gRPC full method names to the operationId of the spec operation they
+// mirror, so RPCs enforce that operation's security requirements and scopes. Methods missing from
+// the map are denied.
+func WithRPCOperations(operations map[string]string) Option {
+	return func(a *Authenticator) {
+		a.rpcOperations = operations
+	}
+}
+
+// UnaryServerInterceptor authenticates gRPC calls with the API keys and JWTs accepted over HTTP.
+// Every call except health checks needs one valid credential, and the credential must also
+// satisfy the security requirements of the spec operation the RPC mirrors.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+			return handler(ctx, req)
+		}
+		ctx, err := a.authenticateRPC(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := a.authorizeRPC(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+			return handler(srv, ss)
+		}
+		ctx, err := a.authenticateRPC(ss.Context())
+		if err != nil {
+			return err
+		}
+		if err := a.authorizeRPC(ctx, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticateRPC checks the API key when one is sent and the bearer token otherwise.
+func (a *Authenticator) authenticateRPC(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if secret := firstMetadata(md, APIKeyMetadata); secret != "" {
+		if a.apiKeys == nil {
+			return nil, status.Error(codes.Unauthenticated, "API keys are not accepted")
+		}
+		key, err := a.apiKeys.Lookup(ctx, secret)
+		if errors.Is(err, ErrAPIKeyNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "invalid API key")
+		}
+		if err != nil {
+			if a.logger != nil {
+				a.logger.ErrorContext(ctx, "api key lookup failed", slog.String("error", err.Error()))
+			}
+			return nil, status.Error(codes.Internal, "API key lookup failed")
+		}
+		return WithPrincipal(ctx, &Principal{Subject: key.Name, Scheme: "api_key", KeyID: key.ID}), nil
+	}
+	scheme, token, _ := strings.Cut(firstMetadata(md, AuthorizationMetadata), " ")
+	token = strings.TrimSpace(token)
+	if !strings.EqualFold(scheme, "bearer") || token == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "missing %q or bearer %q metadata", APIKeyMetadata, AuthorizationMetadata)
+	}
+	if a.tokens == nil {
+		return nil, status.Error(codes.Unauthenticated, "bearer tokens are not accepted")
+	}
+	principal, err := a.tokens.Verify(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid bearer token: "+err.Error())
+	}
+	return WithPrincipal(ctx, principal), nil
+}
+
+// authorizeRPC checks the authenticated principal against the requirements of the spec operation
+// mirrored by method. As over HTTP, one of the requirements must be met in full.
+func (a *Authenticator) authorizeRPC(ctx context.Context, method string) error {
+	operationID, ok := a.rpcOperations[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "no access policy for %s", method)
+	}
+	if a.spec == nil {
+		return status.Error(codes.Internal, "security requirements are not configured")
+	}
+	op, ok := a.spec.OperationByID(operationID)
+	if !ok {
+		return status.Errorf(codes.Internal, "operation %q is not declared", operationID)
+	}
+	requirements := a.spec.SecurityRequirements(op)
+	if len(requirements) == 0 {
+		return nil
+	}
+	principal, _ := PrincipalFromContext(ctx)
+	var missing []string
+	for _, requirement := range requirements {
+		scopes, ok := a.grants(principal, requirement)
+		if ok {
+			return nil
+		}
+		missing = append(missing, scopes...)
+	}
+	if len(missing) > 0 {
+		return status.Errorf(codes.PermissionDenied, "credential lacks required scopes %s", strings.Join(missing, " "))
+	}
+	return status.Errorf(codes.PermissionDenied, "credential is not accepted for %s", method)
+}
+
+// grants reports whether principal meets every scheme of requirement, returning the scopes a
+// bearer requirement asked for when it does not.
+func (a *Authenticator) grants(principal *Principal, requirement map[string][]string) ([]string, bool) {
+	if principal == nil {
+		return nil, false
+	}
+	for name, scopes := range requirement {
+		scheme, ok := a.spec.SecurityScheme(name)
+		if !ok {
+			return nil, false
+		}
+		switch {
+		case scheme.Type == "apiKey":
+			if principal.Scheme != "api_key" {
+				return nil, false
+			}
+		case scheme.Type == "oauth2", scheme.Type == "openIdConnect",
+			scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "bearer"):
+			if principal.Scheme != "bearer" {
+				return nil, false
+			}
+			if !principal.HasScopes(scopes) {
+				return scopes, false
+			}
+		default:
+			return nil, false
+		}
+	}
+	return nil, true
+}
+
+func firstMetadata(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// authenticatedStream exposes the context carrying the principal to stream handlers.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,104 @@
+package security
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Apurer/go-gin-api-server/internal/platform/openapi"
+)
+
+func TestUnaryServerInterceptor_RequiresCredentials(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryAPIKeyStore()
+	_, err := store.Register(ctx, "billing", "secret-key")
+	require.NoError(t, err)
+	spec, err := openapi.LoadFile(ctx, "../../../"+openapi.DefaultSpecPath)
+	require.NoError(t, err)
+	interceptor := NewAuthenticator(spec,
+		WithAPIKeyStore(store),
+		WithRPCOperations(map[string]string{"/petstore.pets.v1.PetService/GetPet": "getPetById"}),
+	).UnaryServerInterceptor()
+	call := func(ctx context.Context, method string) (*Principal, error) {
+		var principal *Principal
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ any) (any, error) {
+			principal, _ = PrincipalFromContext(ctx)
+			return nil, nil
+		})
+		return principal, err
+	}
+	const method = "/petstore.pets.v1.PetService/GetPet"
+
+	_, err = call(ctx, method)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call(metadata.NewIncomingContext(ctx, metadata.Pairs(APIKeyMetadata, "wrong")), method)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = call(metadata.NewIncomingContext(ctx, metadata.Pairs(AuthorizationMetadata, "Bearer token")), method)
+	require.Equal(t, codes.Unauthenticated, status.Code(err), "bearer tokens need a JWT verifier")
+
+	principal, err := call(metadata.NewIncomingContext(ctx, metadata.Pairs(APIKeyMetadata, "secret-key")), method)
+	require.NoError(t, err)
+	require.Equal(t, "billing", principal.Subject)
+
+	_, err = call(ctx, "/grpc.health.v1.Health/Check")
+	require.NoError(t, err, "health checks need no credentials")
+
+	_, err = call(metadata.NewIncomingContext(ctx, metadata.Pairs(APIKeyMetadata, "secret-key")), "/petstore.pets.v1.PetService/Unmapped")
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "methods without an access policy are denied")
+}
+
+func TestUnaryServerInterceptor_EnforcesOperationScopes(t *testing.T) {
+	ctx := context.Background()
+	spec, err := openapi.LoadFile(ctx, "../../../"+openapi.DefaultSpecPath)
+	require.NoError(t, err)
+	signer, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keys, err := LoadJWKSFile(writeJWKS(t, "k1", &signer.PublicKey))
+	require.NoError(t, err)
+	store := NewMemoryAPIKeyStore()
+	_, err = store.Register(ctx, "ops", "special-key")
+	require.NoError(t, err)
+
+	const (
+		deletePet = "/petstore.pets.v1.PetService/DeletePet"
+		findPets  = "/petstore.pets.v1.PetService/FindPetsByStatus"
+	)
+	interceptor := NewAuthenticator(spec,
+		WithAPIKeyStore(store),
+		WithJWTVerifier(NewJWTVerifier(keys, "https://issuer.test", "petstore", 0)),
+		WithRPCOperations(map[string]string{deletePet: "deletePet", findPets: "findPetsByStatus"}),
+	).UnaryServerInterceptor()
+	call := func(method string, md metadata.MD) error {
+		_, err := interceptor(metadata.NewIncomingContext(ctx, md), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(context.Context, any) (any, error) { return nil, nil })
+		return err
+	}
+	bearer := func(scope string) metadata.MD {
+		tok := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"sub": "alice", "iss": "https://issuer.test", "aud": "petstore", "exp": time.Now().Add(time.Hour).Unix(), "scope": scope,
+		})
+		tok.Header["kid"] = "k1"
+		signed, err := tok.SignedString(signer)
+		require.NoError(t, err)
+		return metadata.Pairs(AuthorizationMetadata, "Bearer "+signed)
+	}
+
+	require.NoError(t, call(findPets, bearer("read:pets")))
+	err = call(deletePet, bearer("read:pets"))
+	require.Equal(t, codes.PermissionDenied, status.Code(err), "a read-only token cannot delete pets")
+	require.Contains(t, status.Convert(err).Message(), "write:pets")
+	require.NoError(t, call(deletePet, bearer("read:pets write:pets")))
+	require.Equal(t, codes.PermissionDenied, status.Code(call(deletePet, metadata.Pairs(APIKeyMetadata, "special-key"))),
+		"the spec accepts only OAuth2 tokens for deletePet")
+}
@@ -0,0 +1,268 @@
+package security
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultJWKSRefreshInterval is how long a fetched remote key set is trusted.
+	DefaultJWKSRefreshInterval = 15 * time.Minute
+	// DefaultJWKSFetchTimeout bounds a single fetch of a remote key set.
+	DefaultJWKSFetchTimeout = 10 * time.Second
+	// jwksMinRefetch bounds refetches triggered by unknown key ids and retries after a failed fetch.
+	jwksMinRefetch = 30 * time.Second
+	maxJWKSBytes   = 1 << 20
+)
+
+// ErrUnknownKey is returned when no verification key matches a token's kid.
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// KeySource resolves the public key that verifies a token signed with kid.
+type KeySource interface {
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// KeySet is a parsed JSON Web Key Set holding signature verification keys.
+type KeySet struct {
+	keys map[string]crypto.PublicKey
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// ParseJWKS decodes RSA, EC, and Ed25519 signing keys from a JWKS document. Encryption keys are
+// skipped; a key without kid only matches tokens without kid.
+func ParseJWKS(data []byte) (*KeySet, error) {
+	var doc struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode jwks: %w", err)
+	}
+	set := &KeySet{keys: map[string]crypto.PublicKey{}}
+	for i, jwk := range doc.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("jwks key %d (%q): %w", i, jwk.Kid, err)
+		}
+		set.keys[jwk.Kid] = key
+	}
+	if len(set.keys) == 0 {
+		return nil, errors.New("jwks contains no signing keys")
+	}
+	return set, nil
+}
+
+// LoadJWKSFile reads a key set from a local file.
+func LoadJWKSFile(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read jwks file: %w", err)
+	}
+	return ParseJWKS(data)
+}
+
+// Key returns the key registered under kid.
+func (s *KeySet) Key(_ context.Context, kid string) (crypto.PublicKey, error) {
+	if key, ok := s.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownKey, kid)
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("n: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("e: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() < 3 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("x: %w", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("y: %w", err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 public key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(raw), nil
+}
+
+// RemoteJWKS fetches a key set over HTTP and caches it. Unknown key ids trigger a refetch so
+// rotated keys are picked up without waiting for the refresh interval. Concurrent requests share
+// one fetch, which runs outside the cache lock, and a failed fetch is not retried for
+// jwksMinRefetch so an unavailable endpoint is not hit on every request.
+type RemoteJWKS struct {
+	url      string
+	client   *http.Client
+	interval time.Duration
+	now      func() time.Time
+	fetches  singleflight.Group
+
+	mu        sync.Mutex
+	keys      *KeySet
+	fetchedAt time.Time
+	failedAt  time.Time
+	failure   error
+}
+
+// NewRemoteJWKS returns a source for url; a nil client uses one that gives up after
+// DefaultJWKSFetchTimeout.
+func NewRemoteJWKS(url string, client *http.Client, interval time.Duration) *RemoteJWKS {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultJWKSFetchTimeout}
+	}
+	if interval <= 0 {
+		interval = DefaultJWKSRefreshInterval
+	}
+	return &RemoteJWKS{url: url, client: client, interval: interval, now: time.Now}
+}
+
+// Key returns the key for kid, fetching the set when it is stale or does not know kid.
+func (r *RemoteJWKS) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	r.mu.Lock()
+	keys, fetchedAt, failedAt, failure := r.keys, r.fetchedAt, r.failedAt, r.failure
+	r.mu.Unlock()
+	now := r.now()
+	if age := now.Sub(fetchedAt); keys != nil && age < r.interval {
+		key, err := keys.Key(ctx, kid)
+		if err == nil || age < jwksMinRefetch {
+			return key, err
+		}
+	}
+	if failure != nil && now.Sub(failedAt) < jwksMinRefetch {
+		return r.fallback(ctx, keys, kid, failure)
+	}
+	// The fetch outlives a caller that gives up, so the other waiters still get its result.
+	fetched := r.fetches.DoChan("jwks", func() (any, error) {
+		return r.refresh(context.WithoutCancel(ctx))
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-fetched:
+		if result.Err != nil {
+			return r.fallback(ctx, keys, kid, result.Err)
+		}
+		return result.Val.(*KeySet).Key(ctx, kid)
+	}
+}
+
+// fallback keeps serving the last good set while the endpoint is unavailable.
+func (r *RemoteJWKS) fallback(ctx context.Context, keys *KeySet, kid string, err error) (crypto.PublicKey, error) {
+	if keys != nil {
+		return keys.Key(ctx, kid)
+	}
+	return nil, err
+}
+
+// refresh fetches the set and records the outcome.
+func (r *RemoteJWKS) refresh(ctx context.Context) (*KeySet, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultJWKSFetchTimeout)
+	defer cancel()
+	keys, err := r.fetch(ctx)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.failedAt, r.failure = r.now(), err
+		return nil, err
+	}
+	r.keys, r.fetchedAt = keys, r.now()
+	r.failedAt, r.failure = time.Time{}, nil
+	return keys, nil
+}
+
+func (r *RemoteJWKS) fetch(ctx context.Context) (*KeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSBytes))
+	if err != nil {
+		return nil, fmt.Errorf("fetch jwks: %w", err)
+	}
+	return ParseJWKS(data)
+}
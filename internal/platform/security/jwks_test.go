@@ -0,0 +1,75 @@
+package security
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteJWKS_SharesFetchesAndBacksOffAfterFailures(t *testing.T) {
+	signer, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	doc, err := os.ReadFile(writeJWKS(t, "k1", &signer.PublicKey))
+	require.NoError(t, err)
+
+	var hits atomic.Int32
+	var healthy atomic.Bool
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		<-release
+		if !healthy.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write(doc)
+	}))
+	defer server.Close()
+
+	now := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	source := NewRemoteJWKS(server.URL, nil, time.Hour)
+	source.now = func() time.Time { return now }
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := source.Key(context.Background(), "k1")
+			errs <- err
+		}()
+	}
+	require.Eventually(t, func() bool { return hits.Load() == 1 }, time.Second, 5*time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = source.Key(ctx, "k1")
+	require.ErrorIs(t, err, context.Canceled, "a caller waiting on a slow fetch can give up")
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.ErrorContains(t, err, "unexpected status 503")
+	}
+	require.EqualValues(t, 1, hits.Load(), "concurrent requests share one fetch")
+
+	_, err = source.Key(context.Background(), "k1")
+	require.ErrorContains(t, err, "unexpected status 503")
+	require.EqualValues(t, 1, hits.Load(), "a failed fetch is not retried on every request")
+
+	healthy.Store(true)
+	now = now.Add(jwksMinRefetch)
+	key, err := source.Key(context.Background(), "k1")
+	require.NoError(t, err)
+	require.Equal(t, &signer.PublicKey, key)
+	require.EqualValues(t, 2, hits.Load())
+}
@@ -0,0 +1,118 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// DefaultJWTLeeway tolerates clock skew between the issuer and this service.
+const DefaultJWTLeeway = 30 * time.Second
+
+// signingMethods lists the accepted algorithms. Symmetric algorithms are excluded so a public
+// JWKS key can never be used as an HMAC secret.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// JWTVerifier validates bearer tokens against a key source and optional issuer/audience.
+type JWTVerifier struct {
+	keys   KeySource
+	parser *jwt.Parser
+}
+
+// NewJWTVerifier builds a verifier; empty issuer or audience skips that check.
+func NewJWTVerifier(keys KeySource, issuer, audience string, leeway time.Duration) *JWTVerifier {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+	return &JWTVerifier{keys: keys, parser: jwt.NewParser(opts...)}
+}
+
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	// Scope is the space-delimited OAuth2 form; Scp is the array form some issuers use instead.
+	Scope string          `json:"scope,omitempty"`
+	Scp   json.RawMessage `json:"scp,omitempty"`
+}
+
+// Verify checks the token signature and claims and returns the authenticated principal.
+func (v *JWTVerifier) Verify(ctx context.Context, raw string) (*Principal, error) {
+	var claims tokenClaims
+	_, err := v.parser.ParseWithClaims(raw, &claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.keys.Key(ctx, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := claims.scopes()
+	if err != nil {
+		return nil, err
+	}
+	return &Principal{Subject: claims.Subject, Scheme: "bearer", Scopes: scopes}, nil
+}
+
+func (c tokenClaims) scopes() ([]string, error) {
+	scopes := strings.Fields(c.Scope)
+	if len(c.Scp) == 0 {
+		return scopes, nil
+	}
+	var list []string
+	if err := json.Unmarshal(c.Scp, &list); err == nil {
+		return append(scopes, list...), nil
+	}
+	var single string
+	if err := json.Unmarshal(c.Scp, &single); err != nil {
+		return nil, errors.New("scp claim must be a string or an array of strings")
+	}
+	return append(scopes, strings.Fields(single)...), nil
+}
+
+// Principal identifies the caller authenticated by the security middleware.
+type Principal struct {
+	// Subject is the token subject or the API key name.
+	Subject string
+	// Scheme is "bearer" or "api_key".
+	Scheme string
+	// KeyID is set for API key callers.
+	KeyID  string
+	Scopes []string
+}
+
+// HasScopes reports whether every required scope was granted.
+func (p *Principal) HasScopes(required []string) bool {
+	granted := make(map[string]bool, len(p.Scopes))
+	for _, scope := range p.Scopes {
+		granted[scope] = true
+	}
+	for _, scope := range required {
+		if !granted[scope] {
+			return false
+		}
+	}
+	return true
+}
+
+type principalKey struct{}
+
+// WithPrincipal stores p in ctx.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the caller authenticated for the request, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,233 @@
+package security
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Apurer/go-gin-api-server/internal/platform/openapi"
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// bearerRealm is advertised in WWW-Authenticate challenges.
+const bearerRealm = "petstore"
+
+// Authenticator enforces the security requirements the OpenAPI spec declares per operation.
+type Authenticator struct {
+	spec    *openapi.Spec
+	apiKeys APIKeyStore
+	tokens  *JWTVerifier
+	logger  *slog.Logger
+	// rpcOperations maps gRPC full method names to spec operationIds.
+	rpcOperations map[string]string
+}
+
+// Option customizes an Authenticator.
+type Option func(*Authenticator)
+
+// WithAPIKeyStore validates apiKey schemes against store.
+func WithAPIKeyStore(store APIKeyStore) Option {
+	return func(a *Authenticator) {
+		a.apiKeys = store
+	}
+}
+
+// WithJWTVerifier validates oauth2, openIdConnect, and http bearer schemes with verifier.
+func WithJWTVerifier(verifier *JWTVerifier) Option {
+	return func(a *Authenticator) {
+		a.tokens = verifier
+	}
+}
+
+// WithLogger logs credential store failures.
+func WithLogger(logger *slog.Logger) Option {
+	return func(a *Authenticator) {
+		a.logger = logger
+	}
+}
+
+// NewAuthenticator builds an Authenticator for spec. Schemes without a configured validator
+// reject every credential, so an operation is never silently left open.
+func NewAuthenticator(spec *openapi.Spec, opts ...Option) *Authenticator {
+	a := &Authenticator{spec: spec}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(a)
+		}
+	}
+	return a
+}
+
+// Middleware must be attached before routes are registered. Routes that are not in the spec, and
+// operations without security requirements, pass through untouched.
+func (a *Authenticator) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := a.spec.Operation(c.Request.Method, c.FullPath())
+		if !ok {
+			c.Next()
+			return
+		}
+		requirements := a.spec.SecurityRequirements(op)
+		if len(requirements) == 0 {
+			c.Next()
+			return
+		}
+		var worst *authFailure
+		for _, requirement := range requirements {
+			principal, failure := a.satisfy(c.Request, requirement)
+			if failure == nil {
+				c.Request = c.Request.WithContext(WithPrincipal(c.Request.Context(), principal))
+				c.Next()
+				return
+			}
+			if failure.status == http.StatusInternalServerError {
+				a.reject(c, failure)
+				return
+			}
+			if worst == nil || failure.kind > worst.kind {
+				worst = failure
+			}
+		}
+		a.reject(c, worst)
+	}
+}
+
+// failure kinds ordered by how much they tell the caller.
+const (
+	failureMissing = iota
+	failureInvalid
+	failureScope
+)
+
+type authFailure struct {
+	status int
+	kind   int
+	bearer bool
+	detail string
+	scopes []string
+}
+
+// satisfy checks every scheme of one requirement; all of them must pass.
+func (a *Authenticator) satisfy(r *http.Request, requirement map[string][]string) (*Principal, *authFailure) {
+	if len(requirement) == 0 {
+		// An empty requirement object allows anonymous access.
+		return &Principal{Scheme: "anonymous"}, nil
+	}
+	names := make([]string, 0, len(requirement))
+	for name := range requirement {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var principal *Principal
+	for _, name := range names {
+		scheme, ok := a.spec.SecurityScheme(name)
+		if !ok {
+			return nil, &authFailure{status: http.StatusInternalServerError, detail: fmt.Sprintf("security scheme %q is not declared", name)}
+		}
+		var (
+			p       *Principal
+			failure *authFailure
+		)
+		switch {
+		case scheme.Type == "apiKey":
+			p, failure = a.checkAPIKey(r, scheme.In, scheme.Name)
+		case scheme.Type == "oauth2", scheme.Type == "openIdConnect",
+			scheme.Type == "http" && strings.EqualFold(scheme.Scheme, "bearer"):
+			p, failure = a.checkBearer(r, requirement[name])
+		default:
+			failure = &authFailure{status: http.StatusUnauthorized, kind: failureInvalid, detail: fmt.Sprintf("security scheme %q is not supported", name)}
+		}
+		if failure != nil {
+			return nil, failure
+		}
+		if principal == nil {
+			principal = p
+		}
+	}
+	return principal, nil
+}
+
+func (a *Authenticator) checkAPIKey(r *http.Request, in, name string) (*Principal, *authFailure) {
+	var secret string
+	switch in {
+	case "header":
+		secret = r.Header.Get(name)
+	case "query":
+		secret = r.URL.Query().Get(name)
+	case "cookie":
+		if cookie, err := r.Cookie(name); err == nil {
+			secret = cookie.Value
+		}
+	}
+	if secret == "" {
+		return nil, &authFailure{status: http.StatusUnauthorized, kind: failureMissing, detail: fmt.Sprintf("missing API key in %s %q", in, name)}
+	}
+	if a.apiKeys == nil {
+		return nil, &authFailure{status: http.StatusUnauthorized, kind: failureInvalid, detail: "API keys are not accepted"}
+	}
+	key, err := a.apiKeys.Lookup(r.Context(), secret)
+	if errors.Is(err, ErrAPIKeyNotFound) {
+		return nil, &authFailure{status: http.StatusUnauthorized, kind: failureInvalid, detail: "invalid API key"}
+	}
+	if err != nil {
+		if a.logger != nil {
+			a.logger.ErrorContext(r.Context(), "api key lookup failed", slog.String("error", err.Error()))
+		}
+		return nil, &authFailure{status: http.StatusInternalServerError, detail: "API key lookup failed"}
+	}
+	return &Principal{Subject: key.Name, Scheme: "api_key", KeyID: key.ID}, nil
+}
+
+func (a *Authenticator) checkBearer(r *http.Request, scopes []string) (*Principal, *authFailure) {
+	scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+	token = strings.TrimSpace(token)
+	if !strings.EqualFold(scheme, "bearer") || token == "" {
+		return nil, &authFailure{status: http.StatusUnauthorized, kind: failureMissing, bearer: true, detail: "missing bearer token"}
+	}
+	if a.tokens == nil {
+		return nil, &authFailure{status: http.StatusUnauthorized, kind: failureInvalid, bearer: true, detail: "bearer tokens are not accepted"}
+	}
+	principal, err := a.tokens.Verify(r.Context(), token)
+	if err != nil {
+		return nil, &authFailure{status: http.StatusUnauthorized, kind: failureInvalid, bearer: true, detail: "invalid bearer token: " + err.Error()}
+	}
+	if !principal.HasScopes(scopes) {
+		return nil, &authFailure{status: http.StatusForbidden, kind: failureScope, bearer: true, scopes: scopes,
+			detail: fmt.Sprintf("token lacks required scopes %s", strings.Join(scopes, " "))}
+	}
+	return principal, nil
+}
+
+func (a *Authenticator) reject(c *gin.Context, failure *authFailure) {
+	var problem apierrors.ProblemDetail
+	switch failure.status {
+	case http.StatusForbidden:
+		problem = apierrors.ErrForbidden.WithExtension("requiredScopes", failure.scopes)
+	case http.StatusUnauthorized:
+		problem = apierrors.ErrUnauthorized
+	default:
+		problem = apierrors.ErrInternal
+	}
+	if failure.bearer {
+		c.Header("WWW-Authenticate", bearerChallenge(failure))
+	}
+	apierrors.Respond(c, problem.WithDetail(failure.detail))
+	c.Abort()
+}
+
+// bearerChallenge follows RFC 6750 section 3.
+func bearerChallenge(failure *authFailure) string {
+	challenge := fmt.Sprintf("Bearer realm=%q", bearerRealm)
+	switch failure.kind {
+	case failureInvalid:
+		challenge += `, error="invalid_token"`
+	case failureScope:
+		challenge += fmt.Sprintf(`, error="insufficient_scope", scope=%q`, strings.Join(failure.scopes, " "))
+	}
+	return challenge
+}
@@ -0,0 +1,116 @@
+package security
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Apurer/go-gin-api-server/internal/platform/openapi"
+)
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+	doc := map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestMiddleware_EnforcesSpecSecurity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	spec, err := openapi.LoadFile(ctx, "../../../"+openapi.DefaultSpecPath)
+	require.NoError(t, err)
+
+	signer, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keys, err := LoadJWKSFile(writeJWKS(t, "k1", &signer.PublicKey))
+	require.NoError(t, err)
+	store := NewMemoryAPIKeyStore()
+	_, err = store.Register(ctx, "ops", "special-key")
+	require.NoError(t, err)
+	revokedSecret, revoked, err := store.Issue(ctx, "old", 0)
+	require.NoError(t, err)
+	require.NoError(t, store.Revoke(ctx, revoked.ID))
+
+	auth := NewAuthenticator(spec,
+		WithAPIKeyStore(store),
+		WithJWTVerifier(NewJWTVerifier(keys, "https://issuer.test", "petstore", 0)),
+	)
+	router := gin.New()
+	router.Use(auth.Middleware())
+	ok := func(c *gin.Context) {
+		principal, _ := PrincipalFromContext(c.Request.Context())
+		c.String(http.StatusOK, principal.Subject)
+	}
+	router.GET("/v2/pet/:petId", ok)
+	router.GET("/v2/pet/findByStatus", ok)
+	router.POST("/v2/pet/bulk", ok)
+	router.POST("/v2/store/order", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/v2/partner/webhooks/:provider", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	token := func(kid, scope string, expires time.Time) string {
+		tok := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"sub": "alice", "iss": "https://issuer.test", "aud": "petstore", "exp": expires.Unix(), "scope": scope,
+		})
+		tok.Header["kid"] = kid
+		signed, err := tok.SignedString(signer)
+		require.NoError(t, err)
+		return signed
+	}
+	hour := time.Now().Add(time.Hour)
+	cases := []struct {
+		name, method, path string
+		headers            map[string]string
+		status             int
+		challenge          string
+	}{
+		{"open operation", http.MethodPost, "/v2/partner/webhooks/acme", nil, http.StatusOK, ""},
+		{"order without api key", http.MethodPost, "/v2/store/order", nil, http.StatusUnauthorized, ""},
+		{"order with api key", http.MethodPost, "/v2/store/order", map[string]string{"api_key": "special-key"}, http.StatusOK, ""},
+		{"api key", http.MethodGet, "/v2/pet/7", map[string]string{"api_key": "special-key"}, http.StatusOK, ""},
+		{"missing api key", http.MethodGet, "/v2/pet/7", nil, http.StatusUnauthorized, ""},
+		{"revoked api key", http.MethodGet, "/v2/pet/7", map[string]string{"api_key": revokedSecret}, http.StatusUnauthorized, ""},
+		{"scoped token", http.MethodGet, "/v2/pet/findByStatus", map[string]string{"Authorization": "Bearer " + token("k1", "read:pets", hour)}, http.StatusOK, ""},
+		{"missing scope", http.MethodPost, "/v2/pet/bulk", map[string]string{"Authorization": "Bearer " + token("k1", "read:pets", hour)}, http.StatusForbidden, `error="insufficient_scope"`},
+		{"expired token", http.MethodGet, "/v2/pet/findByStatus", map[string]string{"Authorization": "Bearer " + token("k1", "read:pets", time.Now().Add(-time.Hour))}, http.StatusUnauthorized, `error="invalid_token"`},
+		{"unknown key", http.MethodGet, "/v2/pet/findByStatus", map[string]string{"Authorization": "Bearer " + token("k2", "read:pets", hour)}, http.StatusUnauthorized, `error="invalid_token"`},
+		{"no token", http.MethodGet, "/v2/pet/findByStatus", nil, http.StatusUnauthorized, `Bearer realm="petstore"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			require.Equal(t, tc.status, rec.Code, rec.Body.String())
+			require.Contains(t, rec.Header().Get("WWW-Authenticate"), tc.challenge)
+			if tc.status >= http.StatusBadRequest {
+				require.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package pets
+
+import (
+	"context"
+	"errors"
+
+	"go.temporal.io/sdk/activity"
+
+	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/correlation"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/failures"
+)
+
+// SendGroomingReminderActivityName sends the reminder of a grooming appointment.
+const SendGroomingReminderActivityName = "pets.activities.SendGroomingReminder"
+
+// WithGroomingReminders enables SendGroomingReminder.
+func WithGroomingReminders(sender petsports.GroomingReminderSender) Option {
+	return func(a *Activities) {
+		a.reminders = sender
+	}
+}
+
+// SendGroomingReminder delivers a due appointment reminder. The sender records the delivery, so
+// a retried attempt does not notify twice.
+func (a *Activities) SendGroomingReminder(ctx context.Context, appointmentID int64) error {
+	logger := correlation.Logger(ctx, activity.GetLogger(ctx))
+	if a == nil || a.reminders == nil {
+		logger.Error("grooming reminders not configured", "appointmentId", appointmentID)
+		return errors.New("grooming reminders not configured")
+	}
+	logger.Info("SendGroomingReminder activity started", "appointmentId", appointmentID)
+	if err := a.reminders.SendGroomingReminder(ctx, appointmentID); err != nil {
+		logger.Error("SendGroomingReminder activity failed", "appointmentId", appointmentID, "error", err)
+		return failures.FromError(err)
+	}
+	logger.Info("SendGroomingReminder activity completed", "appointmentId", appointmentID)
+	return nil
+}
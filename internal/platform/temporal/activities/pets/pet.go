@@ -2,18 +2,19 @@ package pets
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/json"
 	"errors"
-	"sort"
+	"fmt"
+	"slices"
 	"strconv"
 
 	"go.temporal.io/sdk/activity"
 
+	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
 	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
 	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
 	petsports "github.com/Apurer/go-gin-api-server/internal/domains/pets/ports"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/correlation"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/failures"
 )
 
 const (
@@ -21,8 +22,12 @@ const (
 	PersistPetActivityName = "pets.activities.PersistPet"
 	// SyncPetWithPartnerActivityName triggers partner sync for an existing pet.
 	SyncPetWithPartnerActivityName = "pets.activities.SyncPetWithPartner"
+	// ReconcileLocalPageActivityName compares one page of local pets with every partner's copy.
+	ReconcileLocalPageActivityName = "pets.activities.ReconcileLocalPage"
+	// ReconcileRemotePageActivityName looks for orphaned records on one page of a partner's pets.
+	ReconcileRemotePageActivityName = "pets.activities.ReconcileRemotePage"
 
-	partnerSyncHashKey = "partner_sync_hash"
+	partnerSyncHashKey = petspartner.SyncHashAttribute
 )
 
 // Activities groups activities that operate on the pets bounded context.
@@ -30,21 +35,61 @@ type Activities struct {
 	persistService petsports.Service
 	repo           petsports.Repository
 	partnerSync    petsports.PartnerSync
+	metrics        petsports.BusinessMetrics
+	reconciler     *petspartner.Reconciler
+	reminders      petsports.GroomingReminderSender
+}
+
+// SyncPetInput selects the pet to push. Providers narrows the fan-out (all targets when empty) and
+// Force ignores the stored sync hash so drift introduced on the partner side is overwritten.
+type SyncPetInput struct {
+	ID        int64
+	Providers []string
+	Force     bool
+}
+
+// Option customizes the activities bundle.
+type Option func(*Activities)
+
+// WithMetrics records partner sync successes, hash short-circuits, and failures.
+func WithMetrics(metrics petsports.BusinessMetrics) Option {
+	return func(a *Activities) {
+		a.metrics = metrics
+	}
+}
+
+// WithReconciliation enables the reconciliation page activities against the given provider registry.
+func WithReconciliation(registry *petspartner.Registry) Option {
+	return func(a *Activities) {
+		if registry == nil {
+			return
+		}
+		a.reconciler = petspartner.NewReconciler(a.repo, registry)
+	}
 }
 
 // NewActivities wires the pets collaborators into the Temporal activities bundle.
 // persistService should be constructed without a partner sync dependency to avoid duplicate calls.
-func NewActivities(persistService petsports.Service, repo petsports.Repository, partnerSync petsports.PartnerSync) *Activities {
-	return &Activities{
+func NewActivities(persistService petsports.Service, repo petsports.Repository, partnerSync petsports.PartnerSync, opts ...Option) *Activities {
+	a := &Activities{
 		persistService: persistService,
 		repo:           repo,
 		partnerSync:    partnerSync,
 	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(a)
+		}
+	}
+	if a.metrics == nil {
+		a.metrics = petsports.NoopBusinessMetrics
+	}
+	return a
 }
 
 // PersistPet stores a new pet aggregate and returns its projection.
 func (a *Activities) PersistPet(ctx context.Context, input petstypes.AddPetInput) (*petstypes.PetProjection, error) {
-	logger := activity.GetLogger(ctx)
+	logger := correlation.Logger(ctx, activity.GetLogger(ctx))
 	petID := input.PetMutationInput.ID
 	if a == nil || a.persistService == nil {
 		logger.Error("pet persist activity not initialized", "petId", petID)
@@ -54,7 +99,7 @@ func (a *Activities) PersistPet(ctx context.Context, input petstypes.AddPetInput
 	projection, err := a.persistService.AddPet(ctx, input)
 	if err != nil {
 		logger.Error("PersistPet activity failed", "petId", petID, "error", err)
-		return nil, err
+		return nil, failures.FromError(err)
 	}
 	if projection != nil && projection.Pet != nil {
 		logger.Info("PersistPet activity completed", "petId", projection.Pet.ID)
@@ -65,8 +110,8 @@ func (a *Activities) PersistPet(ctx context.Context, input petstypes.AddPetInput
 }
 
 // SyncPetWithPartner loads a pet and pushes it to the configured partner.
-func (a *Activities) SyncPetWithPartner(ctx context.Context, input petstypes.PetIdentifier) error {
-	logger := activity.GetLogger(ctx)
+func (a *Activities) SyncPetWithPartner(ctx context.Context, input SyncPetInput) error {
+	logger := correlation.Logger(ctx, activity.GetLogger(ctx))
 	if a == nil {
 		logger.Error("pet sync activity not initialized", "petId", input.ID)
 		return errors.New("pet sync activity not initialized")
@@ -89,125 +134,118 @@ func (a *Activities) SyncPetWithPartner(ctx context.Context, input petstypes.Pet
 		return nil
 	}
 
-	logger.Info("SyncPetWithPartner activity started", "petId", input.ID)
+	logger.Info("SyncPetWithPartner activity started", "petId", input.ID, "providers", input.Providers, "force", input.Force)
+	if input.Force {
+		ctx = petsports.WithResync(ctx, activity.GetInfo(ctx).WorkflowExecution.RunID)
+	}
 	projection, err := a.repo.GetByID(ctx, input.ID)
 	if err != nil {
 		logger.Error("SyncPetWithPartner failed to load pet", "petId", input.ID, "error", err)
-		return err
+		return failures.FromError(err)
 	}
 	if projection == nil || projection.Pet == nil {
 		logger.Error("SyncPetWithPartner missing pet projection", "petId", input.ID)
 		return errors.New("pet projection missing for sync")
 	}
-	hash, err := computePartnerSyncHash(projection.Pet)
-	if err != nil {
-		logger.Error("SyncPetWithPartner failed to compute hash", "petId", input.ID, "error", err)
-		return err
-	}
-	if alreadySynced(hash, projection.Pet) {
-		logger.Info("SyncPetWithPartner skipped; payload unchanged since last sync", "petId", input.ID)
-		return nil
-	}
-	if err := a.partnerSync.Sync(ctx, projection.Pet); err != nil {
-		logger.Error("SyncPetWithPartner failed", "petId", input.ID, "error", err)
-		return err
+	// Providers are synced independently: each keeps its own hash, and a failing provider
+	// does not block the others. Successful providers are skipped on retry via their stored hash.
+	pet := projection.Pet
+	var (
+		errs   []error
+		synced int
+	)
+	for _, target := range petsports.SyncTargets(a.partnerSync, pet) {
+		provider := target.Provider()
+		if len(input.Providers) > 0 && !slices.Contains(input.Providers, provider) {
+			continue
+		}
+		hash, err := petspartner.SyncHashFor(pet, provider)
+		if err != nil {
+			logger.Error("SyncPetWithPartner failed to compute hash", "petId", input.ID, "provider", provider, "error", err)
+			return err
+		}
+		if !input.Force && alreadySynced(hash, pet, provider) {
+			logger.Info("SyncPetWithPartner skipped; payload unchanged since last sync", "petId", input.ID, "provider", provider)
+			a.metrics.PartnerSyncSkipped(ctx, provider)
+			continue
+		}
+		if err := target.Sync(ctx, pet); err != nil {
+			logger.Error("SyncPetWithPartner failed", "petId", input.ID, "provider", provider, "error", err)
+			a.metrics.PartnerSyncFailed(ctx, provider)
+			errs = append(errs, fmt.Errorf("%s: %w", provider, err))
+			continue
+		}
+		a.metrics.PartnerSyncSucceeded(ctx, provider)
+		// Persist the sync hash to avoid re-sending identical payloads on retries.
+		updatePartnerSyncHash(hash, pet, provider)
+		synced++
+	}
+	if synced > 0 {
+		if _, err := a.repo.Save(ctx, pet); err != nil {
+			logger.Error("SyncPetWithPartner failed to persist sync hash", "petId", input.ID, "error", err)
+			return err
+		}
 	}
-	// Persist the sync hash to avoid re-sending identical payloads on retries.
-	updatePartnerSyncHash(hash, projection.Pet)
-	if _, err := a.repo.Save(ctx, projection.Pet); err != nil {
-		logger.Error("SyncPetWithPartner failed to persist sync hash", "petId", input.ID, "error", err)
+	if err := errors.Join(errs...); err != nil {
 		return err
 	}
-	activity.RecordHeartbeat(ctx, syncHeartbeat{Completed: true, Hash: hash})
+	activity.RecordHeartbeat(ctx, syncHeartbeat{Completed: true})
 	logger.Info("SyncPetWithPartner activity completed", "petId", input.ID)
 	return nil
 }
 
-type syncHeartbeat struct {
-	Completed bool
-	Hash      string
+// ReconcileLocalPage compares one page of local pets with the partners' copies.
+func (a *Activities) ReconcileLocalPage(ctx context.Context, input petspartner.LocalPageInput) (*petspartner.ReconcilePage, error) {
+	logger := correlation.Logger(ctx, activity.GetLogger(ctx))
+	if a == nil || a.reconciler == nil {
+		logger.Error("partner reconciliation not configured")
+		return nil, errors.New("partner reconciliation not configured")
+	}
+	page, err := a.reconciler.ReconcileLocalPage(ctx, input)
+	if err != nil {
+		logger.Error("ReconcileLocalPage activity failed", "afterId", input.AfterID, "error", err)
+		return nil, err
+	}
+	logger.Info("ReconcileLocalPage activity completed", "afterId", input.AfterID, "pets", page.Pets, "records", len(page.Records))
+	return page, nil
 }
 
-// computePartnerSyncHash builds a deterministic hash of the pet payload used for partner sync.
-func computePartnerSyncHash(p *domain.Pet) (string, error) {
-	if p == nil {
-		return "", errors.New("nil pet")
-	}
-	normalized := struct {
-		ID           int64             `json:"id"`
-		Name         string            `json:"name"`
-		Status       domain.Status     `json:"status"`
-		CategoryID   int64             `json:"categoryId"`
-		CategoryName string            `json:"categoryName"`
-		PhotoURLs    []string          `json:"photoUrls"`
-		Tags         []syncTag         `json:"tags"`
-		Attributes   []syncAttributeKV `json:"attributes"`
-	}{
-		ID:        p.ID,
-		Name:      p.Name,
-		Status:    p.Status,
-		PhotoURLs: append([]string{}, p.PhotoURLs...),
-	}
-	if p.Category != nil {
-		normalized.CategoryID = p.Category.ID
-		normalized.CategoryName = p.Category.Name
-	}
-	if len(p.Tags) > 0 {
-		tags := make([]syncTag, 0, len(p.Tags))
-		for _, t := range p.Tags {
-			tags = append(tags, syncTag{ID: t.ID, Name: t.Name})
-		}
-		sort.Slice(tags, func(i, j int) bool {
-			if tags[i].Name == tags[j].Name {
-				return tags[i].ID < tags[j].ID
-			}
-			return tags[i].Name < tags[j].Name
-		})
-		normalized.Tags = tags
-	}
-	if p.ExternalRef != nil && len(p.ExternalRef.Attributes) > 0 {
-		attrs := make([]syncAttributeKV, 0, len(p.ExternalRef.Attributes))
-		for k, v := range p.ExternalRef.Attributes {
-			attrs = append(attrs, syncAttributeKV{Key: k, Value: v})
-		}
-		sort.Slice(attrs, func(i, j int) bool { return attrs[i].Key < attrs[j].Key })
-		normalized.Attributes = attrs
+// ReconcileRemotePage reports the orphaned records on one page of a partner's pets.
+func (a *Activities) ReconcileRemotePage(ctx context.Context, input petspartner.RemotePageInput) (*petspartner.ReconcilePage, error) {
+	logger := correlation.Logger(ctx, activity.GetLogger(ctx))
+	if a == nil || a.reconciler == nil {
+		logger.Error("partner reconciliation not configured")
+		return nil, errors.New("partner reconciliation not configured")
 	}
-
-	bytes, err := json.Marshal(normalized)
+	page, err := a.reconciler.ReconcileRemotePage(ctx, input)
 	if err != nil {
-		return "", err
+		logger.Error("ReconcileRemotePage activity failed", "provider", input.Provider, "error", err)
+		return nil, err
 	}
-	sum := sha256.Sum256(bytes)
-	return hex.EncodeToString(sum[:]), nil
+	logger.Info("ReconcileRemotePage activity completed", "provider", input.Provider, "records", len(page.Records))
+	return page, nil
+}
+
+type syncHeartbeat struct {
+	Completed bool
 }
 
-func alreadySynced(hash string, p *domain.Pet) bool {
-	if p == nil || p.ExternalRef == nil {
+func alreadySynced(hash string, p *domain.Pet, provider string) bool {
+	if p == nil {
 		return false
 	}
-	return p.ExternalRef.Attributes[partnerSyncHashKey] == hash
+	ref := p.ExternalReferenceFor(provider)
+	return ref != nil && ref.Attributes[partnerSyncHashKey] == hash
 }
 
-func updatePartnerSyncHash(hash string, p *domain.Pet) {
-	if p.ExternalRef == nil {
-		p.UpdateExternalReference(&domain.ExternalReference{
-			Provider: "partner",
-			ID:       strconv.FormatInt(p.ID, 10),
-		})
+func updatePartnerSyncHash(hash string, p *domain.Pet, provider string) {
+	ref := p.ExternalReferenceFor(provider)
+	if ref == nil {
+		ref = &domain.ExternalReference{Provider: provider, ID: strconv.FormatInt(p.ID, 10)}
 	}
-	if p.ExternalRef.Attributes == nil {
-		p.ExternalRef.Attributes = map[string]string{}
+	if ref.Attributes == nil {
+		ref.Attributes = map[string]string{}
 	}
-	p.ExternalRef.Attributes[partnerSyncHashKey] = hash
-}
-
-type syncTag struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
-}
-
-type syncAttributeKV struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	ref.Attributes[partnerSyncHashKey] = hash
+	p.LinkExternalReference(*ref)
 }
@@ -0,0 +1,57 @@
+package pets
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+
+	partnerclient "github.com/Apurer/go-gin-api-server/internal/clients/http/partner"
+	"github.com/Apurer/go-gin-api-server/internal/clients/http/partner/partnerstub"
+	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/memory"
+	"github.com/Apurer/go-gin-api-server/internal/domains/pets/domain"
+)
+
+func TestSyncPetWithPartner_AgainstStubTracksHashAndForcesRepair(t *testing.T) {
+	stub := partnerstub.New()
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	client, err := partnerclient.NewPartnerClient(server.URL, server.Client())
+	require.NoError(t, err)
+	registry, err := petspartner.NewRegistry(petspartner.NewSyncer(client, petspartner.WithProvider("acme")))
+	require.NoError(t, err)
+
+	repo := memory.NewRepository()
+	_, err = repo.Save(t.Context(), &domain.Pet{ID: 5, Name: "Rex", PhotoURLs: []string{"p"}, Status: domain.StatusAvailable})
+	require.NoError(t, err)
+	activities := NewActivities(nil, repo, registry, WithReconciliation(registry))
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	env.RegisterActivity(activities)
+
+	_, err = env.ExecuteActivity(activities.SyncPetWithPartner, SyncPetInput{ID: 5})
+	require.NoError(t, err)
+	stored, err := repo.GetByID(t.Context(), 5)
+	require.NoError(t, err)
+	ref := stored.Pet.ExternalReferenceFor("acme")
+	require.NotNil(t, ref)
+	require.NotEmpty(t, ref.Attributes[partnerSyncHashKey])
+
+	_, err = env.ExecuteActivity(activities.SyncPetWithPartner, SyncPetInput{ID: 5})
+	require.NoError(t, err)
+	require.Len(t, stub.Requests(), 1, "unchanged payload is skipped by the stored hash")
+
+	drifted, _ := stub.Pet("5")
+	drifted.Title = "Edited at partner"
+	stub.Put(drifted)
+	_, err = env.ExecuteActivity(activities.SyncPetWithPartner, SyncPetInput{ID: 5, Providers: []string{"acme"}, Force: true})
+	require.NoError(t, err)
+	requests := stub.Requests()
+	require.Len(t, requests, 2)
+	require.NotEqual(t, requests[0].IdempotencyKey, requests[1].IdempotencyKey, "forced repair must not be replayed by the partner")
+	repaired, _ := stub.Pet("5")
+	require.Equal(t, "Rex", repaired.Title)
+}
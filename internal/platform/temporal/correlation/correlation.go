@@ -0,0 +1,117 @@
+// Package correlation carries the request ID from the API into Temporal workflows and the
+// activities they schedule, so the worker's logs and partner calls share the caller's ID.
+package correlation
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
+)
+
+const (
+	// HeaderKey names the Temporal header holding the request ID.
+	HeaderKey = "request-id"
+	// MemoKey names the workflow memo entry holding the request ID, visible in the Temporal UI.
+	MemoKey = "requestId"
+	// LogKey is the key activity and workflow loggers use for the request ID.
+	LogKey = "requestId"
+)
+
+type workflowKey struct{}
+
+// Propagator copies the request ID between Go contexts, workflow contexts, and Temporal
+// headers. Register it on every client that starts workflows and on the worker's client.
+type Propagator struct{}
+
+// NewPropagator returns the request ID propagator.
+func NewPropagator() workflow.ContextPropagator {
+	return Propagator{}
+}
+
+// Inject implements workflow.ContextPropagator.
+func (Propagator) Inject(ctx context.Context, writer workflow.HeaderWriter) error {
+	return inject(requestid.FromContext(ctx), writer)
+}
+
+// Extract implements workflow.ContextPropagator.
+func (Propagator) Extract(ctx context.Context, reader workflow.HeaderReader) (context.Context, error) {
+	id, err := extract(reader)
+	if err != nil {
+		return ctx, err
+	}
+	return requestid.WithID(ctx, id), nil
+}
+
+// InjectFromWorkflow implements workflow.ContextPropagator.
+func (Propagator) InjectFromWorkflow(ctx workflow.Context, writer workflow.HeaderWriter) error {
+	return inject(FromWorkflow(ctx), writer)
+}
+
+// ExtractToWorkflow implements workflow.ContextPropagator.
+func (Propagator) ExtractToWorkflow(ctx workflow.Context, reader workflow.HeaderReader) (workflow.Context, error) {
+	id, err := extract(reader)
+	if err != nil || id == "" {
+		return ctx, err
+	}
+	return workflow.WithValue(ctx, workflowKey{}, id), nil
+}
+
+// FromWorkflow returns the request ID the workflow was started with, or "".
+func FromWorkflow(ctx workflow.Context) string {
+	id, _ := ctx.Value(workflowKey{}).(string)
+	return id
+}
+
+// Memo records the request ID on a workflow started from ctx; it is nil without one.
+func Memo(ctx context.Context) map[string]any {
+	id := requestid.FromContext(ctx)
+	if id == "" {
+		return nil
+	}
+	return map[string]any{MemoKey: id}
+}
+
+// Logger adds the request ID in ctx, when present, to an activity logger.
+func Logger(ctx context.Context, logger log.Logger) log.Logger {
+	if id := requestid.FromContext(ctx); id != "" {
+		return log.With(logger, LogKey, id)
+	}
+	return logger
+}
+
+// WorkflowLogger returns the workflow logger with the request ID, when present, attached.
+func WorkflowLogger(ctx workflow.Context) log.Logger {
+	logger := workflow.GetLogger(ctx)
+	if id := FromWorkflow(ctx); id != "" {
+		return log.With(logger, LogKey, id)
+	}
+	return logger
+}
+
+func inject(id string, writer workflow.HeaderWriter) error {
+	if id == "" {
+		return nil
+	}
+	payload, err := converter.GetDefaultDataConverter().ToPayload(id)
+	if err != nil {
+		return err
+	}
+	writer.Set(HeaderKey, payload)
+	return nil
+}
+
+func extract(reader workflow.HeaderReader) (string, error) {
+	payload, ok := reader.Get(HeaderKey)
+	if !ok {
+		return "", nil
+	}
+	var id string
+	if err := converter.GetDefaultDataConverter().FromPayload(payload, &id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
@@ -0,0 +1,55 @@
+package correlation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
+)
+
+type header map[string]*commonpb.Payload
+
+func (h header) Set(key string, value *commonpb.Payload) { h[key] = value }
+
+func (h header) Get(key string) (*commonpb.Payload, bool) {
+	value, ok := h[key]
+	return value, ok
+}
+
+func (h header) ForEachKey(handler func(string, *commonpb.Payload) error) error {
+	for key, value := range h {
+		if err := handler(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestPropagator_RoundTripsRequestID(t *testing.T) {
+	propagator := NewPropagator()
+
+	carried := header{}
+	require.NoError(t, propagator.Inject(requestid.WithID(context.Background(), "req-42"), carried))
+	require.Contains(t, carried, HeaderKey)
+
+	ctx, err := propagator.Extract(context.Background(), carried)
+	require.NoError(t, err)
+	require.Equal(t, "req-42", requestid.FromContext(ctx))
+	require.Equal(t, map[string]any{MemoKey: "req-42"}, Memo(ctx))
+}
+
+func TestPropagator_SkipsMissingRequestID(t *testing.T) {
+	propagator := NewPropagator()
+
+	carried := header{}
+	require.NoError(t, propagator.Inject(context.Background(), carried))
+	require.Empty(t, carried)
+
+	ctx, err := propagator.Extract(context.Background(), carried)
+	require.NoError(t, err)
+	require.Empty(t, requestid.FromContext(ctx))
+	require.Nil(t, Memo(ctx))
+}
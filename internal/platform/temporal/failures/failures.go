@@ -0,0 +1,37 @@
+// Package failures carries catalogued domain errors across Temporal activity and workflow
+// boundaries, where only an error type string and message survive serialization.
+package failures
+
+import (
+	"errors"
+	"net/http"
+
+	"go.temporal.io/sdk/temporal"
+
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+// FromError wraps a catalogued client error (4xx) in a non-retryable ApplicationError typed with
+// its code: retrying a request that can never succeed only delays the answer. Server-side
+// errors and uncatalogued ones are returned unchanged and keep the activity's retry policy.
+func FromError(err error) error {
+	definition, ok := apierrors.Lookup(err)
+	if !ok || definition.Status >= http.StatusInternalServerError {
+		return err
+	}
+	return temporal.NewNonRetryableApplicationError(err.Error(), definition.Code, err)
+}
+
+// ToError recovers the catalogued error from a workflow or activity failure so callers can
+// match the domain sentinel with errors.Is. Other failures are returned unchanged.
+func ToError(err error) error {
+	var appErr *temporal.ApplicationError
+	if !errors.As(err, &appErr) {
+		return err
+	}
+	definition, ok := apierrors.ByCode(appErr.Type())
+	if !ok {
+		return err
+	}
+	return &apierrors.CodedError{Definition: definition, Message: appErr.Message()}
+}
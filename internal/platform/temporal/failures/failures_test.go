@@ -0,0 +1,49 @@
+package failures
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+
+	apierrors "github.com/Apurer/go-gin-api-server/internal/shared/errors"
+)
+
+var (
+	errRejected    = errors.New("failures test: rejected")
+	errUnavailable = errors.New("failures test: unavailable")
+)
+
+func init() {
+	apierrors.Register(
+		apierrors.Definition{Err: errRejected, Code: "failures_test.rejected", Status: http.StatusBadRequest, Title: "Rejected"},
+		apierrors.Definition{Err: errUnavailable, Code: "failures_test.unavailable", Status: http.StatusBadGateway, Title: "Unavailable"},
+	)
+}
+
+func TestFromError_MakesClientErrorsNonRetryable(t *testing.T) {
+	encoded := FromError(fmt.Errorf("%w: pet 7", errRejected))
+	var appErr *temporal.ApplicationError
+	require.True(t, errors.As(encoded, &appErr))
+	require.True(t, appErr.NonRetryable())
+	require.Equal(t, "failures_test.rejected", appErr.Type())
+
+	retryable := fmt.Errorf("%w: partner down", errUnavailable)
+	require.Equal(t, retryable, FromError(retryable))
+	plain := errors.New("boom")
+	require.Equal(t, plain, FromError(plain))
+}
+
+func TestToError_RecoversTheSentinel(t *testing.T) {
+	// Only the type and message survive the trip through Temporal.
+	received := fmt.Errorf("workflow failed: %w", temporal.NewNonRetryableApplicationError("failures test: rejected: pet 7", "failures_test.rejected", nil))
+	decoded := ToError(received)
+	require.ErrorIs(t, decoded, errRejected)
+	require.Equal(t, "failures test: rejected: pet 7", decoded.Error())
+
+	unknown := temporal.NewApplicationError("boom", "SomethingElse")
+	require.Equal(t, unknown, ToError(unknown))
+}
@@ -48,7 +48,7 @@ func RunPetPersistenceSequence(ctx workflow.Context, input petstypes.AddPetInput
 
 	// Sync to partner with separate retry policy.
 	if projection.Pet != nil {
-		syncInput := petstypes.PetIdentifier{ID: projection.Pet.ID}
+		syncInput := petactivities.SyncPetInput{ID: projection.Pet.ID}
 		if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, syncOptions), petactivities.SyncPetWithPartnerActivityName, syncInput).Get(ctx, nil); err != nil {
 			logger.Error("pet persistence sequence sync failed", "petId", petID, "error", err)
 			return &projection, err
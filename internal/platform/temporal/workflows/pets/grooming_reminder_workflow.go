@@ -0,0 +1,72 @@
+package pets
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	petactivities "github.com/Apurer/go-gin-api-server/internal/platform/temporal/activities/pets"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/correlation"
+)
+
+const (
+	// GroomingReminderWorkflowName is the public identifier for registering the workflow.
+	GroomingReminderWorkflowName = "pets.workflows.GroomingReminder"
+	// GroomingReminderRescheduleSignal carries a new reminder time (time.Time) for a running reminder.
+	GroomingReminderRescheduleSignal = "pets.signals.GroomingReminderReschedule"
+)
+
+// GroomingReminderWorkflowInput names the appointment and when its reminder is due.
+type GroomingReminderWorkflowInput struct {
+	AppointmentID int64
+	RemindAt      time.Time
+}
+
+// GroomingReminderWorkflow waits on a durable timer until the reminder is due and then sends it.
+// A reschedule signal restarts the timer for the new time; cancelling the workflow drops the
+// reminder. The send activity skips appointments that are no longer scheduled.
+func GroomingReminderWorkflow(ctx workflow.Context, input GroomingReminderWorkflowInput) error {
+	logger := correlation.WorkflowLogger(ctx)
+	logger.Info("GroomingReminderWorkflow started", "appointmentId", input.AppointmentID, "remindAt", input.RemindAt)
+	reschedule := workflow.GetSignalChannel(ctx, GroomingReminderRescheduleSignal)
+	remindAt := input.RemindAt
+	for due := false; !due; {
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		timer := workflow.NewTimer(timerCtx, max(remindAt.Sub(workflow.Now(ctx)), 0))
+		var timerErr error
+		selector := workflow.NewSelector(ctx)
+		selector.AddFuture(timer, func(f workflow.Future) {
+			timerErr = f.Get(ctx, nil)
+			due = true
+		})
+		selector.AddReceive(reschedule, func(c workflow.ReceiveChannel, _ bool) {
+			c.Receive(ctx, &remindAt)
+			cancelTimer()
+			logger.Info("GroomingReminderWorkflow rescheduled", "appointmentId", input.AppointmentID, "remindAt", remindAt)
+		})
+		selector.Select(ctx)
+		if timerErr != nil {
+			// The workflow was cancelled because the appointment was cancelled or completed.
+			logger.Info("GroomingReminderWorkflow cancelled", "appointmentId", input.AppointmentID)
+			return timerErr
+		}
+	}
+
+	sendOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    10,
+		},
+	}
+	err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, sendOptions), petactivities.SendGroomingReminderActivityName, input.AppointmentID).Get(ctx, nil)
+	if err != nil {
+		logger.Error("GroomingReminderWorkflow failed", "appointmentId", input.AppointmentID, "error", err)
+		return err
+	}
+	logger.Info("GroomingReminderWorkflow completed", "appointmentId", input.AppointmentID)
+	return nil
+}
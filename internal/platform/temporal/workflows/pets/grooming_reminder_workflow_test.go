@@ -0,0 +1,58 @@
+package pets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	petactivities "github.com/Apurer/go-gin-api-server/internal/platform/temporal/activities/pets"
+)
+
+func newGroomingReminderEnv(t *testing.T, start time.Time, sent *[]time.Time) *testsuite.TestWorkflowEnvironment {
+	t.Helper()
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.SetStartTime(start)
+	env.RegisterWorkflowWithOptions(GroomingReminderWorkflow, workflow.RegisterOptions{Name: GroomingReminderWorkflowName})
+	env.RegisterActivityWithOptions(func(_ context.Context, appointmentID int64) error {
+		require.Equal(t, int64(7), appointmentID)
+		*sent = append(*sent, env.Now())
+		return nil
+	}, activity.RegisterOptions{Name: petactivities.SendGroomingReminderActivityName})
+	return env
+}
+
+func TestGroomingReminderWorkflow_RescheduleMovesTimer(t *testing.T) {
+	start := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	var sent []time.Time
+	env := newGroomingReminderEnv(t, start, &sent)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(GroomingReminderRescheduleSignal, start.Add(5*time.Hour))
+	}, time.Hour)
+
+	env.ExecuteWorkflow(GroomingReminderWorkflowName, GroomingReminderWorkflowInput{AppointmentID: 7, RemindAt: start.Add(2 * time.Hour)})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.Len(t, sent, 1, "the original timer must not fire after a reschedule")
+	require.False(t, sent[0].Before(start.Add(5*time.Hour)))
+}
+
+func TestGroomingReminderWorkflow_CancelDropsReminder(t *testing.T) {
+	start := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	var sent []time.Time
+	env := newGroomingReminderEnv(t, start, &sent)
+	env.RegisterDelayedCallback(env.CancelWorkflow, time.Hour)
+
+	env.ExecuteWorkflow(GroomingReminderWorkflowName, GroomingReminderWorkflowInput{AppointmentID: 7, RemindAt: start.Add(2 * time.Hour)})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.True(t, temporal.IsCanceledError(env.GetWorkflowError()))
+	require.Empty(t, sent)
+}
@@ -0,0 +1,163 @@
+package pets
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	petactivities "github.com/Apurer/go-gin-api-server/internal/platform/temporal/activities/pets"
+)
+
+// PartnerReconciliationWorkflowName is the public identifier for registering the workflow.
+const PartnerReconciliationWorkflowName = "pets.workflows.PartnerReconciliation"
+
+const (
+	// reconciliationPagesPerRun bounds the history of one run before it continues as new.
+	reconciliationPagesPerRun = 50
+	// reconciliationRepairBatch caps the SyncPetWithPartner repairs running at once.
+	reconciliationRepairBatch = 10
+)
+
+// PartnerReconciliationWorkflowInput selects providers (all when empty) and whether drift is repaired.
+type PartnerReconciliationWorkflowInput struct {
+	Providers []string
+	PageSize  int
+	Repair    bool
+	// State carries the progress of a run across ContinueAsNew; callers leave it nil.
+	State *PartnerReconciliationState
+}
+
+// PartnerReconciliationState is where a continued run picks up: the report so far, the local
+// cursor, and the provider whose remote pets are being listed.
+type PartnerReconciliationState struct {
+	Report    petstypes.ReconciliationReport
+	Providers []string
+	AfterID   int64
+	LocalDone bool
+	Remote    int
+	Cursor    string
+}
+
+// PartnerReconciliationWorkflow scans local pets against each partner one page per activity and,
+// when Repair is set, re-runs SyncPetWithPartner with Force for the drifted and missing-remote
+// records of each page in bounded batches. The report keeps every count but at most
+// petstypes.MaxReportRecords records, and the run continues as new every few pages.
+func PartnerReconciliationWorkflow(ctx workflow.Context, input PartnerReconciliationWorkflowInput) (*petstypes.ReconciliationReport, error) {
+	logger := workflow.GetLogger(ctx)
+	pageOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	repairOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    2 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    5 * time.Second,
+			MaximumAttempts:    3,
+		},
+	}
+	pageCtx := workflow.WithActivityOptions(ctx, pageOptions)
+	repairCtx := workflow.WithActivityOptions(ctx, repairOptions)
+
+	state := input.State
+	if state == nil {
+		logger.Info("PartnerReconciliationWorkflow started", "providers", input.Providers, "repair", input.Repair)
+		state = &PartnerReconciliationState{
+			Report:    petstypes.ReconciliationReport{StartedAt: workflow.Now(ctx).UTC(), Repair: input.Repair},
+			Providers: input.Providers,
+		}
+	}
+	report := &state.Report
+
+	for pages := 0; ; pages++ {
+		if pages >= reconciliationPagesPerRun || workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			input.State = state
+			return nil, workflow.NewContinueAsNewError(ctx, PartnerReconciliationWorkflowName, input)
+		}
+		if !state.LocalDone {
+			var page petspartner.ReconcilePage
+			pageInput := petspartner.LocalPageInput{Providers: state.Providers, PageSize: input.PageSize, AfterID: state.AfterID}
+			if err := workflow.ExecuteActivity(pageCtx, petactivities.ReconcileLocalPageActivityName, pageInput).Get(ctx, &page); err != nil {
+				logger.Error("PartnerReconciliationWorkflow local page failed", "afterId", state.AfterID, "error", err)
+				return nil, err
+			}
+			if len(state.Providers) == 0 {
+				state.Providers = page.Providers
+			}
+			for _, provider := range page.Providers {
+				report.AddInSync(provider, page.InSync[provider])
+			}
+			if input.Repair {
+				repairRecords(repairCtx, page.Records)
+			}
+			for _, record := range page.Records {
+				report.Add(record, petstypes.MaxReportRecords)
+			}
+			state.AfterID = page.AfterID
+			state.LocalDone = page.Done
+			continue
+		}
+		if state.Remote >= len(state.Providers) {
+			break
+		}
+		provider := state.Providers[state.Remote]
+		var page petspartner.ReconcilePage
+		pageInput := petspartner.RemotePageInput{Provider: provider, PageSize: input.PageSize, Cursor: state.Cursor}
+		if err := workflow.ExecuteActivity(pageCtx, petactivities.ReconcileRemotePageActivityName, pageInput).Get(ctx, &page); err != nil {
+			report.Add(petstypes.ReconciliationRecord{
+				Provider: provider,
+				Status:   petstypes.ReconciliationFailed,
+				Error:    fmt.Sprintf("list remote pets: %v", err),
+			}, petstypes.MaxReportRecords)
+			page.Done = true
+		}
+		for _, record := range page.Records {
+			report.Add(record, petstypes.MaxReportRecords)
+		}
+		state.Cursor = page.Cursor
+		if page.Done {
+			state.Remote++
+			state.Cursor = ""
+		}
+	}
+
+	report.FinishedAt = workflow.Now(ctx).UTC()
+	logger.Info("PartnerReconciliationWorkflow completed", "records", len(report.Records), "omitted", report.OmittedRecords)
+	return report, nil
+}
+
+// repairRecords re-syncs the repairable records reconciliationRepairBatch at a time and marks
+// each with its outcome.
+func repairRecords(ctx workflow.Context, records []petstypes.ReconciliationRecord) {
+	var pending []int
+	for i, record := range records {
+		if record.Status.Repairable() {
+			pending = append(pending, i)
+		}
+	}
+	for start := 0; start < len(pending); start += reconciliationRepairBatch {
+		batch := pending[start:min(start+reconciliationRepairBatch, len(pending))]
+		futures := make([]workflow.Future, len(batch))
+		for j, i := range batch {
+			syncInput := petactivities.SyncPetInput{ID: records[i].PetID, Providers: []string{records[i].Provider}, Force: true}
+			futures[j] = workflow.ExecuteActivity(ctx, petactivities.SyncPetWithPartnerActivityName, syncInput)
+		}
+		for j, i := range batch {
+			if err := futures[j].Get(ctx, nil); err != nil {
+				records[i].RepairError = err.Error()
+				continue
+			}
+			records[i].Repaired = true
+		}
+	}
+}
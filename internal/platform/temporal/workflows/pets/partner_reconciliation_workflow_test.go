@@ -0,0 +1,134 @@
+package pets
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	petspartner "github.com/Apurer/go-gin-api-server/internal/domains/pets/adapters/external/partner"
+	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	petactivities "github.com/Apurer/go-gin-api-server/internal/platform/temporal/activities/pets"
+)
+
+type reconciliationActivities struct {
+	local  func(petspartner.LocalPageInput) *petspartner.ReconcilePage
+	remote func(petspartner.RemotePageInput) *petspartner.ReconcilePage
+	sync   func(petactivities.SyncPetInput) error
+}
+
+func newReconciliationEnv(t *testing.T, fakes reconciliationActivities) *testsuite.TestWorkflowEnvironment {
+	t.Helper()
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(PartnerReconciliationWorkflow, workflow.RegisterOptions{Name: PartnerReconciliationWorkflowName})
+	env.RegisterActivityWithOptions(func(_ context.Context, input petspartner.LocalPageInput) (*petspartner.ReconcilePage, error) {
+		return fakes.local(input), nil
+	}, activity.RegisterOptions{Name: petactivities.ReconcileLocalPageActivityName})
+	env.RegisterActivityWithOptions(func(_ context.Context, input petspartner.RemotePageInput) (*petspartner.ReconcilePage, error) {
+		return fakes.remote(input), nil
+	}, activity.RegisterOptions{Name: petactivities.ReconcileRemotePageActivityName})
+	env.RegisterActivityWithOptions(func(_ context.Context, input petactivities.SyncPetInput) error {
+		if fakes.sync == nil {
+			return nil
+		}
+		return fakes.sync(input)
+	}, activity.RegisterOptions{Name: petactivities.SyncPetWithPartnerActivityName})
+	return env
+}
+
+func TestPartnerReconciliationWorkflow_RepairsEachPageInBoundedBatches(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	env := newReconciliationEnv(t, reconciliationActivities{
+		local: func(input petspartner.LocalPageInput) *petspartner.ReconcilePage {
+			if input.AfterID > 0 {
+				return &petspartner.ReconcilePage{Providers: []string{"partner"}, AfterID: input.AfterID, Done: true}
+			}
+			page := &petspartner.ReconcilePage{Providers: []string{"partner"}, InSync: map[string]int{"partner": 3}, AfterID: 25, Pets: 29}
+			for id := int64(1); id <= 25; id++ {
+				page.Records = append(page.Records, petstypes.ReconciliationRecord{Provider: "partner", PetID: id, Status: petstypes.ReconciliationMissingRemote})
+			}
+			page.Records = append(page.Records, petstypes.ReconciliationRecord{Provider: "partner", PetID: 26, Status: petstypes.ReconciliationFailed, Error: "timeout"})
+			return page
+		},
+		remote: func(input petspartner.RemotePageInput) *petspartner.ReconcilePage {
+			orphan := []petstypes.ReconciliationRecord{{Provider: input.Provider, Reference: "x-" + input.Cursor, Status: petstypes.ReconciliationOrphanedRemote}}
+			if input.Cursor == "" {
+				return &petspartner.ReconcilePage{Records: orphan, Cursor: "next"}
+			}
+			return &petspartner.ReconcilePage{Records: orphan, Done: true}
+		},
+		sync: func(input petactivities.SyncPetInput) error {
+			defer inFlight.Add(-1)
+			if n := inFlight.Add(1); n > maxInFlight.Load() {
+				maxInFlight.Store(n)
+			}
+			time.Sleep(time.Millisecond)
+			if input.ID == 7 {
+				return temporal.NewNonRetryableApplicationError("partner rejected the pet", "test", nil)
+			}
+			return nil
+		},
+	})
+
+	env.ExecuteWorkflow(PartnerReconciliationWorkflowName, PartnerReconciliationWorkflowInput{Repair: true})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var report petstypes.ReconciliationReport
+	require.NoError(t, env.GetWorkflowResult(&report))
+	require.LessOrEqual(t, maxInFlight.Load(), int32(reconciliationRepairBatch))
+	require.Equal(t, []petstypes.ReconciliationSummary{
+		{Provider: "partner", InSync: 3, MissingRemote: 25, OrphanedRemote: 2, Failed: 1, Repaired: 24},
+	}, report.Summaries)
+	require.Len(t, report.Records, 28)
+	require.Contains(t, report.Records[6].RepairError, "partner rejected the pet")
+	require.True(t, report.Unrepaired())
+}
+
+func TestPartnerReconciliationWorkflow_ContinuesAsNewAndCapsRecords(t *testing.T) {
+	fakes := reconciliationActivities{
+		local: func(input petspartner.LocalPageInput) *petspartner.ReconcilePage {
+			page := &petspartner.ReconcilePage{Providers: []string{"partner"}, AfterID: input.AfterID + 1, Done: input.AfterID+1 == 60}
+			for range 30 {
+				page.Records = append(page.Records, petstypes.ReconciliationRecord{Provider: "partner", PetID: page.AfterID, Status: petstypes.ReconciliationDrifted})
+			}
+			return page
+		},
+		remote: func(petspartner.RemotePageInput) *petspartner.ReconcilePage {
+			return &petspartner.ReconcilePage{Done: true}
+		},
+	}
+	env := newReconciliationEnv(t, fakes)
+	env.ExecuteWorkflow(PartnerReconciliationWorkflowName, PartnerReconciliationWorkflowInput{PageSize: 30})
+
+	var continued *workflow.ContinueAsNewError
+	require.True(t, errors.As(env.GetWorkflowError(), &continued))
+	var next PartnerReconciliationWorkflowInput
+	require.NoError(t, converter.GetDefaultDataConverter().FromPayloads(continued.Input, &next))
+	require.Equal(t, 30, next.PageSize)
+	require.NotNil(t, next.State)
+	require.Equal(t, int64(reconciliationPagesPerRun), next.State.AfterID)
+	require.Len(t, next.State.Report.Records, petstypes.MaxReportRecords)
+	require.Equal(t, reconciliationPagesPerRun*30-petstypes.MaxReportRecords, next.State.Report.OmittedRecords)
+
+	env = newReconciliationEnv(t, fakes)
+	env.ExecuteWorkflow(PartnerReconciliationWorkflowName, next)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var report petstypes.ReconciliationReport
+	require.NoError(t, env.GetWorkflowResult(&report))
+	require.Equal(t, []petstypes.ReconciliationSummary{{Provider: "partner", Drifted: 60 * 30}}, report.Summaries)
+	require.Len(t, report.Records, petstypes.MaxReportRecords)
+	require.Equal(t, 60*30-petstypes.MaxReportRecords, report.OmittedRecords)
+	require.True(t, report.Unrepaired())
+}
@@ -2,6 +2,7 @@ package pets
 
 import (
 	petstypes "github.com/Apurer/go-gin-api-server/internal/domains/pets/application/types"
+	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/correlation"
 	"github.com/Apurer/go-gin-api-server/internal/platform/temporal/sequences"
 	"go.temporal.io/sdk/workflow"
 )
@@ -21,7 +22,7 @@ type PetCreationWorkflowInput struct {
 
 // PetCreationWorkflow orchestrates the activities needed to persist a pet aggregate.
 func PetCreationWorkflow(ctx workflow.Context, input PetCreationWorkflowInput) (*petstypes.PetProjection, error) {
-	logger := workflow.GetLogger(ctx)
+	logger := correlation.WorkflowLogger(ctx)
 	petID := input.Command.PetMutationInput.ID
 	logger.Info("PetCreationWorkflow started", withTraceID(input.TraceID, "petId", petID)...)
 	projection, err := sequences.RunPetPersistenceSequence(ctx, input.Command)
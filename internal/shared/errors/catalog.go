@@ -0,0 +1,160 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Definition gives a domain error a stable, machine-readable code and the problem it
+// surfaces as. Codes are part of the API contract: clients branch on them, so they never
+// change once published.
+type Definition struct {
+	// Err is the sentinel matched with errors.Is.
+	Err error
+	// Code is the stable identifier, namespaced by bounded context (e.g. "pets.name_required").
+	Code string
+	// Status is the HTTP status; gRPC and Temporal derive their handling from it.
+	Status int
+	// Title is the short summary used as the problem title.
+	Title string
+	// Type overrides the problem type URI derived from the code.
+	Type string
+	// Field names the offending request field, keyed like the request validator ("body.name").
+	Field string
+}
+
+// ProblemType returns the problem type URI: Type when set, otherwise /problems/ followed by
+// the code with dots as path separators and underscores as hyphens.
+func (d Definition) ProblemType() string {
+	if d.Type != "" {
+		return d.Type
+	}
+	return "/problems/" + strings.NewReplacer(".", "/", "_", "-").Replace(d.Code)
+}
+
+// Problem builds the problem for an occurrence of the error. Field errors carry the same
+// fields extension as request validation failures.
+func (d Definition) Problem(err error) ProblemDetail {
+	problem := ProblemDetail{}
+	if d.Field != "" {
+		problem = NewValidationProblem(map[string]string{d.Field: d.Err.Error()})
+	}
+	problem.Type = d.ProblemType()
+	problem.Title = d.Title
+	problem.Status = d.Status
+	problem.Code = d.Code
+	if err != nil {
+		problem.Detail = err.Error()
+	}
+	return problem
+}
+
+// Catalog is a registry of error definitions. Lookups return the first registered definition
+// whose sentinel matches, so a domain registers specific errors before the generic error
+// they are wrapped in (ErrEmptyName before ErrInvalidInput).
+type Catalog struct {
+	mu          sync.RWMutex
+	definitions []Definition
+	byCode      map[string]Definition
+}
+
+// NewCatalog creates an empty catalog.
+func NewCatalog() *Catalog {
+	return &Catalog{byCode: make(map[string]Definition)}
+}
+
+// DefaultCatalog holds the definitions registered by the bounded contexts.
+var DefaultCatalog = NewCatalog()
+
+// Register adds definitions in order. It panics on a missing sentinel, code, or status and
+// on a reused code, since those are programming errors caught at startup.
+func (c *Catalog) Register(definitions ...Definition) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, definition := range definitions {
+		if definition.Err == nil || definition.Code == "" || definition.Status == 0 {
+			panic(fmt.Sprintf("errors: incomplete definition %q", definition.Code))
+		}
+		if _, exists := c.byCode[definition.Code]; exists {
+			panic(fmt.Sprintf("errors: code %q registered twice", definition.Code))
+		}
+		c.byCode[definition.Code] = definition
+		c.definitions = append(c.definitions, definition)
+	}
+}
+
+// Lookup finds the definition matching err.
+func (c *Catalog) Lookup(err error) (Definition, bool) {
+	if err == nil {
+		return Definition{}, false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, definition := range c.definitions {
+		if errors.Is(err, definition.Err) {
+			return definition, true
+		}
+	}
+	return Definition{}, false
+}
+
+// ByCode finds the definition registered under code.
+func (c *Catalog) ByCode(code string) (Definition, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	definition, ok := c.byCode[code]
+	return definition, ok
+}
+
+// Definitions returns the registered definitions in lookup order.
+func (c *Catalog) Definitions() []Definition {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]Definition(nil), c.definitions...)
+}
+
+// Mapper adapts the catalog to a ChainedResponder.
+func (c *Catalog) Mapper() ErrorMapper {
+	return func(err error) (ProblemDetail, bool) {
+		definition, ok := c.Lookup(err)
+		if !ok {
+			return ProblemDetail{}, false
+		}
+		return definition.Problem(err), true
+	}
+}
+
+// Register adds definitions to the default catalog.
+func Register(definitions ...Definition) {
+	DefaultCatalog.Register(definitions...)
+}
+
+// Lookup finds the definition matching err in the default catalog.
+func Lookup(err error) (Definition, bool) {
+	return DefaultCatalog.Lookup(err)
+}
+
+// ByCode finds a definition in the default catalog.
+func ByCode(code string) (Definition, bool) {
+	return DefaultCatalog.ByCode(code)
+}
+
+// CodedError rebuilds a catalogued error received from another process, such as a Temporal
+// activity failure: it matches the definition's sentinel with errors.Is and keeps the
+// original message.
+type CodedError struct {
+	Definition Definition
+	Message    string
+}
+
+// Error returns the original message.
+func (e *CodedError) Error() string {
+	return e.Message
+}
+
+// Unwrap exposes the sentinel.
+func (e *CodedError) Unwrap() error {
+	return e.Definition.Err
+}
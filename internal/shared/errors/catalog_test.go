@@ -0,0 +1,127 @@
+package errors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errEmptyName    = errors.New("name is required")
+	errInvalidInput = errors.New("invalid input")
+	errMissing      = errors.New("not found")
+)
+
+func testCatalog() *Catalog {
+	catalog := NewCatalog()
+	catalog.Register(
+		Definition{Err: errEmptyName, Code: "things.name_required", Status: http.StatusBadRequest, Title: "Name Required", Field: "body.name"},
+		Definition{Err: errInvalidInput, Code: "things.invalid_input", Status: http.StatusBadRequest, Title: "Invalid Input"},
+		Definition{Err: errMissing, Code: "things.not_found", Status: http.StatusNotFound, Title: "Thing Not Found"},
+	)
+	return catalog
+}
+
+func TestCatalog_LookupPrefersEarlierDefinitions(t *testing.T) {
+	catalog := testCatalog()
+
+	definition, ok := catalog.Lookup(fmt.Errorf("%w: %w", errInvalidInput, errEmptyName))
+	require.True(t, ok)
+	require.Equal(t, "things.name_required", definition.Code)
+
+	definition, ok = catalog.Lookup(fmt.Errorf("%w: id 0", errInvalidInput))
+	require.True(t, ok)
+	require.Equal(t, "things.invalid_input", definition.Code)
+
+	_, ok = catalog.Lookup(errors.New("boom"))
+	require.False(t, ok)
+
+	definition, ok = catalog.ByCode("things.not_found")
+	require.True(t, ok)
+	require.Equal(t, errMissing, definition.Err)
+}
+
+func TestCatalog_RegisterRejectsDuplicateCodes(t *testing.T) {
+	catalog := testCatalog()
+	require.Panics(t, func() {
+		catalog.Register(Definition{Err: errors.New("other"), Code: "things.not_found", Status: http.StatusNotFound})
+	})
+	require.Panics(t, func() {
+		catalog.Register(Definition{Code: "things.no_sentinel", Status: http.StatusBadRequest})
+	})
+}
+
+func TestCatalog_MapperRespondsWithCodedProblems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	responder := NewChainedResponder("", testCatalog().Mapper())
+	router := gin.New()
+	router.POST("/v2/things", func(c *gin.Context) {
+		responder.RespondError(c, fmt.Errorf("%w: %w", errInvalidInput, errEmptyName))
+	})
+	router.GET("/v2/things/:id", func(c *gin.Context) {
+		responder.RespondError(c, errMissing)
+	})
+	router.GET("/v2/broken", func(c *gin.Context) {
+		responder.RespondError(c, errors.New("boom"))
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v2/things", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	var problem ProblemDetail
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	require.Equal(t, "/problems/things/name-required", problem.Type)
+	require.Equal(t, "things.name_required", problem.Code)
+	require.Equal(t, "Name Required", problem.Title)
+	require.Equal(t, "invalid input: name is required", problem.Detail)
+	require.Equal(t, map[string]any{"body.name": "name is required"}, problem.Extensions["fields"])
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/things/7", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	problem = ProblemDetail{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	require.Equal(t, "things.not_found", problem.Code)
+	require.Empty(t, problem.Extensions)
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v2/broken", nil))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	problem = ProblemDetail{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	require.Equal(t, TypeInternal, problem.Type)
+	require.Empty(t, problem.Code)
+}
+
+func TestDefinition_GRPCStatusCarriesCodeAndField(t *testing.T) {
+	definition, ok := testCatalog().Lookup(errEmptyName)
+	require.True(t, ok)
+
+	st := definition.GRPCStatus(errEmptyName)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Equal(t, "name is required", st.Message())
+	require.Len(t, st.Details(), 2)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, "things.name_required", info.GetReason())
+	require.Equal(t, ErrorInfoDomain, info.GetDomain())
+	badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Equal(t, "body.name", badRequest.GetFieldViolations()[0].GetField())
+}
+
+func TestGRPCError_PassesThroughStatusesAndHidesUnknownErrors(t *testing.T) {
+	existing := status.Error(codes.PermissionDenied, "nope")
+	require.Equal(t, existing, GRPCError(existing))
+	require.Equal(t, codes.Internal, status.Code(GRPCError(errors.New("boom"))))
+	require.NoError(t, GRPCError(nil))
+}
@@ -0,0 +1,93 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorInfoDomain is the ErrorInfo domain of catalogued errors sent over gRPC.
+const ErrorInfoDomain = "petstore"
+
+// GRPCCode maps an HTTP status to the gRPC code with the same meaning.
+func GRPCCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusUnsupportedMediaType:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusUnprocessableEntity:
+		return codes.FailedPrecondition
+	case http.StatusRequestEntityTooLarge, http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
+}
+
+// GRPCStatus converts an occurrence of the error to a gRPC status. The code travels as the
+// ErrorInfo reason and the field, when known, as a BadRequest field violation.
+func (d Definition) GRPCStatus(err error) *status.Status {
+	message := d.Title
+	if err != nil {
+		message = err.Error()
+	}
+	st := status.New(GRPCCode(d.Status), message)
+	info := &errdetails.ErrorInfo{Reason: d.Code, Domain: ErrorInfoDomain}
+	var withDetails *status.Status
+	var detailErr error
+	if d.Field != "" {
+		withDetails, detailErr = st.WithDetails(info, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: d.Field, Description: d.Err.Error()}},
+		})
+	} else {
+		withDetails, detailErr = st.WithDetails(info)
+	}
+	if detailErr != nil {
+		return st
+	}
+	return withDetails
+}
+
+// GRPCStatus converts err to a gRPC status through the default catalog.
+func GRPCStatus(err error) (*status.Status, bool) {
+	definition, ok := Lookup(err)
+	if !ok {
+		return nil, false
+	}
+	return definition.GRPCStatus(err), true
+}
+
+// GRPCError converts a service error for a gRPC handler: statuses pass through, context errors
+// keep their codes, catalogued errors get their code and details, and anything else is Internal.
+func GRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	if st, ok := GRPCStatus(err); ok {
+		return st.Err()
+	}
+	return status.Error(codes.Internal, err.Error())
+}
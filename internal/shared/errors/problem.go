@@ -19,6 +19,8 @@ type ProblemDetail struct {
 	Detail string `json:"detail,omitempty"`
 	// Instance is a URI reference that identifies the specific occurrence.
 	Instance string `json:"instance,omitempty"`
+	// Code is the stable machine-readable code of a catalogued domain error.
+	Code string `json:"code,omitempty"`
 	// Extensions holds additional problem-specific properties.
 	Extensions map[string]any `json:"extensions,omitempty"`
 }
@@ -54,14 +56,17 @@ func (p ProblemDetail) WithExtension(key string, value any) ProblemDetail {
 
 // Common problem types as URI references.
 const (
-	TypeValidation    = "/problems/validation-error"
-	TypeNotFound      = "/problems/not-found"
-	TypeConflict      = "/problems/conflict"
-	TypeInternal      = "/problems/internal-error"
-	TypeUnauthorized  = "/problems/unauthorized"
-	TypeForbidden     = "/problems/forbidden"
-	TypeBadRequest    = "/problems/bad-request"
-	TypeUnprocessable = "/problems/unprocessable-entity"
+	TypeValidation       = "/problems/validation-error"
+	TypeNotFound         = "/problems/not-found"
+	TypeConflict         = "/problems/conflict"
+	TypeInternal         = "/problems/internal-error"
+	TypeUnauthorized     = "/problems/unauthorized"
+	TypeForbidden        = "/problems/forbidden"
+	TypeBadRequest       = "/problems/bad-request"
+	TypeUnprocessable    = "/problems/unprocessable-entity"
+	TypeUnsupportedMedia = "/problems/unsupported-media-type"
+	TypeNotAcceptable    = "/problems/not-acceptable"
+	TypePayloadTooLarge  = "/problems/payload-too-large"
 )
 
 // Pre-defined problem templates for common scenarios.
@@ -121,6 +126,27 @@ var (
 		Title:  "Unprocessable Entity",
 		Status: http.StatusUnprocessableEntity,
 	}
+
+	// ErrUnsupportedMediaType indicates the request body uses a content type the endpoint does not accept.
+	ErrUnsupportedMediaType = ProblemDetail{
+		Type:   TypeUnsupportedMedia,
+		Title:  "Unsupported Media Type",
+		Status: http.StatusUnsupportedMediaType,
+	}
+
+	// ErrNotAcceptable indicates none of the media types in the Accept header can be produced.
+	ErrNotAcceptable = ProblemDetail{
+		Type:   TypeNotAcceptable,
+		Title:  "Not Acceptable",
+		Status: http.StatusNotAcceptable,
+	}
+
+	// ErrPayloadTooLarge indicates the request body exceeds the configured size limit.
+	ErrPayloadTooLarge = ProblemDetail{
+		Type:   TypePayloadTooLarge,
+		Title:  "Payload Too Large",
+		Status: http.StatusRequestEntityTooLarge,
+	}
 )
 
 // NewValidationProblem creates a validation error with field-level details.
@@ -135,3 +161,10 @@ func NewNotFoundProblem(resourceType string, identifier any) ProblemDetail {
 		WithExtension("resourceType", resourceType).
 		WithExtension("identifier", identifier)
 }
+
+// NewPayloadTooLargeProblem creates a 413 error for a body over the given byte limit.
+func NewPayloadTooLargeProblem(limit int64) ProblemDetail {
+	return ErrPayloadTooLarge.
+		WithDetail(fmt.Sprintf("request body exceeds %d bytes", limit)).
+		WithExtension("maxBodyBytes", limit)
+}
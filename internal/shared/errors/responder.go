@@ -5,10 +5,25 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"github.com/Apurer/go-gin-api-server/internal/shared/requestid"
 )
 
-// ContentTypeProblemJSON is the media type for Problem Details responses.
-const ContentTypeProblemJSON = "application/problem+json"
+// Media types for Problem Details responses.
+const (
+	ContentTypeProblemJSON = "application/problem+json"
+	ContentTypeProblemXML  = "application/problem+xml"
+)
+
+// problemFormats are offered to the client's Accept header; JSON wins when nothing matches.
+var problemFormats = []string{
+	ContentTypeProblemJSON,
+	binding.MIMEJSON,
+	ContentTypeProblemXML,
+	binding.MIMEXML,
+	binding.MIMEXML2,
+}
 
 // Responder provides methods to send Problem Details responses.
 type Responder struct {
@@ -24,16 +39,39 @@ func NewResponder(baseURI string) *Responder {
 // DefaultResponder uses relative URIs for problem types.
 var DefaultResponder = NewResponder("")
 
-// Respond sends a ProblemDetail response with proper content type.
+// Respond sends a ProblemDetail response as application/problem+xml when the client
+// prefers XML and as application/problem+json otherwise.
 func (r *Responder) Respond(c *gin.Context, problem ProblemDetail) {
 	if r.BaseURI != "" && len(problem.Type) > 0 && problem.Type[0] == '/' {
 		problem.Type = r.BaseURI + problem.Type
 	}
 	if problem.Instance == "" {
-		problem.Instance = c.Request.URL.Path
+		problem.Instance = instance(c)
+	}
+	// Errors must not inherit a route's caching policy or validators.
+	c.Header("Cache-Control", "no-store")
+	c.Writer.Header().Del("ETag")
+	c.Writer.Header().Del("Last-Modified")
+	switch c.NegotiateFormat(problemFormats...) {
+	case ContentTypeProblemXML, binding.MIMEXML, binding.MIMEXML2:
+		c.Header("Content-Type", ContentTypeProblemXML)
+		c.XML(problem.Status, problem)
+	default:
+		c.Header("Content-Type", ContentTypeProblemJSON)
+		c.JSON(problem.Status, problem)
+	}
+}
+
+// RequestInstancePrefix turns a request ID into the problem instance URI.
+const RequestInstancePrefix = "urn:request-id:"
+
+// instance identifies the failed request by its ID so support can find it in logs and traces,
+// falling back to the path when no ID was assigned.
+func instance(c *gin.Context) string {
+	if id := requestid.FromContext(c.Request.Context()); id != "" {
+		return RequestInstancePrefix + id
 	}
-	c.Header("Content-Type", ContentTypeProblemJSON)
-	c.JSON(problem.Status, problem)
+	return c.Request.URL.Path
 }
 
 // RespondError converts a standard error to a ProblemDetail and responds.
@@ -0,0 +1,51 @@
+package errors
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRespond_NegotiatesProblemFormat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/v2/pet/:petId", func(c *gin.Context) {
+		Respond(c, NewValidationProblem(map[string]string{"path.petId": "must be an integer"}))
+	})
+
+	cases := []struct {
+		accept      string
+		contentType string
+	}{
+		{accept: "", contentType: ContentTypeProblemJSON},
+		{accept: "application/json", contentType: ContentTypeProblemJSON},
+		{accept: "text/csv", contentType: ContentTypeProblemJSON},
+		{accept: "application/xml", contentType: ContentTypeProblemXML},
+		{accept: "application/problem+xml, application/json", contentType: ContentTypeProblemXML},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/v2/pet/abc", nil)
+		if tc.accept != "" {
+			req.Header.Set("Accept", tc.accept)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusBadRequest, rec.Code, tc.accept)
+		require.Equal(t, tc.contentType, rec.Header().Get("Content-Type"), tc.accept)
+		if tc.contentType == ContentTypeProblemJSON {
+			var problem ProblemDetail
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			require.Equal(t, TypeValidation, problem.Type)
+			continue
+		}
+		require.Equal(t, `<problem xmlns="urn:ietf:rfc:7807">`+
+			`<type>/problems/validation-error</type><title>Validation Error</title><status>400</status>`+
+			`<instance>/v2/pet/abc</instance>`+
+			`<extensions><fields><path.petId>must be an integer</path.petId></fields></extensions>`+
+			`</problem>`, rec.Body.String())
+	}
+}
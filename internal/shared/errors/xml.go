@@ -0,0 +1,121 @@
+package errors
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// ProblemXMLNamespace is the namespace RFC 7807 Appendix A assigns to XML problem documents.
+const ProblemXMLNamespace = "urn:ietf:rfc:7807"
+
+// MarshalXML renders the problem as an RFC 7807 <problem> document. Extensions keep the
+// same shape as the JSON form: objects become child elements and arrays repeat <i> items.
+func (p ProblemDetail) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	start := xml.StartElement{
+		Name: xml.Name{Local: "problem"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: ProblemXMLNamespace}},
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	members := []struct {
+		name  string
+		value string
+		keep  bool
+	}{
+		{"type", p.Type, true},
+		{"title", p.Title, true},
+		{"status", strconv.Itoa(p.Status), true},
+		{"detail", p.Detail, p.Detail != ""},
+		{"instance", p.Instance, p.Instance != ""},
+		{"code", p.Code, p.Code != ""},
+	}
+	for _, member := range members {
+		if !member.keep {
+			continue
+		}
+		if err := e.EncodeElement(member.value, xml.StartElement{Name: xml.Name{Local: member.name}}); err != nil {
+			return err
+		}
+	}
+	if len(p.Extensions) > 0 {
+		// Round-trip through JSON so typed extension values share the JSON field names.
+		raw, err := json.Marshal(p.Extensions)
+		if err != nil {
+			return err
+		}
+		var extensions map[string]any
+		if err := json.Unmarshal(raw, &extensions); err != nil {
+			return err
+		}
+		if err := encodeXMLValue(e, "extensions", extensions); err != nil {
+			return err
+		}
+	}
+	if err := e.EncodeToken(start.End()); err != nil {
+		return err
+	}
+	return e.Flush()
+}
+
+// encodeXMLValue writes a decoded JSON value as an element. Map keys that are not valid XML
+// names, such as numeric identifiers, are written as <i key="..."> instead.
+func encodeXMLValue(e *xml.Encoder, name string, value any) error {
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	if !isXMLName(name) {
+		start = xml.StartElement{
+			Name: xml.Name{Local: "i"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "key"}, Value: name}},
+		}
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := encodeXMLValue(e, key, v[key]); err != nil {
+				return err
+			}
+		}
+		return e.EncodeToken(start.End())
+	case []any:
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+		for _, item := range v {
+			if err := encodeXMLValue(e, "i", item); err != nil {
+				return err
+			}
+		}
+		return e.EncodeToken(start.End())
+	default:
+		return e.EncodeElement(fmt.Sprint(v), start)
+	}
+}
+
+func isXMLName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		letter := r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		if i == 0 && !letter {
+			return false
+		}
+		if !letter && r != '-' && r != '.' && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
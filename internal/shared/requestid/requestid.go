@@ -0,0 +1,66 @@
+// Package requestid carries the request correlation ID from the transport that received a
+// request to logs, problem details, spans, workflows, and outbound partner calls.
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Header is the HTTP header clients may set and every response echoes.
+	Header = "X-Request-ID"
+	// MetadataKey is the gRPC metadata counterpart of Header.
+	MetadataKey = "x-request-id"
+	// SpanAttribute tags server spans with the request ID.
+	SpanAttribute = "request.id"
+	// MaxLength bounds accepted client IDs so they stay safe to log and index.
+	MaxLength = 128
+)
+
+type contextKey struct{}
+
+// New generates a random ID.
+func New() string {
+	return uuid.NewString()
+}
+
+// Valid reports whether a client-supplied ID can be reused: 1 to MaxLength visible ASCII
+// characters, so it cannot break headers or log lines.
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < '!' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// Ensure returns id when it is valid and a new ID otherwise.
+func Ensure(id string) string {
+	if Valid(id) {
+		return id
+	}
+	return New()
+}
+
+// WithID stores id in ctx.
+func WithID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID stored in ctx, or "" when there is none.
+func FromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
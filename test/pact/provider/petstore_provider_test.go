@@ -141,7 +141,7 @@ func newContractProviderApp(t testing.TB) *contractProviderApp {
 	userService := userobs.New(userapp.NewService(userRepo, sessionStore))
 
 	handlers := petstoreserver.ApiHandleFunctions{
-		PetAPI:   petstoreserver.NewPetAPI(petService, workflows, idempotencyStore),
+		PetAPI:   petstoreserver.NewPetAPI(petService, workflows),
 		StoreAPI: petstoreserver.NewStoreAPI(storeService),
 		UserAPI:  petstoreserver.NewUserAPI(userService),
 	}